@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"omg/api/cmd/serverd/router"
 	"omg/api/internal/authenticate"
@@ -14,19 +18,44 @@ import (
 	"omg/api/internal/controller/products"
 	"omg/api/internal/controller/system"
 	"omg/api/internal/controller/users"
+	"omg/api/internal/controller/webhooks"
+	"omg/api/internal/dispatcher"
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/generator"
+	"omg/api/internal/sweeper"
+	"omg/api/internal/webhook"
 	"omg/api/internal/ws"
 	"omg/api/pkg/app"
 	"omg/api/pkg/db/pg"
 	"omg/api/pkg/env"
 	"omg/api/pkg/httpserv"
+	"omg/api/pkg/metrics"
 
 	"github.com/friendsofgo/errors"
 )
 
+// defaultDrainDelay gives the load balancers the time to notice the failing readiness before the server stops
+// accepting connections, overridden by SHUTDOWN_DRAIN_DELAY
+const defaultDrainDelay = 5 * time.Second
+
+// defaultShutdownGrace gives the in-flight requests the time to complete before the DB pool gets closed, the same as
+// the timeout of the DB transactions of the controllers. Overridden by SHUTDOWN_GRACE, which must not be shorter.
+const defaultShutdownGrace = 2 * time.Minute
+
+// defaultMetricsAddr is the internal listener of the metrics, off the public port, overridden by METRICS_ADDR
+const defaultMetricsAddr = ":9090"
+
+// metricsShutdownGrace bounds the wait for the in-flight scrapes on shutdown
+const metricsShutdownGrace = 5 * time.Second
+
+// hubCloseTimeout bounds the wait for the close frames of the WebSocket clients on shutdown
+const hubCloseTimeout = 5 * time.Second
+
 func main() {
-	ctx := context.Background()
+	// Shut down gracefully on SIGTERM, as sent on deploys, & on SIGINT. A second signal kills the process right away.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	context.AfterFunc(ctx, stop)
 
 	appCfg := app.Config{
 		ProjectName:      env.GetAndValidateF("PROJECT_NAME"),
@@ -59,25 +88,133 @@ func run(ctx context.Context) error {
 		return errors.WithStack(fmt.Errorf("invalid db pool max idle conns: %w", err))
 	}
 
-	conn, err := pg.NewPool(env.GetAndValidateF("DB_URL"), dbOpenConns, dbIdleConns)
+	drainDelay := defaultDrainDelay
+	if v := os.Getenv("SHUTDOWN_DRAIN_DELAY"); v != "" {
+		if drainDelay, err = time.ParseDuration(v); err != nil {
+			return errors.WithStack(fmt.Errorf("invalid shutdown drain delay: %w", err))
+		}
+	}
+
+	shutdownGrace := defaultShutdownGrace
+	if v := os.Getenv("SHUTDOWN_GRACE"); v != "" {
+		if shutdownGrace, err = time.ParseDuration(v); err != nil {
+			return errors.WithStack(fmt.Errorf("invalid shutdown grace: %w", err))
+		}
+		if shutdownGrace < defaultShutdownGrace {
+			return errors.WithStack(fmt.Errorf("shutdown grace %s shorter than the db transaction timeout %s", shutdownGrace, defaultShutdownGrace))
+		}
+	}
+
+	metricsAddr := defaultMetricsAddr
+	if v := os.Getenv("METRICS_ADDR"); v != "" {
+		metricsAddr = v
+	}
+
+	dbURL := env.GetAndValidateF("DB_URL")
+	conn, err := pg.NewPool(dbURL, dbOpenConns, dbIdleConns)
 	if err != nil {
 		return err
 	}
 
 	defer conn.Close()
 
-	rtr, err := initRouter(ctx, conn)
+	if err = pg.RegisterPoolMetrics(conn); err != nil {
+		return err
+	}
+
+	// The hub fans the WebSocket messages out to every replica through LISTEN/NOTIFY
+	listener := pg.NewListener(dbURL)
+	defer listener.Close()
+	hub := ws.NewPGHub(conn, listener)
+
+	systemCtrl := system.New(repository.New(conn), hub)
+
+	rtr, err := initRouter(ctx, conn, systemCtrl, hub)
+	if err != nil {
+		return err
+	}
+
+	// The workers outlive the server, to process the events of the requests still in flight when shutting down
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	runWorker := func(run func(context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workersCtx)
+		}()
+	}
+
+	// Publish the order events recorded in the outbox to the WebSocket clients & queue their webhook deliveries
+	runWorker(dispatcher.New(repository.New(conn), ws.NewHubSink(hub), webhook.NewSink()).Run)
+	runWorker(webhook.New(repository.New(conn)).Run)
+
+	// Clean up the expired data, e.g. the stock reservations of abandoned orders & the idempotency keys
+	runWorker(sweeper.New(repository.New(conn), orders.New(repository.New(conn))).Run)
 
 	log.Println("App initialization completed")
 
-	httpserv.NewServer(rtr.Handler()).Start(ctx)
+	srvCtx, stopSrv := context.WithCancel(drain(ctx, systemCtrl, drainDelay))
+	defer stopSrv()
+
+	// The metrics are served on their own listener, so they stay off the public ingress & are scraped until the
+	// workers stop. The server stops as well when the metrics cannot be served.
+	metricsCtx, stopMetrics := context.WithCancel(context.Background())
+	metricsDone := make(chan error, 1)
+	go func() {
+		err := httpserv.NewServer(metricsAddr, metrics.Handler(), metricsShutdownGrace).Start(metricsCtx)
+		if err != nil {
+			stopSrv()
+		}
+		metricsDone <- err
+	}()
+
+	srv := httpserv.NewServer(":3000", rtr.Handler(), shutdownGrace)
+	// The shutdown neither waits for the hijacked WebSocket connections nor ends the SSE streams, so the hub
+	// disconnects their clients, which then reconnect to another replica
+	srv.RegisterOnShutdown(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), hubCloseTimeout)
+		defer cancel()
+
+		if err := hub.Close(ctx); err != nil {
+			log.Printf("Failed to close the WebSocket clients: %v", err)
+		}
+	})
+	err = srv.Start(srvCtx)
+
+	// Tear down in order, once no request is in flight anymore: the workers, then the listener & the DB pool
+	log.Println("Stopping the workers")
+	stopWorkers()
+	workers.Wait()
+
+	stopMetrics()
+	if metricsErr := <-metricsDone; err == nil {
+		err = metricsErr
+	}
+
+	return err
+}
 
-	return nil
+// drain fails the readiness once the shutdown is signalled, then gives the load balancers the delay to stop routing
+// traffic to this replica. The returned context is done after the delay, for the server to stop.
+func drain(ctx context.Context, systemCtrl system.Controller, delay time.Duration) context.Context {
+	drained, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-ctx.Done()
+		log.Printf("Shutting down, draining for %s", delay)
+		systemCtrl.Drain()
+		time.Sleep(delay)
+		cancel()
+	}()
+
+	return drained
 }
 
 func initRouter(
 	ctx context.Context,
-	dbConn pg.BeginnerExecutor) (router.Router, error) {
+	dbConn pg.BeginnerExecutor,
+	systemCtrl system.Controller,
+	hub ws.Hub) (router.Router, error) {
 	if err := generator.InitSnowflakeGenerators(); err != nil {
 		return router.Router{}, err
 	}
@@ -86,11 +223,13 @@ func initRouter(
 		ctx,
 		strings.Split(os.Getenv("CORS_ALLOWED_ORIGINS"), ","),
 		os.Getenv("GQL_INTROSPECTION_ENABLED") == "true",
-		system.New(repository.New(dbConn)),
+		systemCtrl,
 		products.New(repository.New(dbConn)),
 		users.New(repository.New(dbConn)),
 		orders.New(repository.New(dbConn)),
+		webhooks.New(repository.New(dbConn)),
 		authenticate.NewAuthService(repository.New(dbConn), os.Getenv("AUTH_SECRET_KEY")),
-		ws.NewHub(),
+		hub,
+		repository.New(dbConn).Outbox(),
 	), nil
 }
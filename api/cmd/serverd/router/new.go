@@ -8,10 +8,14 @@ import (
 	"omg/api/internal/controller/products"
 	"omg/api/internal/controller/system"
 	"omg/api/internal/controller/users"
+	"omg/api/internal/controller/webhooks"
+	gqlHandler "omg/api/internal/handler/gql"
 	authenticateRestHandler "omg/api/internal/handler/rest/authenticate"
 	orderRestHandler "omg/api/internal/handler/rest/orders"
 	productRestHandler "omg/api/internal/handler/rest/products"
+	systemRestHandler "omg/api/internal/handler/rest/system"
 	userRestHandler "omg/api/internal/handler/rest/users"
+	webhookRestHandler "omg/api/internal/handler/rest/webhooks"
 	ws2 "omg/api/internal/ws"
 
 	"github.com/gin-gonic/gin"
@@ -26,24 +30,30 @@ func New(
 	productCtrl products.Controller,
 	userCtrl users.Controller,
 	orderCtrl orders.Controller,
+	webhookCtrl webhooks.Controller,
 	authService authenticate.AuthService,
 	hub ws2.Hub,
+	eventLog ws2.EventLog,
 ) Router {
 	return Router{
 		ctx:                     ctx,
 		corsOrigins:             corsOrigins,
 		isGQLIntrospectionOn:    isGQLIntrospectionOn,
 		systemCtrl:              systemCtrl,
+		systemRestHandler:       systemRestHandler.New(systemCtrl),
 		productCtrl:             productCtrl,
 		productRestHandler:      productRestHandler.New(productCtrl),
 		userCtrl:                userCtrl,
 		userRestHandler:         userRestHandler.New(userCtrl),
 		orderCtrl:               orderCtrl,
-		orderRestHandler:        orderRestHandler.NewHandler(orderCtrl, hub),
+		orderRestHandler:        orderRestHandler.NewHandler(orderCtrl),
+		webhookCtrl:             webhookCtrl,
+		webhookRestHandler:      webhookRestHandler.New(webhookCtrl),
 		authService:             authService,
 		authenticateRestHandler: authenticateRestHandler.New(authService),
+		gqlHandler:              gqlHandler.NewHandler(gqlHandler.NewResolver(productCtrl, orderCtrl, userCtrl, hub), &authService, isGQLIntrospectionOn),
 		engine:                  gin.Default(),
 		hub:                     hub,
-		wsHandler:               *ws2.NewWebSocketHandler(hub, authService),
+		wsHandler:               *ws2.NewWebSocketHandler(hub, authService, eventLog),
 	}
 }
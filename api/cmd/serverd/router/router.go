@@ -9,11 +9,17 @@ import (
 	"omg/api/internal/controller/products"
 	"omg/api/internal/controller/system"
 	"omg/api/internal/controller/users"
+	"omg/api/internal/controller/webhooks"
+	gqlHandler "omg/api/internal/handler/gql"
 	authenticateRestHandler "omg/api/internal/handler/rest/authenticate"
 	orderRestHandler "omg/api/internal/handler/rest/orders"
 	productRestHandler "omg/api/internal/handler/rest/products"
+	systemRestHandler "omg/api/internal/handler/rest/system"
 	userRestHandler "omg/api/internal/handler/rest/users"
+	webhookRestHandler "omg/api/internal/handler/rest/webhooks"
+	"omg/api/internal/model"
 	"omg/api/internal/ws"
+	"omg/api/pkg/metrics"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,14 +32,18 @@ type Router struct {
 	corsOrigins             []string
 	isGQLIntrospectionOn    bool
 	systemCtrl              system.Controller
+	systemRestHandler       systemRestHandler.Handler
 	productCtrl             products.Controller
 	productRestHandler      productRestHandler.Handler
 	userCtrl                users.Controller
 	userRestHandler         userRestHandler.Handler
 	orderCtrl               orders.Controller
 	orderRestHandler        orderRestHandler.Handler
+	webhookCtrl             webhooks.Controller
+	webhookRestHandler      webhookRestHandler.Handler
 	authService             authenticate.AuthService
 	authenticateRestHandler authenticateRestHandler.Handler
+	gqlHandler              gqlHandler.Handler
 	engine                  *gin.Engine
 	wsHandler               ws.WebSocketHandler
 	hub                     ws.Hub
@@ -44,11 +54,14 @@ func (rtr *Router) Handler() http.Handler {
 	// Start the WebSocket hub
 	go rtr.hub.Run()
 
+	// Observe the latency & status of every request by route
+	rtr.engine.Use(metrics.GinMiddleware())
+
 	// Set up CORS
 	rtr.engine.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key")
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
 			return
@@ -62,6 +75,10 @@ func (rtr *Router) Handler() http.Handler {
 }
 
 func (rtr *Router) setupRoutes(r *gin.Engine) {
+	// Probes of the orchestrator, e.g. Kubernetes
+	r.GET("/_/live", rtr.systemRestHandler.Liveness)
+	r.GET("/_/ready", rtr.systemRestHandler.Readiness)
+
 	public := r.Group("/public")
 	rtr.public(public)
 
@@ -81,26 +98,49 @@ func (rtr *Router) public(rg *gin.RouterGroup) {
 	usersRouter := rg.Group("/users")
 	usersRouter.POST("/register", rtr.userRestHandler.Register)
 	usersRouter.POST("/login", rtr.authenticateRestHandler.Login)
+	usersRouter.POST("/refresh", rtr.authenticateRestHandler.Refresh)
 	usersRouter.GET("/ws", rtr.wsHandler.Handle)
+
+	// GraphQL subscriptions, authenticating with the token of the connection init payload
+	rg.GET("/graphql", rtr.gqlHandler.ServeWebSocket)
 }
 
 func (rtr *Router) authenticated(rg *gin.RouterGroup) {
+	adminOnly := authenticate.NewRoleMiddleware(model.UserRoleAdmin).Handler()
+	staffOrAdmin := authenticate.NewRoleMiddleware(model.UserRoleStaff, model.UserRoleAdmin).Handler()
+
 	usersRouter := rg.Group("/users")
 	usersRouter.GET("/profile", rtr.userRestHandler.GetUserByEmail)
+	usersRouter.POST("/logout", rtr.authenticateRestHandler.Logout)
 	usersRouter.GET("/:id", rtr.userRestHandler.GetUserByID)
-	usersRouter.GET("/list", rtr.userRestHandler.List)
+	usersRouter.GET("/list", staffOrAdmin, rtr.userRestHandler.List)
 	usersRouter.PUT("/update", rtr.userRestHandler.UpdateUser)
-	usersRouter.POST("/delete/:id", rtr.userRestHandler.Delete)
+	usersRouter.POST("/delete/:id", adminOnly, rtr.userRestHandler.Delete)
 
 	productsRouter := rg.Group("/products")
-	productsRouter.POST("/create", rtr.productRestHandler.Create)
-	productsRouter.PUT("/update", rtr.productRestHandler.UpdateProduct)
-	productsRouter.POST("/delete/:id", rtr.productRestHandler.Delete)
+	productsRouter.POST("/create", staffOrAdmin, rtr.productRestHandler.Create)
+	productsRouter.POST("/import", staffOrAdmin, rtr.productRestHandler.Import)
+	productsRouter.PUT("/update", staffOrAdmin, rtr.productRestHandler.UpdateProduct)
+	productsRouter.POST("/delete/:id", adminOnly, rtr.productRestHandler.Delete)
 	productsRouter.GET("/:id", rtr.productRestHandler.GetProductByID)
 	productsRouter.GET("/list", rtr.productRestHandler.List)
+	productsRouter.GET("/:id/movements", staffOrAdmin, rtr.productRestHandler.ListStockMovements)
 
 	orderRouter := rg.Group("/order")
 	orderRouter.POST("/create", rtr.orderRestHandler.Create)
 	orderRouter.PUT("/update/:id", rtr.orderRestHandler.UpdateOrderStatus)
+	orderRouter.GET("/list", rtr.orderRestHandler.ListOrders)
+	orderRouter.GET("/:id", rtr.orderRestHandler.GetOrderByID)
 	orderRouter.GET("/ws", rtr.wsHandler.HandleOrderUpdates)
+	orderRouter.GET("/events", rtr.wsHandler.HandleOrderEvents)
+
+	// GraphQL over the same controllers, authorizing per field with the role of the caller
+	rg.POST("/graphql", rtr.gqlHandler.Serve)
+
+	webhooksRouter := rg.Group("/webhooks", adminOnly)
+	webhooksRouter.POST("/create", rtr.webhookRestHandler.Create)
+	webhooksRouter.GET("/list", rtr.webhookRestHandler.List)
+	webhooksRouter.POST("/delete/:id", rtr.webhookRestHandler.Delete)
+	webhooksRouter.GET("/deliveries/list", rtr.webhookRestHandler.ListDeliveries)
+	webhooksRouter.POST("/deliveries/redeliver/:id", rtr.webhookRestHandler.Redeliver)
 }
@@ -9,6 +9,7 @@ import (
 
 	"omg/api/internal/authenticate"
 	"omg/api/internal/ws"
+	"omg/api/pkg/metrics"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -37,17 +38,26 @@ func TestRouter_Handler(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				authenticate.AuthService{},
 				ws.NewHub(),
+				nil,
 			),
 			expectedRoutes: []route{
+				// Probes
+				{method: "GET", path: "/_/live"},
+				{method: "GET", path: "/_/ready"},
+
 				// Public routes
 				{method: "POST", path: "/public/users/register"},
 				{method: "POST", path: "/public/users/login"},
+				{method: "POST", path: "/public/users/refresh"},
 				{method: "GET", path: "/public/users/ws"},
+				{method: "GET", path: "/public/graphql"},
 
 				// Authenticated routes - Users
 				{method: "GET", path: "/authenticated/users/profile"},
+				{method: "POST", path: "/authenticated/users/logout"},
 				{method: "GET", path: "/authenticated/users/:id"},
 				{method: "GET", path: "/authenticated/users/list"},
 				{method: "PUT", path: "/authenticated/users/update"},
@@ -55,15 +65,30 @@ func TestRouter_Handler(t *testing.T) {
 
 				// Authenticated routes - Products
 				{method: "POST", path: "/authenticated/products/create"},
+				{method: "POST", path: "/authenticated/products/import"},
 				{method: "PUT", path: "/authenticated/products/update"},
 				{method: "POST", path: "/authenticated/products/delete/:id"},
 				{method: "GET", path: "/authenticated/products/:id"},
 				{method: "GET", path: "/authenticated/products/list"},
+				{method: "GET", path: "/authenticated/products/:id/movements"},
 
 				// Authenticated routes - Orders
 				{method: "POST", path: "/authenticated/order/create"},
 				{method: "PUT", path: "/authenticated/order/update/:id"},
+				{method: "GET", path: "/authenticated/order/list"},
+				{method: "GET", path: "/authenticated/order/:id"},
 				{method: "GET", path: "/authenticated/order/ws"},
+				{method: "GET", path: "/authenticated/order/events"},
+
+				// Authenticated routes - GraphQL
+				{method: "POST", path: "/authenticated/graphql"},
+
+				// Authenticated routes - Webhooks
+				{method: "POST", path: "/authenticated/webhooks/create"},
+				{method: "GET", path: "/authenticated/webhooks/list"},
+				{method: "POST", path: "/authenticated/webhooks/delete/:id"},
+				{method: "GET", path: "/authenticated/webhooks/deliveries/list"},
+				{method: "POST", path: "/authenticated/webhooks/deliveries/redeliver/:id"},
 			},
 		},
 	}
@@ -108,3 +133,48 @@ func TestRouter_Handler(t *testing.T) {
 		})
 	}
 }
+
+func TestRouter_Metrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Given:
+	rtr := New(
+		context.Background(),
+		[]string{"*"},
+		false,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		authenticate.AuthService{},
+		ws.NewHub(),
+		nil,
+	)
+	handler := rtr.Handler()
+
+	// When:
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/_/live", nil))
+
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	// Then: the metrics are served off the public router, on their own listener
+	public := httptest.NewRecorder()
+	handler.ServeHTTP(public, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusNotFound, public.Code)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	body := w.Body.String()
+	require.Contains(t, body, `http_request_duration_seconds_count{method="GET",route="/_/live",status="200"}`)
+	for _, name := range []string{
+		"db_tx_begin_attempts_total",
+		"db_tx_begin_retries_total",
+		"orders_created_total",
+		"ws_active_clients",
+		"ws_dropped_sends_total",
+		"go_goroutines",
+	} {
+		require.Contains(t, body, name)
+	}
+}
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS public.refresh_tokens
+(
+    id         BIGINT PRIMARY KEY,
+    user_id    BIGINT                   NOT NULL REFERENCES public.users (id),
+    family_id  BIGINT                   NOT NULL,
+    revoked    BOOLEAN                  NOT NULL DEFAULT FALSE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS refresh_tokens_family_id_index ON refresh_tokens(family_id);
@@ -0,0 +1,14 @@
+ALTER TABLE public.order_items DROP CONSTRAINT IF EXISTS order_items_price_check;
+ALTER TABLE public.order_items
+    ALTER COLUMN price TYPE FLOAT USING price::FLOAT,
+    ADD CONSTRAINT order_items_price_check CHECK (price >= 0::FLOAT);
+
+ALTER TABLE public.orders DROP CONSTRAINT IF EXISTS orders_total_cost_check;
+ALTER TABLE public.orders
+    ALTER COLUMN total_cost TYPE FLOAT USING total_cost::FLOAT,
+    ADD CONSTRAINT orders_total_cost_check CHECK (total_cost >= 0::FLOAT);
+
+ALTER TABLE public.products DROP CONSTRAINT IF EXISTS products_price_check;
+ALTER TABLE public.products
+    ALTER COLUMN price TYPE FLOAT USING price::FLOAT,
+    ADD CONSTRAINT products_price_check CHECK (price > 0::FLOAT);
@@ -0,0 +1,16 @@
+-- Money is stored as exact NUMERIC instead of FLOAT so that sums never drift by a cent.
+-- Existing values are rounded to cents while converting.
+ALTER TABLE public.products DROP CONSTRAINT IF EXISTS products_price_check;
+ALTER TABLE public.products
+    ALTER COLUMN price TYPE NUMERIC(15, 2) USING round(price::NUMERIC, 2),
+    ADD CONSTRAINT products_price_check CHECK (price > 0);
+
+ALTER TABLE public.orders DROP CONSTRAINT IF EXISTS orders_total_cost_check;
+ALTER TABLE public.orders
+    ALTER COLUMN total_cost TYPE NUMERIC(15, 2) USING round(total_cost::NUMERIC, 2),
+    ADD CONSTRAINT orders_total_cost_check CHECK (total_cost >= 0);
+
+ALTER TABLE public.order_items DROP CONSTRAINT IF EXISTS order_items_price_check;
+ALTER TABLE public.order_items
+    ALTER COLUMN price TYPE NUMERIC(15, 2) USING round(price::NUMERIC, 2),
+    ADD CONSTRAINT order_items_price_check CHECK (price >= 0);
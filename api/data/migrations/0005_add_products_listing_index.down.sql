@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.product_idx_created_at_id;
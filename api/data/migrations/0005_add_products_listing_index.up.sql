@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS product_idx_created_at_id ON public.products (created_at DESC, id DESC);
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS user_idx_created_at_id ON public.users (created_at DESC, id DESC);
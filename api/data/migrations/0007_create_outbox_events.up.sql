@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS public.outbox_events
+(
+    id           BIGSERIAL PRIMARY KEY,
+    type         TEXT                     NOT NULL CHECK (type <> ''::text),
+    aggregate_id BIGINT                   NOT NULL,
+    payload      JSONB                    NOT NULL,
+    status       TEXT                     NOT NULL DEFAULT 'PENDING' CHECK (status <> ''::text),
+    attempts     INTEGER                  NOT NULL DEFAULT 0,
+    last_error   TEXT                     NOT NULL DEFAULT '',
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS outbox_event_idx_pending ON public.outbox_events (id) WHERE status = 'PENDING';
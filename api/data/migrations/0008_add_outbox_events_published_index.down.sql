@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.outbox_event_idx_published_updated_at;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS outbox_event_idx_published_updated_at ON public.outbox_events (updated_at) WHERE status = 'PUBLISHED';
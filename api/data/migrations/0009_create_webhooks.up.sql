@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS public.webhook_endpoints
+(
+    id          BIGINT PRIMARY KEY,
+    url         TEXT                     NOT NULL CHECK (url <> ''::text),
+    secret      TEXT                     NOT NULL CHECK (secret <> ''::text),
+    event_types JSONB                    NOT NULL,
+    status      TEXT                     NOT NULL DEFAULT 'ACTIVE' CHECK (status <> ''::text),
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS public.webhook_deliveries
+(
+    id               BIGINT PRIMARY KEY,
+    endpoint_id      BIGINT                   NOT NULL REFERENCES public.webhook_endpoints (id),
+    event_id         BIGINT                   NOT NULL,
+    event_type       TEXT                     NOT NULL CHECK (event_type <> ''::text),
+    payload          JSONB                    NOT NULL,
+    status           TEXT                     NOT NULL DEFAULT 'PENDING' CHECK (status <> ''::text),
+    attempts         INTEGER                  NOT NULL DEFAULT 0,
+    last_error       TEXT                     NOT NULL DEFAULT '',
+    last_status_code INTEGER                  NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    CONSTRAINT webhook_deliveries_endpoint_id_event_id_key UNIQUE (endpoint_id, event_id)
+);
+CREATE INDEX IF NOT EXISTS webhook_delivery_idx_pending_next_attempt_at ON public.webhook_deliveries (next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS webhook_delivery_idx_status_created_at ON public.webhook_deliveries (status, created_at DESC, id DESC);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS public.idempotency_keys
+(
+    user_id      BIGINT                   NOT NULL REFERENCES public.users (id),
+    key          TEXT                     NOT NULL CHECK (key <> ''::text),
+    request_hash TEXT                     NOT NULL,
+    response     JSONB                    NOT NULL DEFAULT '{}'::jsonb,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, key)
+);
+CREATE INDEX IF NOT EXISTS idempotency_keys_created_at_index ON public.idempotency_keys (created_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS public.stock_reservations;
+ALTER TABLE public.products DROP COLUMN IF EXISTS reserved;
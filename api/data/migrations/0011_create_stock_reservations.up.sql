@@ -0,0 +1,15 @@
+ALTER TABLE public.products ADD COLUMN IF NOT EXISTS reserved BIGINT NOT NULL DEFAULT 0 CHECK (reserved >= 0);
+
+CREATE TABLE IF NOT EXISTS public.stock_reservations
+(
+    id         BIGINT                   NOT NULL PRIMARY KEY,
+    order_id   BIGINT                   NOT NULL REFERENCES public.orders (id),
+    product_id BIGINT                   NOT NULL REFERENCES public.products (id),
+    quantity   BIGINT                   NOT NULL CHECK (quantity > 0),
+    status     TEXT                     NOT NULL DEFAULT 'ACTIVE'::text CHECK (status <> ''::text),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS stock_reservations_order_id_index ON public.stock_reservations (order_id);
+CREATE INDEX IF NOT EXISTS stock_reservations_active_expires_at_index ON public.stock_reservations (expires_at) WHERE status = 'ACTIVE';
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS public.stock_movements
+(
+    id         BIGINT                   NOT NULL PRIMARY KEY,
+    product_id BIGINT                   NOT NULL REFERENCES public.products (id),
+    delta      BIGINT                   NOT NULL CHECK (delta <> 0),
+    balance    BIGINT                   NOT NULL,
+    reason     TEXT                     NOT NULL CHECK (reason <> ''::text),
+    order_id   BIGINT                   NOT NULL DEFAULT 0,
+    actor_id   BIGINT                   NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS stock_movements_product_id_created_at_index ON public.stock_movements (product_id, created_at DESC, id DESC);
+
+-- The ledger is append-only, corrections are recorded as new movements
+CREATE OR REPLACE FUNCTION public.stock_movements_append_only() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'stock_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_movements_append_only
+    BEFORE UPDATE OR DELETE
+    ON public.stock_movements
+    FOR EACH ROW
+EXECUTE FUNCTION public.stock_movements_append_only();
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS public.outbox_event_idx_user_id_published_seq;
+DROP INDEX IF EXISTS public.outbox_event_idx_published_seq;
+DROP SEQUENCE IF EXISTS public.outbox_events_publish_seq;
+ALTER TABLE public.outbox_events DROP COLUMN IF EXISTS published_seq;
+ALTER TABLE public.outbox_events DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,15 @@
+-- The owner of the aggregate, to replay the events of a user without decoding the payloads
+ALTER TABLE public.outbox_events ADD COLUMN IF NOT EXISTS user_id BIGINT NOT NULL DEFAULT 0;
+-- Assigned when the event is published, in the order the publishing txs commit, unlike the id assigned on insert
+ALTER TABLE public.outbox_events ADD COLUMN IF NOT EXISTS published_seq BIGINT NOT NULL DEFAULT 0;
+CREATE SEQUENCE IF NOT EXISTS public.outbox_events_publish_seq;
+
+UPDATE public.outbox_events
+SET user_id = (payload ->> 'user_id')::BIGINT
+WHERE type IN ('order.created', 'order.status_changed')
+  AND payload ? 'user_id';
+UPDATE public.outbox_events SET published_seq = id WHERE status = 'PUBLISHED';
+SELECT setval('public.outbox_events_publish_seq', COALESCE(MAX(id), 0) + 1, false) FROM public.outbox_events;
+
+CREATE INDEX IF NOT EXISTS outbox_event_idx_published_seq ON public.outbox_events (published_seq) WHERE status = 'PUBLISHED';
+CREATE INDEX IF NOT EXISTS outbox_event_idx_user_id_published_seq ON public.outbox_events (user_id, published_seq) WHERE status = 'PUBLISHED';
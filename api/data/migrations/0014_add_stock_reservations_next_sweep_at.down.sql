@@ -0,0 +1,2 @@
+ALTER TABLE public.stock_reservations DROP COLUMN IF EXISTS next_sweep_at;
+ALTER TABLE public.stock_reservations DROP COLUMN IF EXISTS sweep_attempts;
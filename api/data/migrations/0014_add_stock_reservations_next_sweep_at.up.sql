@@ -0,0 +1,3 @@
+-- The sweeper backs off from the orders it fails to expire, so they do not hold the later expiries up
+ALTER TABLE public.stock_reservations ADD COLUMN IF NOT EXISTS sweep_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE public.stock_reservations ADD COLUMN IF NOT EXISTS next_sweep_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now();
@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS stock_movements_product_id_created_at_index ON public.stock_movements (product_id, created_at DESC, id DESC);
+DROP INDEX IF EXISTS public.stock_movements_product_id_seq_index;
+ALTER TABLE public.stock_movements DROP COLUMN IF EXISTS seq;
+DROP SEQUENCE IF EXISTS public.stock_movements_seq_seq;
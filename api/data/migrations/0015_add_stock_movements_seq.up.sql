@@ -0,0 +1,19 @@
+-- The position of the movement in the ledger. The movements of a product are recorded under the lock of the product,
+-- so the seq follows the order they were made in, unlike created_at which the api stamps before the insert
+CREATE SEQUENCE IF NOT EXISTS public.stock_movements_seq_seq;
+ALTER TABLE public.stock_movements ADD COLUMN IF NOT EXISTS seq BIGINT NOT NULL DEFAULT 0;
+
+-- The ledger is append-only, except for numbering the movements recorded so far
+ALTER TABLE public.stock_movements DISABLE TRIGGER stock_movements_append_only;
+UPDATE public.stock_movements m
+SET seq = o.seq
+FROM (SELECT id, row_number() OVER (ORDER BY created_at, id) AS seq FROM public.stock_movements) o
+WHERE m.id = o.id;
+ALTER TABLE public.stock_movements ENABLE TRIGGER stock_movements_append_only;
+
+SELECT setval('public.stock_movements_seq_seq', COALESCE(MAX(seq), 0) + 1, false) FROM public.stock_movements;
+ALTER TABLE public.stock_movements ALTER COLUMN seq SET DEFAULT nextval('public.stock_movements_seq_seq');
+ALTER SEQUENCE public.stock_movements_seq_seq OWNED BY public.stock_movements.seq;
+
+CREATE INDEX IF NOT EXISTS stock_movements_product_id_seq_index ON public.stock_movements (product_id, seq DESC);
+DROP INDEX IF EXISTS public.stock_movements_product_id_created_at_index;
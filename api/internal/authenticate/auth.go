@@ -1,10 +1,13 @@
 package authenticate
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"time"
 
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
 	"omg/api/internal/repository/user"
 
 	"github.com/gin-gonic/gin"
@@ -12,40 +15,124 @@ import (
 )
 
 var (
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrTokenExpired       = errors.New("token expired")
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrTokenExpired        = errors.New("token expired")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reused")
+)
+
+const (
+	accessTokenTTL  = 24 * time.Hour
+	refreshTokenTTL = 7 * 24 * time.Hour
 )
 
 type Claims struct {
-	UserID int64  `json:"user_id"`
-	Email  string `json:"email"`
+	UserID int64          `json:"user_id"`
+	Email  string         `json:"email"`
+	Role   model.UserRole `json:"role"`
 	jwt.RegisteredClaims
 }
 
-func (s *AuthService) Login(ctx *gin.Context, email, password string) (string, error) {
+// TokenPair presents the tokens issued on login & refresh
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+func (s *AuthService) Login(ctx *gin.Context, email, password string) (TokenPair, error) {
 	u, err := s.repo.User().GetByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, user.ErrNotFound) {
-			return "", ErrInvalidCredentials
+			return TokenPair{}, ErrInvalidCredentials
 		}
-		return "", err
+		return TokenPair{}, err
 	}
 
 	if err := s.compareHashAndPassword([]byte(u.Password), []byte(password)); err != nil {
-		return "", ErrInvalidCredentials
+		return TokenPair{}, ErrInvalidCredentials
 	}
 
-	claims := Claims{
-		UserID: u.ID,
-		Email:  u.Email,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+	accessToken, err := s.generateAccessToken(u)
+	if err != nil {
+		return TokenPair{}, err
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return s.signToken(token, s.secret)
+	refreshToken, err := s.GenerateRefreshToken(ctx, u.ID)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	return TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// Refresh rotates the refresh token: the given one is revoked and a new pair is issued.
+// Presenting an already revoked token revokes the whole token family as it may have been stolen.
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (TokenPair, error) {
+	rt, err := s.getRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	if rt.Revoked {
+		return TokenPair{}, s.revokeReusedFamily(ctx, rt.FamilyID)
+	}
+
+	u, err := s.repo.User().GetByID(ctx, rt.UserID)
+	if err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			return TokenPair{}, ErrInvalidRefreshToken
+		}
+		return TokenPair{}, err
+	}
+
+	if u.Status != model.UserStatusActive {
+		return TokenPair{}, ErrInvalidRefreshToken
+	}
+
+	var pair TokenPair
+	txFunc := func(newCtx context.Context, repo repository.Registry) error {
+		// Only one of concurrent refreshes with the same token can revoke it
+		if err := repo.User().RevokeRefreshToken(newCtx, rt.ID); err != nil {
+			if errors.Is(err, user.ErrRefreshTokenNotFound) {
+				return ErrRefreshTokenReused
+			}
+			return err
+		}
+
+		var err error
+		pair.RefreshToken, err = s.issueRefreshToken(newCtx, repo, u.ID, rt.FamilyID)
+		return err
+	}
+
+	if err = s.repo.DoInTx(ctx, txFunc, nil); err != nil {
+		if errors.Is(err, ErrRefreshTokenReused) {
+			return TokenPair{}, s.revokeReusedFamily(ctx, rt.FamilyID)
+		}
+		return TokenPair{}, err
+	}
+
+	if pair.AccessToken, err = s.generateAccessToken(u); err != nil {
+		return TokenPair{}, err
+	}
+
+	return pair, nil
+}
+
+// Logout revokes the refresh token of the given user together with the tokens rotated from the same login
+func (s *AuthService) Logout(ctx context.Context, userID int64, refreshToken string) error {
+	rt, err := s.getRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if rt.UserID != userID {
+		return ErrInvalidRefreshToken
+	}
+
+	return s.repo.User().RevokeRefreshTokenFamily(ctx, rt.FamilyID)
 }
 
 func (s *AuthService) ValidateToken(tokenString string) (*Claims, error) {
@@ -60,20 +147,86 @@ func (s *AuthService) ValidateToken(tokenString string) (*Claims, error) {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
+	// Refresh tokens carry no user_id so they cannot be used as access tokens
+	if claims, ok := token.Claims.(*Claims); ok && token.Valid && claims.UserID != 0 {
 		return claims, nil
 	}
 
-	return nil, nil
+	return nil, jwt.ErrTokenInvalidClaims
 }
 
-func (s *AuthService) GenerateRefreshToken(userID int64) (string, error) {
+// GenerateRefreshToken issues a refresh token starting a new token family
+func (s *AuthService) GenerateRefreshToken(ctx context.Context, userID int64) (string, error) {
+	return s.issueRefreshToken(ctx, s.repo, userID, 0)
+}
+
+func (s *AuthService) generateAccessToken(u model.User) (string, error) {
+	claims := Claims{
+		UserID: u.ID,
+		Email:  u.Email,
+		Role:   u.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(accessTokenTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return s.signToken(token, s.secret)
+}
+
+func (s *AuthService) issueRefreshToken(ctx context.Context, repo repository.Registry, userID int64, familyID int64) (string, error) {
+	rt, err := repo.User().CreateRefreshToken(ctx, model.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(refreshTokenTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+
 	claims := jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(7 * 24 * time.Hour)), // 7 days
+		ExpiresAt: jwt.NewNumericDate(rt.ExpiresAt),
 		IssuedAt:  jwt.NewNumericDate(time.Now()),
-		ID:        strconv.FormatInt(userID, 10),
+		ID:        strconv.FormatInt(rt.ID, 10),
+		Subject:   strconv.FormatInt(userID, 10),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString(s.secret)
 }
+
+// getRefreshToken verifies the refresh token and loads its stored state
+func (s *AuthService) getRefreshToken(ctx context.Context, refreshToken string) (model.RefreshToken, error) {
+	claims := jwt.RegisteredClaims{}
+	if _, err := jwt.ParseWithClaims(refreshToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	}); err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return model.RefreshToken{}, ErrTokenExpired
+		}
+		return model.RefreshToken{}, ErrInvalidRefreshToken
+	}
+
+	id, err := strconv.ParseInt(claims.ID, 10, 64)
+	if err != nil {
+		return model.RefreshToken{}, ErrInvalidRefreshToken
+	}
+
+	rt, err := s.repo.User().GetRefreshTokenByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, user.ErrRefreshTokenNotFound) {
+			return model.RefreshToken{}, ErrInvalidRefreshToken
+		}
+		return model.RefreshToken{}, err
+	}
+
+	return rt, nil
+}
+
+func (s *AuthService) revokeReusedFamily(ctx context.Context, familyID int64) error {
+	if err := s.repo.User().RevokeRefreshTokenFamily(ctx, familyID); err != nil {
+		return err
+	}
+	return ErrRefreshTokenReused
+}
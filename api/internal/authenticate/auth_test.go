@@ -1,6 +1,7 @@
 package authenticate
 
 import (
+	"context"
 	"errors"
 	"math"
 	"net/http/httptest"
@@ -11,6 +12,7 @@ import (
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/user"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/mock"
@@ -29,6 +31,7 @@ func TestAuthService_Login(t *testing.T) {
 		mockUserErr    error
 		mockBcryptErr  error
 		mockSigningErr error
+		mockRefreshErr error
 		expToken       string
 		expErr         error
 	}
@@ -40,6 +43,7 @@ func TestAuthService_Login(t *testing.T) {
 			mockUser: model.User{
 				ID:       14753001,
 				Email:    "test@example.com",
+				Role:     model.UserRoleAdmin,
 				Password: "$2a$10$XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", // mock bcrypt hash
 			},
 			expToken: "valid.jwt.token", // This will be replaced with actual token in the test
@@ -78,6 +82,17 @@ func TestAuthService_Login(t *testing.T) {
 			mockSigningErr: errors.New("signing error"),
 			expErr:         errors.New("signing error"),
 		},
+		"refresh_token_error": {
+			givenEmail:    "test@example.com",
+			givenPassword: "password123",
+			mockUser: model.User{
+				ID:       14753001,
+				Email:    "test@example.com",
+				Password: "$2a$10$XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", // mock bcrypt hash
+			},
+			mockRefreshErr: errors.New("database error"),
+			expErr:         errors.New("database error"),
+		},
 	}
 
 	for s, tc := range tcs {
@@ -96,6 +111,14 @@ func TestAuthService_Login(t *testing.T) {
 			if tc.givenEmail != "" {
 				mockUserRepo.On("GetByEmail", mock.Anything, tc.givenEmail).Return(tc.mockUser, tc.mockUserErr)
 			}
+			mockUserRepo.On("CreateRefreshToken", mock.Anything, mock.MatchedBy(func(rt model.RefreshToken) bool {
+				return rt.UserID == tc.mockUser.ID && rt.FamilyID == 0
+			})).Return(model.RefreshToken{
+				ID:        99,
+				UserID:    tc.mockUser.ID,
+				FamilyID:  99,
+				ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+			}, tc.mockRefreshErr).Maybe()
 			mockRepo.On("User").Return(mockUserRepo)
 
 			// Mock bcrypt.CompareHashAndPassword
@@ -138,10 +161,18 @@ func TestAuthService_Login(t *testing.T) {
 				require.Empty(t, token)
 			} else {
 				require.NoError(t, err)
-				require.NotEmpty(t, token)
+				require.NotEmpty(t, token.AccessToken)
+
+				// Verify refresh token refers to the stored one
+				refreshClaims := jwt.RegisteredClaims{}
+				_, err = jwt.ParseWithClaims(token.RefreshToken, &refreshClaims, func(token *jwt.Token) (interface{}, error) {
+					return testSecret, nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, "99", refreshClaims.ID)
 
 				// Verify JWT token
-				parsedToken, err := jwt.ParseWithClaims(token, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+				parsedToken, err := jwt.ParseWithClaims(token.AccessToken, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 					return testSecret, nil
 				})
 				require.NoError(t, err)
@@ -152,6 +183,7 @@ func TestAuthService_Login(t *testing.T) {
 				require.True(t, ok)
 				require.Equal(t, tc.mockUser.ID, claims.UserID)
 				require.Equal(t, tc.mockUser.Email, claims.Email)
+				require.Equal(t, tc.mockUser.Role, claims.Role)
 				require.NotZero(t, claims.ExpiresAt)
 				require.NotZero(t, claims.IssuedAt)
 
@@ -194,7 +226,17 @@ func TestAuthService_ValidateToken(t *testing.T) {
 		},
 	}).SignedString(testSecret)
 
+	refreshToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+		ID:        "10",
+		Subject:   "1",
+	}).SignedString(testSecret)
+
 	tcs := map[string]arg{
+		"refresh token": {
+			token:         refreshToken,
+			expectedError: jwt.ErrTokenInvalidClaims,
+		},
 		"valid token": {
 			token:         validToken,
 			expectedUser:  1,
@@ -236,12 +278,25 @@ func TestAuthService_GenerateRefreshToken(t *testing.T) {
 	userID := int64(1)
 
 	// Setup
+	mockUserRepo := &user.MockRepository{}
+	mockUserRepo.On("CreateRefreshToken", mock.Anything, mock.MatchedBy(func(rt model.RefreshToken) bool {
+		return rt.UserID == userID && rt.FamilyID == 0 && rt.ExpiresAt.After(time.Now())
+	})).Return(model.RefreshToken{
+		ID:        10,
+		UserID:    userID,
+		FamilyID:  10,
+		ExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+	}, nil)
+	mockRepo := &repository.MockRegistry{}
+	mockRepo.On("User").Return(mockUserRepo)
+
 	authService := &AuthService{
+		repo:   mockRepo,
 		secret: testSecret,
 	}
 
 	// Execute
-	token, err := authService.GenerateRefreshToken(userID)
+	token, err := authService.GenerateRefreshToken(context.Background(), userID)
 
 	// Assert
 	require.NoError(t, err)
@@ -254,7 +309,227 @@ func TestAuthService_GenerateRefreshToken(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.True(t, parsedToken.Valid)
-	require.Equal(t, "1", claims.ID)
+	require.Equal(t, "10", claims.ID)
+	require.Equal(t, "1", claims.Subject)
 	require.NotZero(t, claims.ExpiresAt)
 	require.NotZero(t, claims.IssuedAt)
 }
+
+func TestAuthService_Refresh(t *testing.T) {
+	testSecret := []byte("test-secret-key")
+
+	signRefreshToken := func(id string, expiresAt time.Time, secret []byte) string {
+		token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			ID:        id,
+			Subject:   "1",
+		}).SignedString(secret)
+		return token
+	}
+
+	activeToken := model.RefreshToken{ID: 10, UserID: 1, FamilyID: 5, ExpiresAt: time.Now().Add(time.Hour)}
+	revokedToken := model.RefreshToken{ID: 10, UserID: 1, FamilyID: 5, Revoked: true, ExpiresAt: time.Now().Add(time.Hour)}
+	activeUser := model.User{ID: 1, Email: "test@example.com", Status: model.UserStatusActive, Role: model.UserRoleCustomer}
+
+	type arg struct {
+		givenToken       string
+		mockGetTokenCall bool
+		mockToken        model.RefreshToken
+		mockGetTokenErr  error
+		mockGetUserCall  bool
+		mockUser         model.User
+		mockRevokeCall   bool
+		mockRevokeErr    error
+		mockCreateCall   bool
+		expFamilyRevoked bool
+		expErr           error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenToken:       signRefreshToken("10", time.Now().Add(time.Hour), testSecret),
+			mockGetTokenCall: true,
+			mockToken:        activeToken,
+			mockGetUserCall:  true,
+			mockUser:         activeUser,
+			mockRevokeCall:   true,
+			mockCreateCall:   true,
+		},
+		"expired_token": {
+			givenToken: signRefreshToken("10", time.Now().Add(-time.Hour), testSecret),
+			expErr:     ErrTokenExpired,
+		},
+		"wrong_signature": {
+			givenToken: signRefreshToken("10", time.Now().Add(time.Hour), []byte("other-secret")),
+			expErr:     ErrInvalidRefreshToken,
+		},
+		"token_without_id": {
+			givenToken: signRefreshToken("", time.Now().Add(time.Hour), testSecret),
+			expErr:     ErrInvalidRefreshToken,
+		},
+		"unknown_token": {
+			givenToken:       signRefreshToken("10", time.Now().Add(time.Hour), testSecret),
+			mockGetTokenCall: true,
+			mockGetTokenErr:  user.ErrRefreshTokenNotFound,
+			expErr:           ErrInvalidRefreshToken,
+		},
+		"reused_revoked_token": {
+			givenToken:       signRefreshToken("10", time.Now().Add(time.Hour), testSecret),
+			mockGetTokenCall: true,
+			mockToken:        revokedToken,
+			expFamilyRevoked: true,
+			expErr:           ErrRefreshTokenReused,
+		},
+		"concurrently_rotated_token": {
+			givenToken:       signRefreshToken("10", time.Now().Add(time.Hour), testSecret),
+			mockGetTokenCall: true,
+			mockToken:        activeToken,
+			mockGetUserCall:  true,
+			mockUser:         activeUser,
+			mockRevokeCall:   true,
+			mockRevokeErr:    user.ErrRefreshTokenNotFound,
+			expFamilyRevoked: true,
+			expErr:           ErrRefreshTokenReused,
+		},
+		"deleted_user": {
+			givenToken:       signRefreshToken("10", time.Now().Add(time.Hour), testSecret),
+			mockGetTokenCall: true,
+			mockToken:        activeToken,
+			mockGetUserCall:  true,
+			mockUser:         model.User{ID: 1, Status: model.UserStatusDeleted},
+			expErr:           ErrInvalidRefreshToken,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			mockUserRepo := user.NewMockRepository(t)
+			if tc.mockGetTokenCall {
+				mockUserRepo.On("GetRefreshTokenByID", mock.Anything, int64(10)).Return(tc.mockToken, tc.mockGetTokenErr)
+			}
+			if tc.mockGetUserCall {
+				mockUserRepo.On("GetByID", mock.Anything, int64(1)).Return(tc.mockUser, nil)
+			}
+			if tc.mockRevokeCall {
+				mockUserRepo.On("RevokeRefreshToken", mock.Anything, int64(10)).Return(tc.mockRevokeErr)
+			}
+			if tc.mockCreateCall {
+				mockUserRepo.On("CreateRefreshToken", mock.Anything, mock.MatchedBy(func(rt model.RefreshToken) bool {
+					return rt.UserID == 1 && rt.FamilyID == 5
+				})).Return(model.RefreshToken{ID: 11, UserID: 1, FamilyID: 5, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+			}
+			if tc.expFamilyRevoked {
+				mockUserRepo.On("RevokeRefreshTokenFamily", mock.Anything, int64(5)).Return(nil)
+			}
+
+			mockRepo := &repository.MockRegistry{}
+			mockRepo.On("User").Return(mockUserRepo)
+			mockRepo.On("DoInTx", mock.Anything, mock.AnythingOfType("func(context.Context, repository.Registry) error"), mock.Anything).
+				Return(func(ctx context.Context, txFunc func(context.Context, repository.Registry) error, _ backoff.BackOff) error {
+					return txFunc(ctx, mockRepo)
+				}).Maybe()
+
+			authService := &AuthService{
+				repo:   mockRepo,
+				secret: testSecret,
+				signToken: func(token *jwt.Token, secret []byte) (string, error) {
+					return token.SignedString(secret)
+				},
+			}
+
+			// When:
+			pair, err := authService.Refresh(context.Background(), tc.givenToken)
+
+			// Then:
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Equal(t, TokenPair{}, pair)
+			} else {
+				require.NoError(t, err)
+
+				refreshClaims := jwt.RegisteredClaims{}
+				_, err = jwt.ParseWithClaims(pair.RefreshToken, &refreshClaims, func(token *jwt.Token) (interface{}, error) {
+					return testSecret, nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, "11", refreshClaims.ID)
+
+				claims, err := authService.ValidateToken(pair.AccessToken)
+				require.NoError(t, err)
+				require.Equal(t, tc.mockUser.ID, claims.UserID)
+				require.Equal(t, tc.mockUser.Role, claims.Role)
+			}
+		})
+	}
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	testSecret := []byte("test-secret-key")
+
+	validToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		ID:        "10",
+		Subject:   "1",
+	}).SignedString(testSecret)
+
+	type arg struct {
+		givenUserID      int64
+		givenToken       string
+		mockGetTokenCall bool
+		expFamilyRevoked bool
+		expErr           error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenUserID:      1,
+			givenToken:       validToken,
+			mockGetTokenCall: true,
+			expFamilyRevoked: true,
+		},
+		"token_of_other_user": {
+			givenUserID:      2,
+			givenToken:       validToken,
+			mockGetTokenCall: true,
+			expErr:           ErrInvalidRefreshToken,
+		},
+		"invalid_token": {
+			givenUserID: 1,
+			givenToken:  "invalid.token",
+			expErr:      ErrInvalidRefreshToken,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			mockUserRepo := user.NewMockRepository(t)
+			if tc.mockGetTokenCall {
+				mockUserRepo.On("GetRefreshTokenByID", mock.Anything, int64(10)).
+					Return(model.RefreshToken{ID: 10, UserID: 1, FamilyID: 5}, nil)
+			}
+			if tc.expFamilyRevoked {
+				mockUserRepo.On("RevokeRefreshTokenFamily", mock.Anything, int64(5)).Return(nil)
+			}
+
+			mockRepo := &repository.MockRegistry{}
+			mockRepo.On("User").Return(mockUserRepo)
+
+			authService := &AuthService{
+				repo:   mockRepo,
+				secret: testSecret,
+			}
+
+			// When:
+			err := authService.Logout(context.Background(), tc.givenUserID, tc.givenToken)
+
+			// Then:
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -40,6 +40,7 @@ func (m *MiddlewareAuth) Handler() gin.HandlerFunc {
 
 		c.Set("user_id", claims.UserID)
 		c.Set("email", claims.Email)
+		c.Set("role", claims.Role.String())
 		c.Next()
 	}
 }
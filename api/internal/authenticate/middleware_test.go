@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	"omg/api/internal/model"
+
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
@@ -24,6 +26,7 @@ func TestMiddlewareAuth_Handler(t *testing.T) {
 		expectedBody   map[string]interface{}
 		expectedUserID int64
 		expectedEmail  string
+		expectedRole   string
 	}
 
 	tcs := map[string]arg{
@@ -73,6 +76,7 @@ func TestMiddlewareAuth_Handler(t *testing.T) {
 			mockClaims: &Claims{
 				UserID: 123,
 				Email:  "test@example.com",
+				Role:   model.UserRoleStaff,
 				RegisteredClaims: jwt.RegisteredClaims{
 					ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
 					IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -81,6 +85,7 @@ func TestMiddlewareAuth_Handler(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectedUserID: 123,
 			expectedEmail:  "test@example.com",
+			expectedRole:   "STAFF",
 		},
 	}
 
@@ -115,6 +120,7 @@ func TestMiddlewareAuth_Handler(t *testing.T) {
 			// Create a channel to capture context values
 			userIDChan := make(chan int64, 1)
 			emailChan := make(chan string, 1)
+			roleChan := make(chan string, 1)
 
 			// Add test handlers
 			handlers := []gin.HandlerFunc{
@@ -126,6 +132,9 @@ func TestMiddlewareAuth_Handler(t *testing.T) {
 					if val, exists := c.Get("email"); exists {
 						emailChan <- val.(string)
 					}
+					if val, exists := c.Get("role"); exists {
+						roleChan <- val.(string)
+					}
 					c.Status(http.StatusOK)
 				},
 			}
@@ -157,6 +166,12 @@ func TestMiddlewareAuth_Handler(t *testing.T) {
 				default:
 					t.Error("Expected email not found in context")
 				}
+				select {
+				case role := <-roleChan:
+					require.Equal(t, tc.expectedRole, role)
+				default:
+					t.Error("Expected role not found in context")
+				}
 			}
 		})
 	}
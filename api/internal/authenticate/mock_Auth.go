@@ -3,6 +3,8 @@
 package authenticate
 
 import (
+	context "context"
+
 	gin "github.com/gin-gonic/gin"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -12,9 +14,9 @@ type MockAuth struct {
 	mock.Mock
 }
 
-// GenerateRefreshToken provides a mock function with given fields: userID
-func (_m *MockAuth) GenerateRefreshToken(userID int64) (string, error) {
-	ret := _m.Called(userID)
+// GenerateRefreshToken provides a mock function with given fields: ctx, userID
+func (_m *MockAuth) GenerateRefreshToken(ctx context.Context, userID int64) (string, error) {
+	ret := _m.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateRefreshToken")
@@ -22,17 +24,17 @@ func (_m *MockAuth) GenerateRefreshToken(userID int64) (string, error) {
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int64) (string, error)); ok {
-		return rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (string, error)); ok {
+		return rf(ctx, userID)
 	}
-	if rf, ok := ret.Get(0).(func(int64) string); ok {
-		r0 = rf(userID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) string); ok {
+		r0 = rf(ctx, userID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = rf(userID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,22 +43,22 @@ func (_m *MockAuth) GenerateRefreshToken(userID int64) (string, error) {
 }
 
 // Login provides a mock function with given fields: ctx, email, password
-func (_m *MockAuth) Login(ctx *gin.Context, email string, password string) (string, error) {
+func (_m *MockAuth) Login(ctx *gin.Context, email string, password string) (TokenPair, error) {
 	ret := _m.Called(ctx, email, password)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
 	}
 
-	var r0 string
+	var r0 TokenPair
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) (TokenPair, error)); ok {
 		return rf(ctx, email, password)
 	}
-	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) string); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context, string, string) TokenPair); ok {
 		r0 = rf(ctx, email, password)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(TokenPair)
 	}
 
 	if rf, ok := ret.Get(1).(func(*gin.Context, string, string) error); ok {
@@ -68,6 +70,52 @@ func (_m *MockAuth) Login(ctx *gin.Context, email string, password string) (stri
 	return r0, r1
 }
 
+// Logout provides a mock function with given fields: ctx, userID, refreshToken
+func (_m *MockAuth) Logout(ctx context.Context, userID int64, refreshToken string) error {
+	ret := _m.Called(ctx, userID, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, userID, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken
+func (_m *MockAuth) Refresh(ctx context.Context, refreshToken string) (TokenPair, error) {
+	ret := _m.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 TokenPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (TokenPair, error)); ok {
+		return rf(ctx, refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) TokenPair); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Get(0).(TokenPair)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ValidateToken provides a mock function with given fields: tokenString
 func (_m *MockAuth) ValidateToken(tokenString string) (*Claims, error) {
 	ret := _m.Called(tokenString)
@@ -1,6 +1,8 @@
 package authenticate
 
 import (
+	"context"
+
 	"omg/api/internal/repository"
 
 	"github.com/gin-gonic/gin"
@@ -9,9 +11,11 @@ import (
 )
 
 type Auth interface {
-	Login(ctx *gin.Context, email, password string) (string, error)
+	Login(ctx *gin.Context, email, password string) (TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (TokenPair, error)
+	Logout(ctx context.Context, userID int64, refreshToken string) error
 	ValidateToken(tokenString string) (*Claims, error)
-	GenerateRefreshToken(userID int64) (string, error)
+	GenerateRefreshToken(ctx context.Context, userID int64) (string, error)
 }
 
 func NewAuthService(repo repository.Registry, secret string) AuthService {
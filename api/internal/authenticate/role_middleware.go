@@ -0,0 +1,36 @@
+package authenticate
+
+import (
+	"net/http"
+
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewRoleMiddleware returns a middleware only letting through users having one of the given roles.
+// It must run after the auth middleware which puts the role of the caller into the context.
+func NewRoleMiddleware(roles ...model.UserRole) *MiddlewareRole {
+	return &MiddlewareRole{
+		roles: roles,
+	}
+}
+
+type MiddlewareRole struct {
+	roles []model.UserRole
+}
+
+func (m *MiddlewareRole) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := model.UserRole(c.GetString("role"))
+		for _, r := range m.roles {
+			if r == role {
+				c.Next()
+				return
+			}
+		}
+
+		c.JSON(http.StatusForbidden, gin.H{"error": "Permission denied"})
+		c.Abort()
+	}
+}
@@ -0,0 +1,74 @@
+package authenticate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareRole_Handler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type arg struct {
+		givenRoles     []model.UserRole
+		givenRole      string
+		expectedStatus int
+		expectedBody   string
+	}
+
+	tcs := map[string]arg{
+		"allowed role": {
+			givenRoles:     []model.UserRole{model.UserRoleAdmin},
+			givenRole:      "ADMIN",
+			expectedStatus: http.StatusOK,
+		},
+		"one of allowed roles": {
+			givenRoles:     []model.UserRole{model.UserRoleStaff, model.UserRoleAdmin},
+			givenRole:      "STAFF",
+			expectedStatus: http.StatusOK,
+		},
+		"denied role": {
+			givenRoles:     []model.UserRole{model.UserRoleAdmin},
+			givenRole:      "CUSTOMER",
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":"Permission denied"}`,
+		},
+		"missing role": {
+			givenRoles:     []model.UserRole{model.UserRoleStaff, model.UserRoleAdmin},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error":"Permission denied"}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			called := false
+			r := gin.New()
+			r.GET("/", func(c *gin.Context) {
+				if tc.givenRole != "" {
+					c.Set("role", tc.givenRole)
+				}
+			}, NewRoleMiddleware(tc.givenRoles...).Handler(), func(c *gin.Context) {
+				called = true
+				c.Status(http.StatusOK)
+			})
+
+			// When:
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			// Then:
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.Equal(t, tc.expectedStatus == http.StatusOK, called)
+			if tc.expectedBody != "" {
+				require.JSONEq(t, tc.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
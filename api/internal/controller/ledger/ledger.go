@@ -0,0 +1,22 @@
+// Package ledger records the moves of the product stock on hand, shared by the controllers which move the stock
+package ledger
+
+import (
+	"context"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+)
+
+// ErrRecordStockMovement means the stock movement could not be appended to the ledger
+var ErrRecordStockMovement = errors.New("fail to record product stock movement")
+
+// RecordStockMovement appends the move of the product stock on hand to the ledger using the tx which moves the stock
+func RecordStockMovement(ctx context.Context, repo repository.Registry, movement model.StockMovement) error {
+	if _, err := repo.Inventory().CreateStockMovement(ctx, movement); err != nil {
+		return ErrRecordStockMovement
+	}
+
+	return nil
+}
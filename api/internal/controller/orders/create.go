@@ -9,15 +9,44 @@ import (
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
 	"omg/api/pkg/db/pg"
+
+	"github.com/shopspring/decimal"
 )
 
+// CreateOrder creates the order in PENDING & reserves the stock of its items until model.StockReservationTTL passes.
+// With an idempotency key, a retry of the request returns the order created the first time instead.
 func (i impl) CreateOrder(ctx context.Context, inp model.CreateOrderInput) (model.Order, error) {
+	if len(inp.IdempotencyKey) > maxIdempotencyKeyLen {
+		return model.Order{}, ErrInvalidIdempotencyKey
+	}
+
 	var order model.Order
+	var created bool
 
 	txFunc := func(newCtx context.Context, repo repository.Registry) error {
+		created = false
+		if inp.IdempotencyKey != "" {
+			replayed, ok, err := replayOrder(newCtx, repo, inp)
+			if err != nil {
+				return err
+			}
+			if ok {
+				order = replayed
+				return nil
+			}
+		}
+
 		var err error
 		order, err = i.processOrder(newCtx, repo, inp)
-		return err
+		if err != nil {
+			return err
+		}
+		created = true
+
+		if inp.IdempotencyKey != "" {
+			return saveOrderResponse(newCtx, repo, inp, order)
+		}
+		return nil
 	}
 
 	// Create a new context with timeout for the transaction
@@ -26,9 +55,16 @@ func (i impl) CreateOrder(ctx context.Context, inp model.CreateOrderInput) (mode
 
 	// Use the new context with timeout for the transaction
 	if err := i.repo.DoInTx(newCtx, txFunc, pg.ExponentialBackOff(2, 2*time.Minute)); err != nil {
+		if errors.Is(err, ErrProductOutOfStock) {
+			stockOutRejections.WithLabelValues(stockOutOperationCreate).Inc()
+		}
 		return model.Order{}, err
 	}
 
+	if created {
+		ordersCreated.Inc()
+	}
+
 	return order, nil
 }
 
@@ -37,7 +73,7 @@ func (i impl) processOrder(ctx context.Context, repo repository.Registry, inp mo
 	order := model.Order{
 		UserID:    inp.UserID,
 		Status:    model.OrderStatusPending,
-		TotalCost: 0, // Will be updated after processing items
+		TotalCost: decimal.Zero, // Will be updated after processing items
 	}
 
 	order, err := repo.Inventory().CreateOrder(ctx, order)
@@ -45,8 +81,9 @@ func (i impl) processOrder(ctx context.Context, repo repository.Registry, inp mo
 		return model.Order{}, ErrCreateOrder
 	}
 
-	// Process items with the created order ID
-	items, totalCost, err := i.processOrderItems(ctx, repo, order.ID, inp.Items)
+	// Process items with the created order ID, all of them held until the same time
+	expiresAt := time.Now().Add(model.StockReservationTTL)
+	items, totalCost, err := i.processOrderItems(ctx, repo, order.ID, expiresAt, inp.Items)
 	if err != nil {
 		return model.Order{}, err
 	}
@@ -58,51 +95,56 @@ func (i impl) processOrder(ctx context.Context, repo repository.Registry, inp mo
 		return model.Order{}, ErrUpdateOrder
 	}
 
+	if err = recordOrderEvent(ctx, repo, model.EventTypeOrderCreated, order); err != nil {
+		return model.Order{}, err
+	}
+
 	order.OrderItems = items
 	return order, nil
 }
 
-func (i impl) processOrderItems(ctx context.Context, repo repository.Registry, orderID int64, items []model.CreateOrderItemInput) ([]model.OrderItem, float64, error) {
-	var totalCost float64
+func (i impl) processOrderItems(ctx context.Context, repo repository.Registry, orderID int64, expiresAt time.Time, items []model.CreateOrderItemInput) ([]model.OrderItem, decimal.Decimal, error) {
+	totalCost := decimal.Zero
 	var processedItems []model.OrderItem
 
 	for _, item := range items {
-		orderItem, itemCost, err := i.processOrderItem(ctx, repo, orderID, item)
+		orderItem, itemCost, err := i.processOrderItem(ctx, repo, orderID, expiresAt, item)
 		if err != nil {
-			return nil, 0, err
+			return nil, decimal.Zero, err
 		}
 
 		processedItems = append(processedItems, orderItem)
-		totalCost += itemCost
+		totalCost = totalCost.Add(itemCost)
 	}
 
 	return processedItems, totalCost, nil
 }
 
-func (i impl) processOrderItem(ctx context.Context, repo repository.Registry, orderID int64, item model.CreateOrderItemInput) (model.OrderItem, float64, error) {
-	// Find product
-	product, err := repo.Inventory().GetProductByID(ctx, item.ProductID)
+func (i impl) processOrderItem(ctx context.Context, repo repository.Registry, orderID int64, expiresAt time.Time, item model.CreateOrderItemInput) (model.OrderItem, decimal.Decimal, error) {
+	// Reserve stock of product, checked & applied atomically so concurrent orders cannot oversell
+	product, err := repo.Inventory().ReserveProductStock(ctx, item.ProductID, item.Quantity)
 	if err != nil {
-		if errors.Is(err, inventory.ErrProductNotFound) {
-			return model.OrderItem{}, 0, ErrProductNotFound
+		switch {
+		case errors.Is(err, inventory.ErrProductNotFound):
+			return model.OrderItem{}, decimal.Zero, ErrProductNotFound
+		case errors.Is(err, inventory.ErrOutOfStock):
+			return model.OrderItem{}, decimal.Zero, ErrProductOutOfStock
 		}
-		return model.OrderItem{}, 0, ErrGetProduct
+		return model.OrderItem{}, decimal.Zero, ErrUpdateProduct
 	}
 
-	// Check enough stock
-	if product.Stock < item.Quantity {
-		return model.OrderItem{}, 0, ErrProductOutOfStock
+	if _, err = repo.Inventory().CreateStockReservation(ctx, model.StockReservation{
+		OrderID:   orderID,
+		ProductID: item.ProductID,
+		Quantity:  item.Quantity,
+		Status:    model.StockReservationStatusActive,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return model.OrderItem{}, decimal.Zero, ErrReserveStock
 	}
 
-	// Deduct stock of product
-	product.Stock -= item.Quantity
-
-	// Update stock for product
-	if _, err = repo.Inventory().UpdateProduct(ctx, product); err != nil {
-		if errors.Is(err, inventory.ErrProductNotFound) {
-			return model.OrderItem{}, 0, ErrProductNotFound
-		}
-		return model.OrderItem{}, 0, ErrUpdateProduct
+	if err = recordProductStockEvent(ctx, repo, product); err != nil {
+		return model.OrderItem{}, decimal.Zero, err
 	}
 
 	orderItem := model.OrderItem{
@@ -114,9 +156,9 @@ func (i impl) processOrderItem(ctx context.Context, repo repository.Registry, or
 
 	// Insert order item
 	if _, err = repo.Inventory().CreateOrderItem(ctx, orderItem); err != nil {
-		return model.OrderItem{}, 0, ErrCreateOrderItem
+		return model.OrderItem{}, decimal.Zero, ErrCreateOrderItem
 	}
 
-	itemCost := float64(item.Quantity) * product.Price
+	itemCost := product.Price.Mul(decimal.NewFromInt(item.Quantity))
 	return orderItem, itemCost, nil
 }
@@ -9,8 +9,10 @@ import (
 	"omg/api/internal/model"
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
+	"omg/api/internal/repository/outbox"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -21,13 +23,14 @@ func TestImpl_CreateOrder(t *testing.T) {
 		mockCreateOrder          model.Order
 		mockCreateOrderErr       error
 		mockProduct              model.Product
-		mockGetProductErr        error
-		mockUpdateProductErr     error
+		mockReserveStockErr      error
+		mockReservationErr       error
 		mockCreateOrderItemErr   error
 		mockUpdateOrderErr       error
+		mockCreateEventErr       error
+		mockStockEventErr        error
 		expDoInTxCalled          bool
-		expGetProductCalled      bool
-		expUpdateProductCalled   bool
+		expReserveStockCalled    bool
 		expCreateOrderItemCalled bool
 		expCreateOrderCalled     bool
 		expUpdateOrderCalled     bool
@@ -50,12 +53,11 @@ func TestImpl_CreateOrder(t *testing.T) {
 			},
 			mockProduct: model.Product{
 				ID:    456,
-				Price: 10.5,
+				Price: decimal.RequireFromString("10.5"),
 				Stock: 5,
 			},
 			expDoInTxCalled:          true,
-			expGetProductCalled:      true,
-			expUpdateProductCalled:   true,
+			expReserveStockCalled:    true,
 			expCreateOrderItemCalled: true,
 			expCreateOrderCalled:     true,
 			expUpdateOrderCalled:     true,
@@ -63,9 +65,9 @@ func TestImpl_CreateOrder(t *testing.T) {
 				ID:        789,
 				UserID:    123,
 				Status:    model.OrderStatusPending,
-				TotalCost: 21.0,
+				TotalCost: decimal.RequireFromString("21"),
 				OrderItems: []model.OrderItem{
-					{OrderID: 789, ProductID: 456, Quantity: 2, Price: 10.5},
+					{OrderID: 789, ProductID: 456, Quantity: 2, Price: decimal.RequireFromString("10.5")},
 				},
 			},
 		},
@@ -84,12 +86,11 @@ func TestImpl_CreateOrder(t *testing.T) {
 			},
 			mockProduct: model.Product{
 				ID:    456,
-				Price: 10.5,
+				Price: decimal.RequireFromString("10.5"),
 				Stock: 5,
 			},
 			expDoInTxCalled:          true,
-			expGetProductCalled:      true,
-			expUpdateProductCalled:   true,
+			expReserveStockCalled:    true,
 			expCreateOrderItemCalled: true,
 			expCreateOrderCalled:     true,
 			expUpdateOrderCalled:     true,
@@ -97,10 +98,10 @@ func TestImpl_CreateOrder(t *testing.T) {
 				ID:        789,
 				UserID:    123,
 				Status:    model.OrderStatusPending,
-				TotalCost: 36.5, // 2*10.5 + 1*15.5
+				TotalCost: decimal.RequireFromString("36.5"), // 2*10.5 + 1*15.5
 				OrderItems: []model.OrderItem{
-					{OrderID: 789, ProductID: 456, Quantity: 2, Price: 10.5},
-					{OrderID: 789, ProductID: 457, Quantity: 1, Price: 15.5},
+					{OrderID: 789, ProductID: 456, Quantity: 2, Price: decimal.RequireFromString("10.5")},
+					{OrderID: 789, ProductID: 457, Quantity: 1, Price: decimal.RequireFromString("15.5")},
 				},
 			},
 		},
@@ -128,11 +129,11 @@ func TestImpl_CreateOrder(t *testing.T) {
 				UserID: 123,
 				Status: model.OrderStatusPending,
 			},
-			mockGetProductErr:    inventory.ErrProductNotFound,
-			expDoInTxCalled:      true,
-			expGetProductCalled:  true,
-			expCreateOrderCalled: true,
-			expErr:               ErrProductNotFound,
+			mockReserveStockErr:   inventory.ErrProductNotFound,
+			expDoInTxCalled:       true,
+			expReserveStockCalled: true,
+			expCreateOrderCalled:  true,
+			expErr:                ErrProductNotFound,
 		},
 		"product_out_of_stock": {
 			givenInput: model.CreateOrderInput{
@@ -148,13 +149,14 @@ func TestImpl_CreateOrder(t *testing.T) {
 			},
 			mockProduct: model.Product{
 				ID:    456,
-				Price: 10.5,
+				Price: decimal.RequireFromString("10.5"),
 				Stock: 5, // Less than requested quantity
 			},
-			expDoInTxCalled:      true,
-			expGetProductCalled:  true,
-			expCreateOrderCalled: true,
-			expErr:               ErrProductOutOfStock,
+			mockReserveStockErr:   inventory.ErrOutOfStock,
+			expDoInTxCalled:       true,
+			expReserveStockCalled: true,
+			expCreateOrderCalled:  true,
+			expErr:                ErrProductOutOfStock,
 		},
 		"update_product_error": {
 			givenInput: model.CreateOrderInput{
@@ -170,15 +172,14 @@ func TestImpl_CreateOrder(t *testing.T) {
 			},
 			mockProduct: model.Product{
 				ID:    456,
-				Price: 10.5,
+				Price: decimal.RequireFromString("10.5"),
 				Stock: 5,
 			},
-			mockUpdateProductErr:   errors.New("update error"),
-			expDoInTxCalled:        true,
-			expGetProductCalled:    true,
-			expUpdateProductCalled: true,
-			expCreateOrderCalled:   true,
-			expErr:                 ErrUpdateProduct,
+			mockReserveStockErr:   errors.New("update error"),
+			expDoInTxCalled:       true,
+			expReserveStockCalled: true,
+			expCreateOrderCalled:  true,
+			expErr:                ErrUpdateProduct,
 		},
 		"create_order_item_error": {
 			givenInput: model.CreateOrderInput{
@@ -194,17 +195,93 @@ func TestImpl_CreateOrder(t *testing.T) {
 			},
 			mockProduct: model.Product{
 				ID:    456,
-				Price: 10.5,
+				Price: decimal.RequireFromString("10.5"),
 				Stock: 5,
 			},
 			mockCreateOrderItemErr:   errors.New("create item error"),
 			expDoInTxCalled:          true,
-			expGetProductCalled:      true,
-			expUpdateProductCalled:   true,
+			expReserveStockCalled:    true,
 			expCreateOrderItemCalled: true,
 			expCreateOrderCalled:     true,
 			expErr:                   ErrCreateOrderItem,
 		},
+		"create_reservation_error": {
+			givenInput: model.CreateOrderInput{
+				UserID: 123,
+				Items: []model.CreateOrderItemInput{
+					{ProductID: 456, Quantity: 2},
+				},
+			},
+			mockCreateOrder: model.Order{
+				ID:     789,
+				UserID: 123,
+				Status: model.OrderStatusPending,
+			},
+			mockProduct: model.Product{
+				ID:    456,
+				Price: decimal.RequireFromString("10.5"),
+				Stock: 5,
+			},
+			mockReservationErr:    errors.New("insert error"),
+			expDoInTxCalled:       true,
+			expReserveStockCalled: true,
+			expCreateOrderCalled:  true,
+			expErr:                ErrReserveStock,
+		},
+		"record_stock_event_error": {
+			givenInput: model.CreateOrderInput{
+				UserID: 123,
+				Items: []model.CreateOrderItemInput{
+					{ProductID: 456, Quantity: 2},
+				},
+			},
+			mockCreateOrder: model.Order{
+				ID:     789,
+				UserID: 123,
+				Status: model.OrderStatusPending,
+			},
+			mockProduct: model.Product{
+				ID:    456,
+				Price: decimal.RequireFromString("10.5"),
+				Stock: 5,
+			},
+			mockStockEventErr:     errors.New("insert error"),
+			expDoInTxCalled:       true,
+			expReserveStockCalled: true,
+			expCreateOrderCalled:  true,
+			expErr:                ErrRecordStockEvent,
+		},
+		"record_event_error": {
+			givenInput: model.CreateOrderInput{
+				UserID: 123,
+				Items: []model.CreateOrderItemInput{
+					{ProductID: 456, Quantity: 2},
+				},
+			},
+			mockCreateOrder: model.Order{
+				ID:     789,
+				UserID: 123,
+				Status: model.OrderStatusPending,
+			},
+			mockProduct: model.Product{
+				ID:    456,
+				Price: decimal.RequireFromString("10.5"),
+				Stock: 5,
+			},
+			mockCreateEventErr:       errors.New("insert error"),
+			expDoInTxCalled:          true,
+			expReserveStockCalled:    true,
+			expCreateOrderItemCalled: true,
+			expCreateOrderCalled:     true,
+			expUpdateOrderCalled:     true,
+			expResult: model.Order{
+				ID:        789,
+				UserID:    123,
+				Status:    model.OrderStatusPending,
+				TotalCost: decimal.RequireFromString("21"),
+			},
+			expErr: ErrRecordOrderEvent,
+		},
 		"update_order_error": {
 			givenInput: model.CreateOrderInput{
 				UserID: 123,
@@ -219,13 +296,12 @@ func TestImpl_CreateOrder(t *testing.T) {
 			},
 			mockProduct: model.Product{
 				ID:    456,
-				Price: 10.5,
+				Price: decimal.RequireFromString("10.5"),
 				Stock: 5,
 			},
 			mockUpdateOrderErr:       errors.New("update order error"),
 			expDoInTxCalled:          true,
-			expGetProductCalled:      true,
-			expUpdateProductCalled:   true,
+			expReserveStockCalled:    true,
 			expCreateOrderItemCalled: true,
 			expCreateOrderCalled:     true,
 			expUpdateOrderCalled:     true,
@@ -244,15 +320,17 @@ func TestImpl_CreateOrder(t *testing.T) {
 				expectedOrder := model.Order{
 					UserID:    tc.givenInput.UserID,
 					Status:    model.OrderStatusPending,
-					TotalCost: 0,
+					TotalCost: decimal.Zero,
 				}
 				invRepo.On("CreateOrder", mock.Anything, mock.MatchedBy(func(o model.Order) bool {
-					return o.UserID == expectedOrder.UserID && o.Status == expectedOrder.Status && o.TotalCost == expectedOrder.TotalCost
+					return o.UserID == expectedOrder.UserID && o.Status == expectedOrder.Status && o.TotalCost.Equal(expectedOrder.TotalCost)
 				})).Return(tc.mockCreateOrder, tc.mockCreateOrderErr)
 			}
 
+			outboxRepo := outbox.NewMockRepository(t)
+
 			// Setup product mocks for all items
-			if tc.expGetProductCalled {
+			if tc.expReserveStockCalled {
 				for _, item := range tc.givenInput.Items {
 					productID := item.ProductID
 
@@ -261,25 +339,34 @@ func TestImpl_CreateOrder(t *testing.T) {
 					if s == "success_multiple_items" && productID == 457 {
 						mockProduct = model.Product{
 							ID:    457,
-							Price: 15.5,
+							Price: decimal.RequireFromString("15.5"),
 							Stock: 10,
 						}
 					}
 
-					invRepo.On("GetProductByID", mock.Anything, productID).Return(mockProduct, tc.mockGetProductErr)
-
-					if tc.expUpdateProductCalled && tc.mockGetProductErr == nil {
-						// Check that the product stock is reduced by the requested quantity
-						invRepo.On("UpdateProduct", mock.Anything, mock.MatchedBy(func(p model.Product) bool {
-							expectedStock := tc.mockProduct.Stock
-							if s == "success_multiple_items" && productID == 457 {
-								expectedStock = 10 // Original stock for product 457
-							}
-							return p.ID == productID && p.Stock == expectedStock-item.Quantity
-						})).Return(mockProduct, tc.mockUpdateProductErr)
+					// The requested quantity is reserved in DB, leaving the stock on hand as is
+					mockProduct.Reserved += item.Quantity
+					invRepo.On("ReserveProductStock", mock.Anything, productID, item.Quantity).Return(mockProduct, tc.mockReserveStockErr)
+
+					if tc.mockReserveStockErr == nil {
+						// The reservation is held for the order until it expires
+						invRepo.On("CreateStockReservation", mock.Anything, mock.MatchedBy(func(r model.StockReservation) bool {
+							return r.OrderID == tc.mockCreateOrder.ID &&
+								r.ProductID == productID &&
+								r.Quantity == item.Quantity &&
+								r.Status == model.StockReservationStatusActive &&
+								r.ExpiresAt.After(time.Now())
+						})).Return(model.StockReservation{}, tc.mockReservationErr)
+					}
+
+					if tc.mockReserveStockErr == nil && tc.mockReservationErr == nil {
+						// The stock movement is recorded in the same tx
+						outboxRepo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+							return e.Type == model.EventTypeProductStockChanged && e.AggregateID == productID
+						})).Return(model.Event{}, tc.mockStockEventErr)
 					}
 
-					if tc.expCreateOrderItemCalled && tc.mockUpdateProductErr == nil {
+					if tc.expCreateOrderItemCalled && tc.mockReserveStockErr == nil {
 						// Match that order item is created with correct values
 						invRepo.On("CreateOrderItem", mock.Anything, mock.MatchedBy(func(item model.OrderItem) bool {
 							return item.OrderID == tc.mockCreateOrder.ID &&
@@ -293,12 +380,22 @@ func TestImpl_CreateOrder(t *testing.T) {
 			if tc.expUpdateOrderCalled && tc.mockCreateOrderItemErr == nil {
 				// Final update of order with total cost
 				invRepo.On("UpdateOrder", mock.Anything, mock.MatchedBy(func(o model.Order) bool {
-					return o.ID == tc.mockCreateOrder.ID && o.TotalCost > 0
+					return o.ID == tc.mockCreateOrder.ID && o.TotalCost.IsPositive()
 				})).Return(tc.expResult, tc.mockUpdateOrderErr)
 			}
 
+			if tc.expUpdateOrderCalled && tc.mockUpdateOrderErr == nil {
+				// The created order event is recorded in the same tx
+				outboxRepo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+					return e.Type == model.EventTypeOrderCreated &&
+						e.AggregateID == tc.expResult.ID &&
+						e.Status == model.EventStatusPending
+				})).Return(model.Event{}, tc.mockCreateEventErr)
+			}
+
 			mockRepo := &repository.MockRegistry{}
 			mockRepo.On("Inventory").Return(invRepo)
+			mockRepo.On("Outbox").Return(outboxRepo).Maybe()
 
 			if tc.expDoInTxCalled {
 				// Setup DoInTx mock
@@ -378,7 +475,7 @@ func TestImpl_CreateOrder_ContextHandling(t *testing.T) {
 			},
 			expErr: context.Canceled,
 		},
-		"get_product_generic_error": {
+		"reserve_stock_generic_error": {
 			givenInput: model.CreateOrderInput{
 				UserID: 123,
 				Items: []model.CreateOrderItemInput{
@@ -392,16 +489,16 @@ func TestImpl_CreateOrder_ContextHandling(t *testing.T) {
 				expectedOrder := model.Order{
 					UserID:    123,
 					Status:    model.OrderStatusPending,
-					TotalCost: 0,
+					TotalCost: decimal.Zero,
 				}
 
 				// First create order call
 				invRepo.On("CreateOrder", mock.Anything, mock.MatchedBy(func(o model.Order) bool {
-					return o.UserID == expectedOrder.UserID && o.Status == expectedOrder.Status && o.TotalCost == expectedOrder.TotalCost
+					return o.UserID == expectedOrder.UserID && o.Status == expectedOrder.Status && o.TotalCost.Equal(expectedOrder.TotalCost)
 				})).Return(model.Order{ID: 789, UserID: 123, Status: model.OrderStatusPending}, nil)
 
 				// Setup product mock with generic error
-				invRepo.On("GetProductByID", mock.Anything, int64(456)).Return(model.Product{}, errors.New("database error"))
+				invRepo.On("ReserveProductStock", mock.Anything, int64(456), int64(2)).Return(model.Product{}, errors.New("database error"))
 
 				mockRepo.On("Inventory").Return(invRepo)
 
@@ -413,11 +510,11 @@ func TestImpl_CreateOrder_ContextHandling(t *testing.T) {
 						// We're calling the transaction function with the mock registry
 						err := txFunc(context.Background(), mockRepo)
 						require.Error(t, err)
-						require.Equal(t, ErrGetProduct, err)
+						require.Equal(t, ErrUpdateProduct, err)
 					}).
-					Return(ErrGetProduct)
+					Return(ErrUpdateProduct)
 			},
-			expErr: ErrGetProduct,
+			expErr: ErrUpdateProduct,
 		},
 	}
 
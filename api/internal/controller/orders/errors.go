@@ -1,14 +1,33 @@
 package orders
 
-import "errors"
+import (
+	"errors"
+
+	"omg/api/internal/controller/ledger"
+)
 
 var (
-	ErrProductNotFound   = errors.New("product not found")
-	ErrGetProduct        = errors.New("fail to get product")
-	ErrProductOutOfStock = errors.New("product out of stock")
-	ErrUpdateProduct     = errors.New("fail to update product")
-	ErrCreateOrderItem   = errors.New("fail to create order item")
-	ErrCreateOrder       = errors.New("fail to create order")
-	ErrUpdateOrder       = errors.New("fail to update order")
-	ErrOrderNotFound     = errors.New("order not found")
+	ErrProductNotFound         = errors.New("product not found")
+	ErrGetProduct              = errors.New("fail to get product")
+	ErrProductOutOfStock       = errors.New("product out of stock")
+	ErrUpdateProduct           = errors.New("fail to update product")
+	ErrRestockProduct          = errors.New("fail to restock product")
+	ErrReserveStock            = errors.New("fail to reserve product stock")
+	ErrSettleStockReservation  = errors.New("fail to settle product stock reservation")
+	ErrCreateOrderItem         = errors.New("fail to create order item")
+	ErrCreateOrder             = errors.New("fail to create order")
+	ErrUpdateOrder             = errors.New("fail to update order")
+	ErrRecordOrderEvent        = errors.New("fail to record order event")
+	ErrRecordStockEvent        = errors.New("fail to record product stock event")
+	ErrRecordStockMovement     = ledger.ErrRecordStockMovement
+	ErrOrderNotFound           = errors.New("order not found")
+	ErrInvalidOrderStatus      = errors.New("invalid order status")
+	ErrInvalidStatusTransition = errors.New("invalid order status transition")
+	ErrStatusChangeForbidden   = errors.New("order status change not allowed for the user role")
+	ErrUserRequired            = errors.New("user required")
+	ErrInvalidDateRange        = errors.New("invalid date range")
+	ErrInvalidIdempotencyKey   = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyMismatch  = errors.New("idempotency key already used for a different request")
+	ErrCheckIdempotencyKey     = errors.New("fail to check idempotency key")
+	ErrSaveIdempotencyKey      = errors.New("fail to save idempotency key")
 )
@@ -0,0 +1,68 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/db/pg"
+)
+
+// ExpireOrder cancels the pending order whose stock reservations expired & releases its stock.
+// It reports false when the order got paid or cancelled meanwhile, or its reservations did not expire.
+func (i impl) ExpireOrder(ctx context.Context, id int64) (bool, error) {
+	var expired bool
+
+	txFunc := func(newCtx context.Context, repo repository.Registry) error {
+		// Lock the order so a payment racing with the expiry is applied one after another
+		o, err := repo.Inventory().GetOrderByIDWithLock(newCtx, id)
+		if err != nil {
+			if errors.Is(err, inventory.ErrOrderNotFound) {
+				return ErrOrderNotFound
+			}
+			return err
+		}
+
+		if o.Status != model.OrderStatusPending {
+			return nil
+		}
+
+		reservations, err := repo.Inventory().ListOrderStockReservations(newCtx, o.ID)
+		if err != nil {
+			return ErrSettleStockReservation
+		}
+
+		now := time.Now()
+		for _, r := range reservations {
+			if r.IsExpired(now) {
+				expired = true
+				break
+			}
+		}
+		if !expired {
+			return nil
+		}
+
+		// No user makes the change, the sweeper does
+		_, err = i.applyOrderStatus(newCtx, repo, 0, o, model.OrderStatusCancelled)
+		return err
+	}
+
+	// Create a new context with timeout for the transaction
+	newCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	// Use the new context with timeout for the transaction
+	if err := i.repo.DoInTx(newCtx, txFunc, pg.ExponentialBackOff(2, 2*time.Minute)); err != nil {
+		return false, err
+	}
+
+	if expired {
+		observeStatusTransition(model.OrderStatusCancelled)
+	}
+
+	return expired, nil
+}
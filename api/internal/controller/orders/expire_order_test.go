@@ -0,0 +1,125 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/inventory"
+	"omg/api/internal/repository/outbox"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_ExpireOrder(t *testing.T) {
+	type arg struct {
+		givenID                 int64
+		mockOrder               model.Order
+		mockGetErr              error
+		mockReservations        []model.StockReservation
+		mockListReservationsErr error
+
+		expListReservationsCalled bool
+		expCancelled              bool
+		expResult                 bool
+		expErr                    error
+	}
+
+	expired := []model.StockReservation{
+		{ID: 21, OrderID: 15, ProductID: 456, Quantity: 2, Status: model.StockReservationStatusActive, ExpiresAt: time.Now().Add(-time.Minute)},
+		{ID: 22, OrderID: 15, ProductID: 457, Quantity: 1, Status: model.StockReservationStatusActive, ExpiresAt: time.Now().Add(-time.Minute)},
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenID:                   15,
+			mockOrder:                 model.Order{ID: 15, UserID: 1, Status: model.OrderStatusPending},
+			mockReservations:          expired,
+			expListReservationsCalled: true,
+			expCancelled:              true,
+			expResult:                 true,
+		},
+		"not_expired_yet": {
+			givenID:   15,
+			mockOrder: model.Order{ID: 15, UserID: 1, Status: model.OrderStatusPending},
+			mockReservations: []model.StockReservation{
+				{ID: 21, OrderID: 15, ProductID: 456, Quantity: 2, Status: model.StockReservationStatusActive, ExpiresAt: time.Now().Add(time.Minute)},
+			},
+			expListReservationsCalled: true,
+		},
+		"paid_meanwhile": {
+			givenID:   15,
+			mockOrder: model.Order{ID: 15, UserID: 1, Status: model.OrderStatusPaid},
+		},
+		"order_not_found": {
+			givenID:    15,
+			mockGetErr: inventory.ErrOrderNotFound,
+			expErr:     ErrOrderNotFound,
+		},
+		"list_reservations_error": {
+			givenID:                   15,
+			mockOrder:                 model.Order{ID: 15, UserID: 1, Status: model.OrderStatusPending},
+			mockListReservationsErr:   errors.New("database error"),
+			expListReservationsCalled: true,
+			expErr:                    ErrSettleStockReservation,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given:
+			invRepo := inventory.NewMockRepository(t)
+			outboxRepo := outbox.NewMockRepository(t)
+			invRepo.On("GetOrderByIDWithLock", mock.Anything, tc.givenID).Return(tc.mockOrder, tc.mockGetErr)
+			if tc.expListReservationsCalled {
+				// Listed once to check the expiry & once more to release them
+				invRepo.On("ListOrderStockReservations", mock.Anything, tc.givenID).Return(tc.mockReservations, tc.mockListReservationsErr)
+			}
+			if tc.expCancelled {
+				for _, r := range tc.mockReservations {
+					invRepo.On("ReleaseProductStock", mock.Anything, r.ProductID, r.Quantity).
+						Return(model.Product{ID: r.ProductID, Stock: r.Quantity}, nil).Once()
+					outboxRepo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+						return e.Type == model.EventTypeProductStockChanged && e.AggregateID == r.ProductID
+					})).Return(model.Event{}, nil).Once()
+				}
+				invRepo.On("UpdateStockReservationStatus", mock.Anything, tc.givenID, model.StockReservationStatusReleased).
+					Return(int64(len(tc.mockReservations)), nil)
+
+				cancelled := tc.mockOrder
+				cancelled.Status = model.OrderStatusCancelled
+				invRepo.On("UpdateOrder", mock.Anything, cancelled).Return(cancelled, nil)
+				outboxRepo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+					return e.Type == model.EventTypeOrderStatusChanged && e.AggregateID == tc.givenID
+				})).Return(model.Event{}, nil)
+			}
+
+			mockRepo := &repository.MockRegistry{}
+			mockRepo.On("Inventory").Return(invRepo)
+			mockRepo.On("Outbox").Return(outboxRepo).Maybe()
+			mockRepo.On("DoInTx", mock.Anything, mock.AnythingOfType("func(context.Context, repository.Registry) error"), mock.Anything).
+				Return(func(ctx context.Context, txFunc func(context.Context, repository.Registry) error, _ backoff.BackOff) error {
+					return txFunc(ctx, mockRepo)
+				})
+
+			i := New(mockRepo)
+
+			// When:
+			rs, err := i.ExpireOrder(context.Background(), tc.givenID)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+				require.False(t, rs)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResult, rs)
+			}
+		})
+	}
+}
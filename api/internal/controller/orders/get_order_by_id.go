@@ -0,0 +1,28 @@
+package orders
+
+import (
+	"context"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/inventory"
+)
+
+// GetOrderByID gets an order with its items, only when it belongs to the given user.
+// A zero userID allows reading any order.
+func (i impl) GetOrderByID(ctx context.Context, userID int64, orderID int64) (model.Order, error) {
+	o, err := i.repo.Inventory().GetOrderByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, inventory.ErrOrderNotFound) {
+			return model.Order{}, ErrOrderNotFound
+		}
+		return model.Order{}, err
+	}
+
+	// Report other users' orders as not found so their existence is not leaked
+	if userID != 0 && o.UserID != userID {
+		return model.Order{}, ErrOrderNotFound
+	}
+
+	return o, nil
+}
@@ -0,0 +1,94 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/inventory"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_GetOrderByID(t *testing.T) {
+	type arg struct {
+		givenUserID  int64
+		givenOrderID int64
+		mockOrder    model.Order
+		mockErr      error
+		expOrder     model.Order
+		expErr       error
+	}
+
+	order := model.Order{
+		ID:     11,
+		UserID: 1,
+		Status: model.OrderStatusPending,
+		OrderItems: []model.OrderItem{
+			{ID: 1, OrderID: 11, ProductID: 456, Quantity: 2, Price: decimal.RequireFromString("10.5")},
+		},
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenUserID:  1,
+			givenOrderID: 11,
+			mockOrder:    order,
+			expOrder:     order,
+		},
+		"order_of_other_user": {
+			givenUserID:  2,
+			givenOrderID: 11,
+			mockOrder:    order,
+			expErr:       ErrOrderNotFound,
+		},
+		"back_office_reads_any_order": {
+			givenUserID:  0,
+			givenOrderID: 11,
+			mockOrder:    order,
+			expOrder:     order,
+		},
+		"order_not_found": {
+			givenUserID:  1,
+			givenOrderID: 12,
+			mockErr:      inventory.ErrOrderNotFound,
+			expErr:       ErrOrderNotFound,
+		},
+		"database_error": {
+			givenUserID:  1,
+			givenOrderID: 11,
+			mockErr:      errors.New("database error"),
+			expErr:       errors.New("database error"),
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			invRepo := inventory.NewMockRepository(t)
+			invRepo.On("GetOrderByID", mock.Anything, tc.givenOrderID).Return(tc.mockOrder, tc.mockErr)
+
+			mockRepo := &repository.MockRegistry{}
+			mockRepo.On("Inventory").Return(invRepo)
+
+			impl := New(mockRepo)
+
+			// When:
+			rs, err := impl.GetOrderByID(context.Background(), tc.givenUserID, tc.givenOrderID)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, pkgerrors.Cause(err), tc.expErr.Error())
+				require.Equal(t, model.Order{}, rs)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expOrder, rs)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package orders
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+)
+
+// maxIdempotencyKeyLen is the longest idempotency key a client can send, a UUID fits with room to spare
+const maxIdempotencyKeyLen = 255
+
+// replayOrder reserves the idempotency key of the request using the tx which creates the order.
+// When the key was already used for the same request, the order it created is returned to be replayed instead.
+func replayOrder(ctx context.Context, repo repository.Registry, inp model.CreateOrderInput) (model.Order, bool, error) {
+	hash, err := requestHash(inp)
+	if err != nil {
+		return model.Order{}, false, ErrCheckIdempotencyKey
+	}
+
+	key, reserved, err := repo.Idempotency().ReserveKey(ctx, model.IdempotencyKey{
+		UserID:      idempotencyKeyOwner(inp),
+		Key:         inp.IdempotencyKey,
+		RequestHash: hash,
+	}, model.IdempotencyKeyRetention)
+	if err != nil {
+		return model.Order{}, false, ErrCheckIdempotencyKey
+	}
+	if reserved {
+		return model.Order{}, false, nil
+	}
+
+	if key.RequestHash != hash {
+		return model.Order{}, false, ErrIdempotencyKeyMismatch
+	}
+
+	var order model.Order
+	if err = json.Unmarshal(key.Response, &order); err != nil {
+		return model.Order{}, false, ErrCheckIdempotencyKey
+	}
+
+	return order, true, nil
+}
+
+// saveOrderResponse keeps the created order for the retries of the request to replay it
+func saveOrderResponse(ctx context.Context, repo repository.Registry, inp model.CreateOrderInput, order model.Order) error {
+	response, err := json.Marshal(order)
+	if err != nil {
+		return ErrSaveIdempotencyKey
+	}
+
+	if err = repo.Idempotency().SaveResponse(ctx, model.IdempotencyKey{
+		UserID:   idempotencyKeyOwner(inp),
+		Key:      inp.IdempotencyKey,
+		Response: response,
+	}); err != nil {
+		return ErrSaveIdempotencyKey
+	}
+
+	return nil
+}
+
+// requestHash fingerprints what the order is created from, to tell a retry from another request reusing the key
+func requestHash(inp model.CreateOrderInput) (string, error) {
+	b, err := json.Marshal(struct {
+		UserID int64
+		Items  []model.CreateOrderItemInput
+	}{
+		UserID: inp.UserID,
+		Items:  inp.Items,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// idempotencyKeyOwner is the user the keys are scoped to, i.e. the one placing the order
+func idempotencyKeyOwner(inp model.CreateOrderInput) int64 {
+	if inp.RequestedBy != 0 {
+		return inp.RequestedBy
+	}
+	return inp.UserID
+}
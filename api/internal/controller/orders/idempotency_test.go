@@ -0,0 +1,153 @@
+package orders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/idempotency"
+	"omg/api/internal/repository/inventory"
+	"omg/api/internal/repository/outbox"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_CreateOrder_IdempotencyKey(t *testing.T) {
+	input := model.CreateOrderInput{
+		UserID:         123,
+		Items:          []model.CreateOrderItemInput{{ProductID: 456, Quantity: 2}},
+		IdempotencyKey: "retry-me",
+		RequestedBy:    123,
+	}
+	hash, err := requestHash(input)
+	require.NoError(t, err)
+
+	created := model.Order{
+		ID:        789,
+		UserID:    123,
+		Status:    model.OrderStatusPending,
+		TotalCost: decimal.RequireFromString("21"),
+		OrderItems: []model.OrderItem{
+			{OrderID: 789, ProductID: 456, Quantity: 2, Price: decimal.RequireFromString("10.5")},
+		},
+	}
+	response, err := json.Marshal(created)
+	require.NoError(t, err)
+
+	type arg struct {
+		givenInput      model.CreateOrderInput
+		mockStoredKey   model.IdempotencyKey
+		mockReserved    bool
+		mockReserveErr  error
+		mockSaveErr     error
+		expDoInTxCalled bool
+		expProcessed    bool
+		expResult       model.Order
+		expErr          error
+	}
+
+	tcs := map[string]arg{
+		"first_request": {
+			givenInput:      input,
+			mockReserved:    true,
+			expDoInTxCalled: true,
+			expProcessed:    true,
+			expResult:       created,
+		},
+		"retry_replayed": {
+			givenInput:      input,
+			mockStoredKey:   model.IdempotencyKey{UserID: 123, Key: "retry-me", RequestHash: hash, Response: response},
+			expDoInTxCalled: true,
+			expResult:       created,
+		},
+		"key_reused_for_another_request": {
+			givenInput:      input,
+			mockStoredKey:   model.IdempotencyKey{UserID: 123, Key: "retry-me", RequestHash: "other", Response: response},
+			expDoInTxCalled: true,
+			expErr:          ErrIdempotencyKeyMismatch,
+		},
+		"key_too_long": {
+			givenInput: model.CreateOrderInput{UserID: 123, IdempotencyKey: strings.Repeat("k", maxIdempotencyKeyLen+1)},
+			expErr:     ErrInvalidIdempotencyKey,
+		},
+		"reserve_error": {
+			givenInput:      input,
+			mockReserveErr:  errors.New("database error"),
+			expDoInTxCalled: true,
+			expErr:          ErrCheckIdempotencyKey,
+		},
+		"save_error": {
+			givenInput:      input,
+			mockReserved:    true,
+			mockSaveErr:     errors.New("database error"),
+			expDoInTxCalled: true,
+			expProcessed:    true,
+			expErr:          ErrSaveIdempotencyKey,
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			idemRepo := idempotency.NewMockRepository(t)
+			invRepo := inventory.NewMockRepository(t)
+			outboxRepo := outbox.NewMockRepository(t)
+
+			if tc.expDoInTxCalled {
+				idemRepo.On("ReserveKey", mock.Anything, model.IdempotencyKey{
+					UserID:      123,
+					Key:         "retry-me",
+					RequestHash: hash,
+				}, model.IdempotencyKeyRetention).Return(tc.mockStoredKey, tc.mockReserved, tc.mockReserveErr)
+			}
+
+			if tc.expProcessed {
+				invRepo.On("CreateOrder", mock.Anything, mock.Anything).Return(model.Order{ID: 789, UserID: 123, Status: model.OrderStatusPending}, nil)
+				invRepo.On("ReserveProductStock", mock.Anything, int64(456), int64(2)).
+					Return(model.Product{ID: 456, Price: decimal.RequireFromString("10.5"), Stock: 5, Reserved: 2}, nil)
+				invRepo.On("CreateStockReservation", mock.Anything, mock.Anything).Return(model.StockReservation{}, nil)
+				invRepo.On("CreateOrderItem", mock.Anything, mock.Anything).Return(model.OrderItem{}, nil)
+				invRepo.On("UpdateOrder", mock.Anything, mock.Anything).Return(func(_ context.Context, o model.Order) model.Order {
+					return o
+				}, nil)
+				outboxRepo.On("CreateEvent", mock.Anything, mock.Anything).Return(model.Event{}, nil)
+				idemRepo.On("SaveResponse", mock.Anything, model.IdempotencyKey{
+					UserID:   123,
+					Key:      "retry-me",
+					Response: response,
+				}).Return(tc.mockSaveErr)
+			}
+
+			mockRepo := repository.NewMockRegistry(t)
+			if tc.expDoInTxCalled {
+				mockRepo.On("DoInTx", mock.Anything, mock.Anything, mock.Anything).Return(
+					func(ctx context.Context, txFunc func(context.Context, repository.Registry) error, _ backoff.BackOff) error {
+						return txFunc(ctx, mockRepo)
+					})
+			}
+			mockRepo.On("Idempotency").Return(idemRepo).Maybe()
+			mockRepo.On("Inventory").Return(invRepo).Maybe()
+			mockRepo.On("Outbox").Return(outboxRepo).Maybe()
+
+			// When:
+			order, err := New(mockRepo).CreateOrder(context.Background(), tc.givenInput)
+
+			// Then:
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResult.ID, order.ID)
+				require.True(t, tc.expResult.TotalCost.Equal(order.TotalCost))
+				require.Len(t, order.OrderItems, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package orders
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/inventory"
+)
+
+// ListOrders gets the orders of the given user with their items
+func (i impl) ListOrders(ctx context.Context, inp model.ListOrdersInput) ([]model.Order, error) {
+	if inp.UserID == 0 {
+		return nil, ErrUserRequired
+	}
+
+	for _, s := range inp.Status {
+		if !s.IsValid() {
+			return nil, ErrInvalidOrderStatus
+		}
+	}
+
+	if !inp.CreatedFrom.IsZero() && !inp.CreatedTo.IsZero() && inp.CreatedFrom.After(inp.CreatedTo) {
+		return nil, ErrInvalidDateRange
+	}
+
+	rs, err := i.repo.Inventory().ListOrders(ctx, inventory.OrdersFilter{
+		UserID:      inp.UserID,
+		Status:      inp.Status,
+		CreatedFrom: inp.CreatedFrom,
+		CreatedTo:   inp.CreatedTo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,105 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/inventory"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_ListOrders(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	type arg struct {
+		givenInput    model.ListOrdersInput
+		expRepoCalled bool
+		expFilter     inventory.OrdersFilter
+		mockOrders    []model.Order
+		mockErr       error
+		expErr        error
+	}
+
+	orders := []model.Order{
+		{ID: 11, UserID: 1, Status: model.OrderStatusPaid},
+		{ID: 12, UserID: 1, Status: model.OrderStatusPending},
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenInput:    model.ListOrdersInput{UserID: 1},
+			expRepoCalled: true,
+			expFilter:     inventory.OrdersFilter{UserID: 1},
+			mockOrders:    orders,
+		},
+		"success_with_filters": {
+			givenInput: model.ListOrdersInput{
+				UserID:      1,
+				Status:      []model.OrderStatus{model.OrderStatusPaid, model.OrderStatusPending},
+				CreatedFrom: from,
+				CreatedTo:   to,
+			},
+			expRepoCalled: true,
+			expFilter: inventory.OrdersFilter{
+				UserID:      1,
+				Status:      []model.OrderStatus{model.OrderStatusPaid, model.OrderStatusPending},
+				CreatedFrom: from,
+				CreatedTo:   to,
+			},
+			mockOrders: orders,
+		},
+		"missing_user": {
+			givenInput: model.ListOrdersInput{},
+			expErr:     ErrUserRequired,
+		},
+		"invalid_status": {
+			givenInput: model.ListOrdersInput{UserID: 1, Status: []model.OrderStatus{"UNKNOWN"}},
+			expErr:     ErrInvalidOrderStatus,
+		},
+		"invalid_date_range": {
+			givenInput: model.ListOrdersInput{UserID: 1, CreatedFrom: to, CreatedTo: from},
+			expErr:     ErrInvalidDateRange,
+		},
+		"database_error": {
+			givenInput:    model.ListOrdersInput{UserID: 1},
+			expRepoCalled: true,
+			expFilter:     inventory.OrdersFilter{UserID: 1},
+			mockErr:       errors.New("database error"),
+			expErr:        errors.New("database error"),
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			invRepo := inventory.NewMockRepository(t)
+			if tc.expRepoCalled {
+				invRepo.On("ListOrders", mock.Anything, tc.expFilter).Return(tc.mockOrders, tc.mockErr)
+			}
+
+			mockRepo := &repository.MockRegistry{}
+			mockRepo.On("Inventory").Return(invRepo)
+
+			impl := New(mockRepo)
+
+			// When:
+			rs, err := impl.ListOrders(context.Background(), tc.givenInput)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+				require.Nil(t, rs)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.mockOrders, rs)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package orders
+
+import (
+	"omg/api/internal/model"
+	"omg/api/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// The operations rejected for lack of stock
+const (
+	stockOutOperationCreate = "create_order"
+	stockOutOperationPay    = "pay_order"
+)
+
+var (
+	ordersCreated = promauto.With(metrics.Registry).NewCounter(prometheus.CounterOpts{
+		Name: "orders_created_total",
+		Help: "Orders created, the replays of an idempotency key excluded.",
+	})
+	orderStatusTransitions = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Name: "order_status_transitions_total",
+		Help: "Orders moved to a new status, the expiries of the pending ones included, by new status.",
+	}, []string{"status"})
+	stockOutRejections = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Name: "stock_out_rejections_total",
+		Help: "Operations rejected as a product is out of stock, by operation.",
+	}, []string{"operation"})
+)
+
+// observeStatusTransition counts the order moved to the status, once the change is committed
+func observeStatusTransition(status model.OrderStatus) {
+	orderStatusTransitions.WithLabelValues(status.String()).Inc()
+}
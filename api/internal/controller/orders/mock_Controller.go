@@ -42,9 +42,95 @@ func (_m *MockController) CreateOrder(_a0 context.Context, _a1 model.CreateOrder
 	return r0, r1
 }
 
-// UpdateOrderStatus provides a mock function with given fields: _a0, _a1, _a2
-func (_m *MockController) UpdateOrderStatus(_a0 context.Context, _a1 int64, _a2 model.OrderStatus) (model.Order, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// ExpireOrder provides a mock function with given fields: ctx, orderID
+func (_m *MockController) ExpireOrder(ctx context.Context, orderID int64) (bool, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireOrder")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderByID provides a mock function with given fields: ctx, userID, orderID
+func (_m *MockController) GetOrderByID(ctx context.Context, userID int64, orderID int64) (model.Order, error) {
+	ret := _m.Called(ctx, userID, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByID")
+	}
+
+	var r0 model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Order, error)); ok {
+		return rf(ctx, userID, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Order); ok {
+		r0 = rf(ctx, userID, orderID)
+	} else {
+		r0 = ret.Get(0).(model.Order)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrders provides a mock function with given fields: _a0, _a1
+func (_m *MockController) ListOrders(_a0 context.Context, _a1 model.ListOrdersInput) ([]model.Order, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListOrdersInput) ([]model.Order, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListOrdersInput) []model.Order); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListOrdersInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOrderStatus provides a mock function with given fields: ctx, actorID, actorRole, orderID, status
+func (_m *MockController) UpdateOrderStatus(ctx context.Context, actorID int64, actorRole model.UserRole, orderID int64, status model.OrderStatus) (model.Order, error) {
+	ret := _m.Called(ctx, actorID, actorRole, orderID, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateOrderStatus")
@@ -52,17 +138,17 @@ func (_m *MockController) UpdateOrderStatus(_a0 context.Context, _a1 int64, _a2
 
 	var r0 model.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, model.OrderStatus) (model.Order, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.UserRole, int64, model.OrderStatus) (model.Order, error)); ok {
+		return rf(ctx, actorID, actorRole, orderID, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, model.OrderStatus) model.Order); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.UserRole, int64, model.OrderStatus) model.Order); ok {
+		r0 = rf(ctx, actorID, actorRole, orderID, status)
 	} else {
 		r0 = ret.Get(0).(model.Order)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, model.OrderStatus) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.UserRole, int64, model.OrderStatus) error); ok {
+		r1 = rf(ctx, actorID, actorRole, orderID, status)
 	} else {
 		r1 = ret.Error(1)
 	}
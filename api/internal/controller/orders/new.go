@@ -10,7 +10,10 @@ import (
 // Controller represents the specification of this pkg
 type Controller interface {
 	CreateOrder(context.Context, model.CreateOrderInput) (model.Order, error)
-	UpdateOrderStatus(context.Context, int64, model.OrderStatus) (model.Order, error)
+	UpdateOrderStatus(ctx context.Context, actorID int64, actorRole model.UserRole, orderID int64, status model.OrderStatus) (model.Order, error)
+	GetOrderByID(ctx context.Context, userID int64, orderID int64) (model.Order, error)
+	ListOrders(context.Context, model.ListOrdersInput) ([]model.Order, error)
+	ExpireOrder(ctx context.Context, orderID int64) (bool, error)
 }
 
 // New initializes a new Controller instance and returns it
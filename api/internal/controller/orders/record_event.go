@@ -0,0 +1,37 @@
+package orders
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+)
+
+// recordOrderEvent writes the order event into the outbox using the tx which changes the order,
+// so the event is published if and only if the change is committed
+func recordOrderEvent(ctx context.Context, repo repository.Registry, eventType model.EventType, order model.Order) error {
+	event, err := model.NewOrderEvent(eventType, order)
+	if err != nil {
+		return ErrRecordOrderEvent
+	}
+
+	if _, err = repo.Outbox().CreateEvent(ctx, event); err != nil {
+		return ErrRecordOrderEvent
+	}
+
+	return nil
+}
+
+// recordProductStockEvent writes the stock changed event of the product into the outbox using the tx which moves the stock
+func recordProductStockEvent(ctx context.Context, repo repository.Registry, product model.Product) error {
+	event, err := model.NewProductStockEvent(product)
+	if err != nil {
+		return ErrRecordStockEvent
+	}
+
+	if _, err = repo.Outbox().CreateEvent(ctx, event); err != nil {
+		return ErrRecordStockEvent
+	}
+
+	return nil
+}
@@ -3,14 +3,59 @@ package orders
 import (
 	"context"
 	"errors"
+	"time"
 
+	"omg/api/internal/controller/ledger"
 	"omg/api/internal/model"
+	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/db/pg"
 )
 
-func (i impl) UpdateOrderStatus(ctx context.Context, id int64, status model.OrderStatus) (model.Order, error) {
-	// Check if product with this name already exists
-	o, err := i.repo.Inventory().GetOrderByID(ctx, id)
+// UpdateOrderStatus moves an order to the given status on behalf of the actor, recorded in the stock ledger along
+// the stock it moves. Back-office users may move any order to any status, customers may only cancel their own orders.
+func (i impl) UpdateOrderStatus(ctx context.Context, actorID int64, actorRole model.UserRole, id int64, status model.OrderStatus) (model.Order, error) {
+	if !status.IsValid() {
+		return model.Order{}, ErrInvalidOrderStatus
+	}
+	if !status.CanBeSetBy(actorRole) {
+		return model.Order{}, ErrStatusChangeForbidden
+	}
+
+	// Back-office users may act on any order
+	userID := actorID
+	if actorRole.IsBackOffice() {
+		userID = 0
+	}
+
+	var order model.Order
+
+	txFunc := func(newCtx context.Context, repo repository.Registry) error {
+		var err error
+		order, err = i.processOrderStatus(newCtx, repo, actorID, userID, id, status)
+		return err
+	}
+
+	// Create a new context with timeout for the transaction
+	newCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	// Use the new context with timeout for the transaction
+	if err := i.repo.DoInTx(newCtx, txFunc, pg.ExponentialBackOff(2, 2*time.Minute)); err != nil {
+		if errors.Is(err, ErrProductOutOfStock) {
+			stockOutRejections.WithLabelValues(stockOutOperationPay).Inc()
+		}
+		return model.Order{}, err
+	}
+
+	observeStatusTransition(order.Status)
+
+	return order, nil
+}
+
+func (i impl) processOrderStatus(ctx context.Context, repo repository.Registry, actorID int64, userID int64, id int64, status model.OrderStatus) (model.Order, error) {
+	// Lock the order so concurrent status updates are applied one after another
+	o, err := repo.Inventory().GetOrderByIDWithLock(ctx, id)
 	if err != nil {
 		if errors.Is(err, inventory.ErrOrderNotFound) {
 			return model.Order{}, ErrOrderNotFound
@@ -19,10 +64,29 @@ func (i impl) UpdateOrderStatus(ctx context.Context, id int64, status model.Orde
 		return model.Order{}, err
 	}
 
+	// Report other users' orders as not found so their existence is not leaked
+	if userID != 0 && o.UserID != userID {
+		return model.Order{}, ErrOrderNotFound
+	}
+
+	return i.applyOrderStatus(ctx, repo, actorID, o, status)
+}
+
+// applyOrderStatus moves the locked order to the given status & settles the stock of its items accordingly
+func (i impl) applyOrderStatus(ctx context.Context, repo repository.Registry, actorID int64, o model.Order, status model.OrderStatus) (model.Order, error) {
+	// Only allow moving along the declared status transitions
+	if !o.Status.CanTransitionTo(status) {
+		return model.Order{}, ErrInvalidStatusTransition
+	}
+
+	if err := i.settleOrderStock(ctx, repo, actorID, o, status); err != nil {
+		return model.Order{}, err
+	}
+
 	o.Status = status
 
 	// Update status
-	rs, err := i.repo.Inventory().UpdateOrder(ctx, o)
+	rs, err := repo.Inventory().UpdateOrder(ctx, o)
 	if err != nil {
 		if errors.Is(err, inventory.ErrOrderNotFound) {
 			return model.Order{}, ErrOrderNotFound
@@ -30,5 +94,132 @@ func (i impl) UpdateOrderStatus(ctx context.Context, id int64, status model.Orde
 		return model.Order{}, err
 	}
 
+	if err = recordOrderEvent(ctx, repo, model.EventTypeOrderStatusChanged, rs); err != nil {
+		return model.Order{}, err
+	}
+
 	return rs, nil
 }
+
+// settleOrderStock takes or gives back the stock of the order items for the order leaving its current status.
+// A pending order only holds reservations, which get consumed once paid & released otherwise.
+func (i impl) settleOrderStock(ctx context.Context, repo repository.Registry, actorID int64, o model.Order, status model.OrderStatus) error {
+	if o.Status == model.OrderStatusPending {
+		reservations, err := repo.Inventory().ListOrderStockReservations(ctx, o.ID)
+		if err != nil {
+			return ErrSettleStockReservation
+		}
+
+		// Orders placed before stock got reserved had it deducted right away, they are restocked as the others below
+		if len(reservations) > 0 {
+			switch {
+			case status == model.OrderStatusPaid:
+				return i.consumeStockReservations(ctx, repo, actorID, o.ID, reservations)
+			case status.ReleasesStock():
+				return i.releaseStockReservations(ctx, repo, o.ID, reservations)
+			}
+			return nil
+		}
+	}
+
+	// Give the stock back only once, when the order first leaves the stock holding statuses
+	if status.ReleasesStock() && !o.Status.ReleasesStock() {
+		return i.restockOrderItems(ctx, repo, actorID, o.ID, o.OrderItems)
+	}
+
+	return nil
+}
+
+// consumeStockReservations takes the reserved stock from the products for good.
+// What is available to order stays the same, so no stock event is recorded.
+func (i impl) consumeStockReservations(ctx context.Context, repo repository.Registry, actorID int64, orderID int64, reservations []model.StockReservation) error {
+	for _, r := range reservations {
+		if r.Status != model.StockReservationStatusActive {
+			continue
+		}
+
+		product, err := repo.Inventory().ConsumeProductStock(ctx, r.ProductID, r.Quantity)
+		if err != nil {
+			switch {
+			case errors.Is(err, inventory.ErrProductNotFound):
+				return ErrProductNotFound
+			case errors.Is(err, inventory.ErrOutOfStock):
+				return ErrProductOutOfStock
+			}
+			return ErrSettleStockReservation
+		}
+
+		if err = ledger.RecordStockMovement(ctx, repo, model.StockMovement{
+			ProductID: product.ID,
+			Delta:     -r.Quantity,
+			Balance:   product.Stock,
+			Reason:    model.StockMovementReasonSale,
+			OrderID:   orderID,
+			ActorID:   actorID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if _, err := repo.Inventory().UpdateStockReservationStatus(ctx, orderID, model.StockReservationStatusConsumed); err != nil {
+		return ErrSettleStockReservation
+	}
+
+	return nil
+}
+
+// releaseStockReservations gives the reserved stock back to what is available to order
+func (i impl) releaseStockReservations(ctx context.Context, repo repository.Registry, orderID int64, reservations []model.StockReservation) error {
+	for _, r := range reservations {
+		if r.Status != model.StockReservationStatusActive {
+			continue
+		}
+
+		product, err := repo.Inventory().ReleaseProductStock(ctx, r.ProductID, r.Quantity)
+		if err != nil {
+			if errors.Is(err, inventory.ErrProductNotFound) {
+				return ErrProductNotFound
+			}
+			return ErrSettleStockReservation
+		}
+
+		if err = recordProductStockEvent(ctx, repo, product); err != nil {
+			return err
+		}
+	}
+
+	if _, err := repo.Inventory().UpdateStockReservationStatus(ctx, orderID, model.StockReservationStatusReleased); err != nil {
+		return ErrSettleStockReservation
+	}
+
+	return nil
+}
+
+func (i impl) restockOrderItems(ctx context.Context, repo repository.Registry, actorID int64, orderID int64, items []model.OrderItem) error {
+	for _, item := range items {
+		product, err := repo.Inventory().IncreaseProductStock(ctx, item.ProductID, item.Quantity)
+		if err != nil {
+			if errors.Is(err, inventory.ErrProductNotFound) {
+				return ErrProductNotFound
+			}
+			return ErrRestockProduct
+		}
+
+		if err = ledger.RecordStockMovement(ctx, repo, model.StockMovement{
+			ProductID: product.ID,
+			Delta:     item.Quantity,
+			Balance:   product.Stock,
+			Reason:    model.StockMovementReasonRestock,
+			OrderID:   orderID,
+			ActorID:   actorID,
+		}); err != nil {
+			return err
+		}
+
+		if err = recordProductStockEvent(ctx, repo, product); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,55 +8,140 @@ import (
 	"omg/api/internal/model"
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
+	"omg/api/internal/repository/outbox"
 
+	"github.com/cenkalti/backoff/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestImpl_UpdateOrderStatus(t *testing.T) {
 	type arg struct {
-		givenID       int64
-		givenStatus   model.OrderStatus
-		mockOrder     model.Order
-		mockGetErr    error
-		mockUpdateErr error
+		givenRole      model.UserRole
+		givenID        int64
+		givenStatus    model.OrderStatus
+		mockOrder      model.Order
+		mockGetErr     error
+		mockRestockErr error
+		mockUpdateErr  error
+		mockEventErr   error
 
-		expGetCalled    bool
-		expUpdateCalled bool
-		expErr          error
+		mockReservations        []model.StockReservation
+		mockListReservationsErr error
+		mockSettleErr           error
+		mockMovementErr         error
+
+		expGetCalled              bool
+		expListReservationsCalled bool
+		expConsumeCalled          bool
+		expReleaseCalled          bool
+		expRestockCalled          bool
+		expUpdateCalled           bool
+		expErr                    error
+	}
+
+	orderItems := []model.OrderItem{
+		{ID: 1, OrderID: 11, ProductID: 456, Quantity: 2, Price: decimal.RequireFromString("10.5")},
+		{ID: 2, OrderID: 11, ProductID: 457, Quantity: 1, Price: decimal.RequireFromString("15.5")},
+	}
+	const actorID = int64(99)
+	reservations := []model.StockReservation{
+		{ID: 21, OrderID: 15, ProductID: 456, Quantity: 2, Status: model.StockReservationStatusActive},
+		{ID: 22, OrderID: 15, ProductID: 457, Quantity: 1, Status: model.StockReservationStatusActive},
 	}
 
 	tcs := map[string]arg{
 		"success": {
+			givenRole:   model.UserRoleStaff,
 			givenID:     1,
-			givenStatus: model.OrderStatusShipped,
+			givenStatus: model.OrderStatusPaid,
 			mockOrder: model.Order{
 				ID:     1,
+				UserID: 1,
 				Status: model.OrderStatusPending,
 			},
-			expGetCalled:    true,
-			expUpdateCalled: true,
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expUpdateCalled:           true,
 		},
 		"order_not_found_on_get": {
+			givenRole:    model.UserRoleStaff,
 			givenID:      2,
 			givenStatus:  model.OrderStatusCancelled,
 			mockGetErr:   inventory.ErrOrderNotFound,
 			expGetCalled: true,
 			expErr:       ErrOrderNotFound,
 		},
+		"order_of_other_user": {
+			givenRole:   model.UserRoleCustomer,
+			givenID:     12,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         12,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			expGetCalled: true,
+			expErr:       ErrOrderNotFound,
+		},
+		"customer_cancels_own_order": {
+			givenRole:   model.UserRoleCustomer,
+			givenID:     16,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         16,
+				UserID:     actorID,
+				Status:     model.OrderStatusPaid,
+				OrderItems: orderItems,
+			},
+			expGetCalled:     true,
+			expRestockCalled: true,
+			expUpdateCalled:  true,
+		},
+		"customer_cannot_pay": {
+			givenRole:   model.UserRoleCustomer,
+			givenID:     17,
+			givenStatus: model.OrderStatusPaid,
+			expErr:      ErrStatusChangeForbidden,
+		},
+		"customer_cannot_refund": {
+			givenRole:   model.UserRoleCustomer,
+			givenID:     18,
+			givenStatus: model.OrderStatusRefunded,
+			expErr:      ErrStatusChangeForbidden,
+		},
+		"back_office_updates_any_order": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     13,
+			givenStatus: model.OrderStatusPaid,
+			mockOrder: model.Order{
+				ID:     13,
+				UserID: 1,
+				Status: model.OrderStatusPending,
+			},
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expUpdateCalled:           true,
+		},
 		"order_not_found_on_update": {
+			givenRole:   model.UserRoleStaff,
 			givenID:     4,
 			givenStatus: model.OrderStatusCancelled,
 			mockOrder: model.Order{
 				ID:     4,
+				UserID: 1,
 				Status: model.OrderStatusPending,
 			},
-			mockUpdateErr:   inventory.ErrOrderNotFound,
-			expGetCalled:    true,
-			expUpdateCalled: true,
-			expErr:          ErrOrderNotFound,
+			mockUpdateErr:             inventory.ErrOrderNotFound,
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expUpdateCalled:           true,
+			expErr:                    ErrOrderNotFound,
 		},
 		"generic_error_on_get": {
+			givenRole:    model.UserRoleStaff,
 			givenID:      3,
 			givenStatus:  model.OrderStatusPaid,
 			mockGetErr:   errors.New("database connection error"),
@@ -64,31 +149,398 @@ func TestImpl_UpdateOrderStatus(t *testing.T) {
 			expErr:       errors.New("database connection error"),
 		},
 		"generic_error_on_update": {
+			givenRole:   model.UserRoleStaff,
 			givenID:     5,
 			givenStatus: model.OrderStatusPaid,
 			mockOrder: model.Order{
 				ID:     5,
+				UserID: 1,
 				Status: model.OrderStatusPending,
 			},
-			mockUpdateErr:   errors.New("database error"),
-			expGetCalled:    true,
-			expUpdateCalled: true,
-			expErr:          errors.New("database error"),
+			mockUpdateErr:             errors.New("database error"),
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expUpdateCalled:           true,
+			expErr:                    errors.New("database error"),
 		},
 		"zero_id_check": {
-			givenID:         0,
-			givenStatus:     model.OrderStatusPaid,
-			mockOrder:       model.Order{},
+			givenRole:   model.UserRoleStaff,
+			givenID:     0,
+			givenStatus: model.OrderStatusPaid,
+			mockOrder: model.Order{
+				UserID: 1,
+				Status: model.OrderStatusPending,
+			},
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expUpdateCalled:           true,
+		},
+		"success_shipped_to_delivered": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     6,
+			givenStatus: model.OrderStatusDelivered,
+			mockOrder: model.Order{
+				ID:     6,
+				UserID: 1,
+				Status: model.OrderStatusShipped,
+			},
 			expGetCalled:    true,
 			expUpdateCalled: true,
 		},
+		"invalid_status": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     7,
+			givenStatus: model.OrderStatus("UNKNOWN"),
+			expErr:      ErrInvalidOrderStatus,
+		},
+		"delivered_back_to_pending": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     8,
+			givenStatus: model.OrderStatusPending,
+			mockOrder: model.Order{
+				ID:     8,
+				UserID: 1,
+				Status: model.OrderStatusDelivered,
+			},
+			expGetCalled: true,
+			expErr:       ErrInvalidStatusTransition,
+		},
+		"cancelled_to_shipped": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     9,
+			givenStatus: model.OrderStatusShipped,
+			mockOrder: model.Order{
+				ID:     9,
+				UserID: 1,
+				Status: model.OrderStatusCancelled,
+			},
+			expGetCalled: true,
+			expErr:       ErrInvalidStatusTransition,
+		},
+		"same_status": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     10,
+			givenStatus: model.OrderStatusPaid,
+			mockOrder: model.Order{
+				ID:     10,
+				UserID: 1,
+				Status: model.OrderStatusPaid,
+			},
+			expGetCalled: true,
+			expErr:       ErrInvalidStatusTransition,
+		},
+		"pay_consumes_reservations": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     15,
+			givenStatus: model.OrderStatusPaid,
+			mockOrder: model.Order{
+				ID:         15,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockReservations:          reservations,
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expConsumeCalled:          true,
+			expUpdateCalled:           true,
+		},
+		"pay_consume_out_of_stock": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     15,
+			givenStatus: model.OrderStatusPaid,
+			mockOrder: model.Order{
+				ID:         15,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockReservations:          reservations,
+			mockSettleErr:             inventory.ErrOutOfStock,
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expConsumeCalled:          true,
+			expErr:                    ErrProductOutOfStock,
+		},
+		"pay_record_movement_error": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     15,
+			givenStatus: model.OrderStatusPaid,
+			mockOrder: model.Order{
+				ID:         15,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockReservations:          reservations,
+			mockMovementErr:           errors.New("database error"),
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expConsumeCalled:          true,
+			expErr:                    ErrRecordStockMovement,
+		},
+		"cancel_releases_reservations": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     15,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         15,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockReservations:          reservations,
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expReleaseCalled:          true,
+			expUpdateCalled:           true,
+		},
+		"fail_releases_reservations": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     15,
+			givenStatus: model.OrderStatusFailed,
+			mockOrder: model.Order{
+				ID:         15,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockReservations:          reservations,
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expReleaseCalled:          true,
+			expUpdateCalled:           true,
+		},
+		"release_error": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     15,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         15,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockReservations:          reservations,
+			mockSettleErr:             errors.New("database error"),
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expReleaseCalled:          true,
+			expErr:                    ErrSettleStockReservation,
+		},
+		"list_reservations_error": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     15,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         15,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockListReservationsErr:   errors.New("database error"),
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expErr:                    ErrSettleStockReservation,
+		},
+		"cancel_restocks_items": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     11,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         11,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expRestockCalled:          true,
+			expUpdateCalled:           true,
+		},
+		"fail_restocks_items": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     11,
+			givenStatus: model.OrderStatusFailed,
+			mockOrder: model.Order{
+				ID:         11,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expRestockCalled:          true,
+			expUpdateCalled:           true,
+		},
+		"refund_restocks_items": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     11,
+			givenStatus: model.OrderStatusRefunded,
+			mockOrder: model.Order{
+				ID:         11,
+				UserID:     1,
+				Status:     model.OrderStatusDelivered,
+				OrderItems: orderItems,
+			},
+			expGetCalled:     true,
+			expRestockCalled: true,
+			expUpdateCalled:  true,
+		},
+		"second_cancel_does_not_restock": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     11,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         11,
+				UserID:     1,
+				Status:     model.OrderStatusCancelled,
+				OrderItems: orderItems,
+			},
+			expGetCalled: true,
+			expErr:       ErrInvalidStatusTransition,
+		},
+		"restock_product_not_found": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     11,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         11,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockRestockErr:            inventory.ErrProductNotFound,
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expRestockCalled:          true,
+			expErr:                    ErrProductNotFound,
+		},
+		"record_event_error": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     14,
+			givenStatus: model.OrderStatusPaid,
+			mockOrder: model.Order{
+				ID:     14,
+				UserID: 1,
+				Status: model.OrderStatusPending,
+			},
+			mockEventErr:              errors.New("database error"),
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expUpdateCalled:           true,
+			expErr:                    ErrRecordOrderEvent,
+		},
+		"restock_record_movement_error": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     11,
+			givenStatus: model.OrderStatusRefunded,
+			mockOrder: model.Order{
+				ID:         11,
+				UserID:     1,
+				Status:     model.OrderStatusDelivered,
+				OrderItems: orderItems,
+			},
+			mockMovementErr:  errors.New("database error"),
+			expGetCalled:     true,
+			expRestockCalled: true,
+			expErr:           ErrRecordStockMovement,
+		},
+		"restock_error": {
+			givenRole:   model.UserRoleStaff,
+			givenID:     11,
+			givenStatus: model.OrderStatusCancelled,
+			mockOrder: model.Order{
+				ID:         11,
+				UserID:     1,
+				Status:     model.OrderStatusPending,
+				OrderItems: orderItems,
+			},
+			mockRestockErr:            errors.New("database error"),
+			expGetCalled:              true,
+			expListReservationsCalled: true,
+			expRestockCalled:          true,
+			expErr:                    ErrRestockProduct,
+		},
 	}
 
 	for name, tc := range tcs {
 		t.Run(name, func(t *testing.T) {
-			invRepo := &inventory.MockRepository{}
+			// Given:
+			invRepo := inventory.NewMockRepository(t)
 			if tc.expGetCalled {
-				invRepo.On("GetOrderByID", mock.Anything, tc.givenID).Return(tc.mockOrder, tc.mockGetErr)
+				invRepo.On("GetOrderByIDWithLock", mock.Anything, tc.givenID).Return(tc.mockOrder, tc.mockGetErr)
+			}
+			outboxRepo := outbox.NewMockRepository(t)
+			if tc.expListReservationsCalled {
+				invRepo.On("ListOrderStockReservations", mock.Anything, tc.givenID).Return(tc.mockReservations, tc.mockListReservationsErr)
+			}
+			if tc.expConsumeCalled {
+				for _, r := range tc.mockReservations {
+					invRepo.On("ConsumeProductStock", mock.Anything, r.ProductID, r.Quantity).
+						Return(model.Product{ID: r.ProductID, Stock: 10}, tc.mockSettleErr).Once()
+					if tc.mockSettleErr != nil {
+						break
+					}
+					// The sale is appended to the stock ledger in the same tx
+					invRepo.On("CreateStockMovement", mock.Anything, model.StockMovement{
+						ProductID: r.ProductID,
+						Delta:     -r.Quantity,
+						Balance:   10,
+						Reason:    model.StockMovementReasonSale,
+						OrderID:   tc.givenID,
+						ActorID:   actorID,
+					}).Return(model.StockMovement{}, tc.mockMovementErr).Once()
+					if tc.mockMovementErr != nil {
+						break
+					}
+				}
+				if tc.mockSettleErr == nil && tc.mockMovementErr == nil {
+					invRepo.On("UpdateStockReservationStatus", mock.Anything, tc.givenID, model.StockReservationStatusConsumed).
+						Return(int64(len(tc.mockReservations)), nil)
+				}
+			}
+			if tc.expReleaseCalled {
+				for _, r := range tc.mockReservations {
+					invRepo.On("ReleaseProductStock", mock.Anything, r.ProductID, r.Quantity).
+						Return(model.Product{ID: r.ProductID, Stock: r.Quantity}, tc.mockSettleErr).Once()
+					if tc.mockSettleErr != nil {
+						break
+					}
+					// The stock given back is announced in the same tx
+					outboxRepo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+						return e.Type == model.EventTypeProductStockChanged && e.AggregateID == r.ProductID
+					})).Return(model.Event{}, nil).Once()
+				}
+				if tc.mockSettleErr == nil {
+					invRepo.On("UpdateStockReservationStatus", mock.Anything, tc.givenID, model.StockReservationStatusReleased).
+						Return(int64(len(tc.mockReservations)), nil)
+				}
+			}
+			if tc.expRestockCalled {
+				for _, item := range tc.mockOrder.OrderItems {
+					invRepo.On("IncreaseProductStock", mock.Anything, item.ProductID, item.Quantity).
+						Return(model.Product{ID: item.ProductID, Stock: item.Quantity}, tc.mockRestockErr).Once()
+					if tc.mockRestockErr != nil {
+						break
+					}
+					// The restock is appended to the stock ledger in the same tx
+					invRepo.On("CreateStockMovement", mock.Anything, model.StockMovement{
+						ProductID: item.ProductID,
+						Delta:     item.Quantity,
+						Balance:   item.Quantity,
+						Reason:    model.StockMovementReasonRestock,
+						OrderID:   tc.givenID,
+						ActorID:   actorID,
+					}).Return(model.StockMovement{}, tc.mockMovementErr).Once()
+					if tc.mockMovementErr != nil {
+						break
+					}
+					// The stock movement is recorded in the same tx
+					outboxRepo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+						return e.Type == model.EventTypeProductStockChanged && e.AggregateID == item.ProductID
+					})).Return(model.Event{}, nil).Once()
+				}
 			}
 			if tc.expUpdateCalled {
 				expectedOrder := tc.mockOrder
@@ -96,13 +548,26 @@ func TestImpl_UpdateOrderStatus(t *testing.T) {
 				invRepo.On("UpdateOrder", mock.Anything, expectedOrder).Return(expectedOrder, tc.mockUpdateErr)
 			}
 
+			if tc.expUpdateCalled && tc.mockUpdateErr == nil {
+				outboxRepo.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+					return e.Type == model.EventTypeOrderStatusChanged &&
+						e.AggregateID == tc.givenID &&
+						e.Status == model.EventStatusPending
+				})).Return(model.Event{}, tc.mockEventErr)
+			}
+
 			mockRepo := &repository.MockRegistry{}
 			mockRepo.On("Inventory").Return(invRepo)
+			mockRepo.On("Outbox").Return(outboxRepo).Maybe()
+			mockRepo.On("DoInTx", mock.Anything, mock.AnythingOfType("func(context.Context, repository.Registry) error"), mock.Anything).
+				Return(func(ctx context.Context, txFunc func(context.Context, repository.Registry) error, _ backoff.BackOff) error {
+					return txFunc(ctx, mockRepo)
+				}).Maybe()
 
 			i := New(mockRepo)
 
 			// When:
-			rs, err := i.UpdateOrderStatus(context.Background(), tc.givenID, tc.givenStatus)
+			rs, err := i.UpdateOrderStatus(context.Background(), actorID, tc.givenRole, tc.givenID, tc.givenStatus)
 
 			// Then:
 			if tc.expErr != nil {
@@ -3,15 +3,65 @@ package products
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
+	"omg/api/internal/controller/ledger"
 	"omg/api/internal/model"
+	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/db/pg"
 )
 
-// Create creates the product
+// Create creates the product & records its initial stock in the stock ledger
 func (i impl) Create(ctx context.Context, inp model.CreateProductInput) (model.Product, error) {
+	if err := validateCreateInput(inp); err != nil {
+		return model.Product{}, err
+	}
+
+	var created model.Product
+	txFunc := func(newCtx context.Context, repo repository.Registry) error {
+		var err error
+		created, err = createProduct(newCtx, repo, inp)
+		return err
+	}
+
+	// Create a new context with timeout for the transaction
+	newCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	if err := i.repo.DoInTx(newCtx, txFunc, pg.ExponentialBackOff(2, 2*time.Minute)); err != nil {
+		return model.Product{}, err
+	}
+
+	return created, nil
+}
+
+// validateCreateInput checks the product to create against the rules the products table holds it to
+func validateCreateInput(inp model.CreateProductInput) error {
+	if strings.TrimSpace(inp.Name) == "" {
+		return ErrInvalidProductName
+	}
+
+	if strings.TrimSpace(inp.Desc) == "" {
+		return ErrInvalidProductDescription
+	}
+
+	if !inp.Price.IsPositive() || !inp.Price.Equal(inp.Price.Round(2)) {
+		return ErrInvalidProductPrice
+	}
+
+	if inp.Stock < 0 {
+		return ErrInvalidProductStock
+	}
+
+	return nil
+}
+
+// createProduct creates the validated product using the given tx, rejecting the names already taken
+func createProduct(ctx context.Context, repo repository.Registry, inp model.CreateProductInput) (model.Product, error) {
 	// Check if product with this name already exists
-	_, err := i.repo.Inventory().GetProductByName(ctx, inp.Name)
+	_, err := repo.Inventory().GetProductByName(ctx, inp.Name)
 	if err != nil {
 		if !errors.Is(err, inventory.ErrProductNotFound) {
 			return model.Product{}, err
@@ -20,13 +70,30 @@ func (i impl) Create(ctx context.Context, inp model.CreateProductInput) (model.P
 		return model.Product{}, ErrProductAlreadyExists
 	}
 
-	product := model.Product{
+	created, err := repo.Inventory().CreateProduct(ctx, model.Product{
 		Name:        inp.Name,
 		Description: inp.Desc,
 		Status:      model.ProductStatusActive,
 		Price:       inp.Price,
 		Stock:       inp.Stock,
+	})
+	if err != nil {
+		return model.Product{}, err
+	}
+
+	if created.Stock == 0 {
+		return created, nil
+	}
+
+	if err = ledger.RecordStockMovement(ctx, repo, model.StockMovement{
+		ProductID: created.ID,
+		Delta:     created.Stock,
+		Balance:   created.Stock,
+		Reason:    model.StockMovementReasonInitial,
+		ActorID:   inp.CreatedBy,
+	}); err != nil {
+		return model.Product{}, err
 	}
 
-	return i.repo.Inventory().CreateProduct(ctx, product)
+	return created, nil
 }
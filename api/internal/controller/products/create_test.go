@@ -9,6 +9,8 @@ import (
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
 
+	"github.com/cenkalti/backoff/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +22,7 @@ func TestImpl_CreateProduct(t *testing.T) {
 		mockGetProductByNameErr error
 		mockCreateProductOut    model.Product
 		mockCreateProductErr    error
+		mockMovementErr         error
 		expRepoMockCalled       bool
 		expResult               model.Product
 		expErr                  error
@@ -28,10 +31,11 @@ func TestImpl_CreateProduct(t *testing.T) {
 	tcs := map[string]arg{
 		"success": {
 			givenInput: model.CreateProductInput{
-				Name:  "New Product",
-				Desc:  "Product description",
-				Price: 99.99,
-				Stock: 100,
+				Name:      "New Product",
+				Desc:      "Product description",
+				Price:     decimal.RequireFromString("99.99"),
+				Stock:     100,
+				CreatedBy: 7,
 			},
 			mockGetProductByNameErr: inventory.ErrProductNotFound,
 			mockCreateProductOut: model.Product{
@@ -39,7 +43,7 @@ func TestImpl_CreateProduct(t *testing.T) {
 				Name:        "New Product",
 				Description: "Product description",
 				Status:      model.ProductStatusActive,
-				Price:       99.99,
+				Price:       decimal.RequireFromString("99.99"),
 				Stock:       100,
 			},
 			mockCreateProductErr: nil,
@@ -49,16 +53,96 @@ func TestImpl_CreateProduct(t *testing.T) {
 				Name:        "New Product",
 				Description: "Product description",
 				Status:      model.ProductStatusActive,
-				Price:       99.99,
+				Price:       decimal.RequireFromString("99.99"),
 				Stock:       100,
 			},
 			expErr: nil,
 		},
+		"success_without_stock": {
+			givenInput: model.CreateProductInput{
+				Name:  "New Product",
+				Desc:  "Product description",
+				Price: decimal.RequireFromString("99.99"),
+			},
+			mockGetProductByNameErr: inventory.ErrProductNotFound,
+			mockCreateProductOut: model.Product{
+				ID:          1,
+				Name:        "New Product",
+				Description: "Product description",
+				Status:      model.ProductStatusActive,
+				Price:       decimal.RequireFromString("99.99"),
+			},
+			expRepoMockCalled: true,
+			expResult: model.Product{
+				ID:          1,
+				Name:        "New Product",
+				Description: "Product description",
+				Status:      model.ProductStatusActive,
+				Price:       decimal.RequireFromString("99.99"),
+			},
+		},
+		"record_movement_error": {
+			givenInput: model.CreateProductInput{
+				Name:  "New Product",
+				Desc:  "Product description",
+				Price: decimal.RequireFromString("99.99"),
+				Stock: 100,
+			},
+			mockGetProductByNameErr: inventory.ErrProductNotFound,
+			mockCreateProductOut: model.Product{
+				ID:     1,
+				Name:   "New Product",
+				Status: model.ProductStatusActive,
+				Stock:  100,
+			},
+			mockMovementErr:   errors.New("insert error"),
+			expRepoMockCalled: true,
+			expErr:            ErrRecordStockMovement,
+		},
+		"blank_name": {
+			givenInput: model.CreateProductInput{
+				Name:  "  ",
+				Desc:  "Product description",
+				Price: decimal.RequireFromString("99.99"),
+			},
+			expErr: ErrInvalidProductName,
+		},
+		"blank_description": {
+			givenInput: model.CreateProductInput{
+				Name:  "New Product",
+				Price: decimal.RequireFromString("99.99"),
+			},
+			expErr: ErrInvalidProductDescription,
+		},
+		"zero_price": {
+			givenInput: model.CreateProductInput{
+				Name: "New Product",
+				Desc: "Product description",
+			},
+			expErr: ErrInvalidProductPrice,
+		},
+		"fraction_of_cent_price": {
+			givenInput: model.CreateProductInput{
+				Name:  "New Product",
+				Desc:  "Product description",
+				Price: decimal.RequireFromString("9.999"),
+			},
+			expErr: ErrInvalidProductPrice,
+		},
+		"negative_stock": {
+			givenInput: model.CreateProductInput{
+				Name:  "New Product",
+				Desc:  "Product description",
+				Price: decimal.RequireFromString("99.99"),
+				Stock: -1,
+			},
+			expErr: ErrInvalidProductStock,
+		},
 		"product_already_exists": {
 			givenInput: model.CreateProductInput{
 				Name:  "Existing Product",
 				Desc:  "Product description",
-				Price: 99.99,
+				Price: decimal.RequireFromString("99.99"),
 				Stock: 100,
 			},
 			mockGetProductByNameOut: model.Product{
@@ -66,7 +150,7 @@ func TestImpl_CreateProduct(t *testing.T) {
 				Name:        "Existing Product",
 				Description: "Product description",
 				Status:      model.ProductStatusActive,
-				Price:       99.99,
+				Price:       decimal.RequireFromString("99.99"),
 				Stock:       100,
 			},
 			mockGetProductByNameErr: nil,
@@ -78,7 +162,7 @@ func TestImpl_CreateProduct(t *testing.T) {
 			givenInput: model.CreateProductInput{
 				Name:  "Test Product",
 				Desc:  "Product description",
-				Price: 99.99,
+				Price: decimal.RequireFromString("99.99"),
 				Stock: 100,
 			},
 			mockGetProductByNameErr: errors.New("database error"),
@@ -90,7 +174,7 @@ func TestImpl_CreateProduct(t *testing.T) {
 			givenInput: model.CreateProductInput{
 				Name:  "New Product",
 				Desc:  "Product description",
-				Price: 99.99,
+				Price: decimal.RequireFromString("99.99"),
 				Stock: 100,
 			},
 			mockGetProductByNameErr: inventory.ErrProductNotFound,
@@ -121,11 +205,26 @@ func TestImpl_CreateProduct(t *testing.T) {
 							p.Stock == tc.givenInput.Stock &&
 							p.Status == model.ProductStatusActive
 					})).Return(tc.mockCreateProductOut, tc.mockCreateProductErr)
+
+					// The initial stock opens the stock ledger of the product
+					if tc.mockCreateProductErr == nil && tc.mockCreateProductOut.Stock != 0 {
+						inventoryRepo.On("CreateStockMovement", mock.Anything, model.StockMovement{
+							ProductID: tc.mockCreateProductOut.ID,
+							Delta:     tc.mockCreateProductOut.Stock,
+							Balance:   tc.mockCreateProductOut.Stock,
+							Reason:    model.StockMovementReasonInitial,
+							ActorID:   tc.givenInput.CreatedBy,
+						}).Return(model.StockMovement{}, tc.mockMovementErr)
+					}
 				}
 			}
 
 			repo := repository.MockRegistry{}
 			repo.On("Inventory").Return(&inventoryRepo)
+			repo.On("DoInTx", mock.Anything, mock.AnythingOfType("func(context.Context, repository.Registry) error"), mock.Anything).
+				Return(func(ctx context.Context, txFunc func(context.Context, repository.Registry) error, _ backoff.BackOff) error {
+					return txFunc(ctx, &repo)
+				})
 
 			impl := impl{repo: &repo}
 
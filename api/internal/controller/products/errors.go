@@ -2,10 +2,29 @@ package products
 
 import (
 	"errors"
+
+	"omg/api/internal/controller/ledger"
 )
 
 var (
 	ErrNotFound             = errors.New("product not found")
 	ErrProductAlreadyExists = errors.New("product already exists")
 	ErrProductDeleted       = errors.New("product deleted")
+	ErrInvalidProductStatus = errors.New("invalid product status")
+	ErrInvalidPriceRange    = errors.New("invalid price range")
+	ErrInvalidCursor        = errors.New("invalid cursor")
+	ErrInvalidLimit         = errors.New("invalid limit")
+	ErrRecordStockEvent     = errors.New("fail to record product stock event")
+	ErrRecordStockMovement  = ledger.ErrRecordStockMovement
+
+	ErrInvalidProductName        = errors.New("product name is required")
+	ErrInvalidProductDescription = errors.New("product description is required")
+	ErrInvalidProductPrice       = errors.New("product price must be positive with at most 2 decimal places")
+	ErrInvalidProductStock       = errors.New("product stock must not be negative")
+	ErrInvalidStockAdjustment    = errors.New("stock adjustment needs a product id and a non zero delta")
+	ErrInvalidImportAction       = errors.New("invalid import action")
+	ErrDuplicateImportName       = errors.New("product name is repeated in the import")
+	ErrInsufficientStock         = errors.New("stock adjustment takes the stock below the reserved stock")
+	ErrEmptyImport               = errors.New("import has no rows")
+	ErrTooManyImportRows         = errors.New("import has too many rows")
 )
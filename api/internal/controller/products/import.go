@@ -0,0 +1,217 @@
+package products
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/db/pg"
+)
+
+// maxImportRows bounds the rows of a single import so its tx stays short
+const maxImportRows = 1000
+
+// Import validates every row of the import & applies them all in a single tx, or each row in its own tx in partial mode
+func (i impl) Import(ctx context.Context, inp model.ImportProductsInput) (model.ProductImportResult, error) {
+	if len(inp.Rows) == 0 {
+		return model.ProductImportResult{}, ErrEmptyImport
+	}
+
+	if len(inp.Rows) > maxImportRows {
+		return model.ProductImportResult{}, ErrTooManyImportRows
+	}
+
+	result := model.ProductImportResult{Rows: make([]model.ProductImportRowResult, len(inp.Rows))}
+	names := make(map[string]struct{}, len(inp.Rows))
+	for idx, row := range inp.Rows {
+		result.Rows[idx] = model.ProductImportRowResult{
+			Line:      row.Line,
+			Status:    model.ProductImportRowStatusSkipped,
+			ProductID: row.ProductID,
+		}
+
+		if err := validateImportRow(row, names); err != nil {
+			failImportRow(&result, idx, err)
+		}
+	}
+
+	if inp.Partial {
+		return i.importEachRow(ctx, inp, result), nil
+	}
+
+	// All or none: a single invalid row keeps the whole import from being applied
+	if result.Failed > 0 {
+		return result, nil
+	}
+
+	return i.importAllRows(ctx, inp, result)
+}
+
+// importAllRows applies every row in a single tx, rolling all of them back on the first row which fails
+func (i impl) importAllRows(ctx context.Context, inp model.ImportProductsInput, result model.ProductImportResult) (model.ProductImportResult, error) {
+	failedIdx := -1
+	productIDs := make([]int64, len(inp.Rows))
+	txFunc := func(newCtx context.Context, repo repository.Registry) error {
+		for idx, row := range inp.Rows {
+			id, err := applyImportRow(newCtx, repo, row, inp.ImportedBy)
+			if err != nil {
+				failedIdx = idx
+				return err
+			}
+			productIDs[idx] = id
+		}
+		return nil
+	}
+
+	// Create a new context with timeout for the transaction
+	newCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	if err := i.repo.DoInTx(newCtx, txFunc, pg.ExponentialBackOff(2, 2*time.Minute)); err != nil {
+		if failedIdx < 0 || !isImportRowErr(err) {
+			return model.ProductImportResult{}, err
+		}
+
+		failImportRow(&result, failedIdx, err)
+		return result, nil
+	}
+
+	for idx := range result.Rows {
+		result.Rows[idx].Status = model.ProductImportRowStatusApplied
+		result.Rows[idx].ProductID = productIDs[idx]
+	}
+	result.Applied = len(result.Rows)
+
+	return result, nil
+}
+
+// importEachRow applies every valid row in its own tx, so a failing row leaves the others applied
+func (i impl) importEachRow(ctx context.Context, inp model.ImportProductsInput, result model.ProductImportResult) model.ProductImportResult {
+	for idx, row := range inp.Rows {
+		if result.Rows[idx].Status == model.ProductImportRowStatusFailed {
+			continue
+		}
+
+		var id int64
+		txFunc := func(newCtx context.Context, repo repository.Registry) error {
+			var err error
+			id, err = applyImportRow(newCtx, repo, row, inp.ImportedBy)
+			return err
+		}
+
+		// Create a new context with timeout for the transaction
+		newCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+		err := i.repo.DoInTx(newCtx, txFunc, pg.ExponentialBackOff(2, 2*time.Minute))
+		cancel()
+		if err != nil {
+			failImportRow(&result, idx, err)
+			continue
+		}
+
+		result.Rows[idx].Status = model.ProductImportRowStatusApplied
+		result.Rows[idx].ProductID = id
+		result.Applied++
+	}
+
+	return result
+}
+
+// validateImportRow checks the row with the rules of its action, rejecting the names already created by the import
+func validateImportRow(row model.ProductImportRow, names map[string]struct{}) error {
+	switch row.Action {
+	case model.ProductImportActionCreate:
+		if err := validateCreateInput(row.Create); err != nil {
+			return err
+		}
+
+		if _, ok := names[row.Create.Name]; ok {
+			return ErrDuplicateImportName
+		}
+		names[row.Create.Name] = struct{}{}
+	case model.ProductImportActionAdjust:
+		if row.ProductID <= 0 || row.Delta == 0 {
+			return ErrInvalidStockAdjustment
+		}
+	default:
+		return ErrInvalidImportAction
+	}
+
+	return nil
+}
+
+// applyImportRow applies the validated row using the given tx & returns the product it created or adjusted
+func applyImportRow(ctx context.Context, repo repository.Registry, row model.ProductImportRow, actorID int64) (int64, error) {
+	switch row.Action {
+	case model.ProductImportActionCreate:
+		inp := row.Create
+		inp.CreatedBy = actorID
+
+		p, err := createProduct(ctx, repo, inp)
+		return p.ID, err
+	case model.ProductImportActionAdjust:
+		p, err := adjustProductStock(ctx, repo, row.ProductID, row.Delta, actorID)
+		return p.ID, err
+	}
+
+	return 0, ErrInvalidImportAction
+}
+
+// adjustProductStock moves the stock on hand of the product by delta, never below the stock held for pending orders
+func adjustProductStock(ctx context.Context, repo repository.Registry, productID, delta, actorID int64) (model.Product, error) {
+	p, err := repo.Inventory().GetProductByIDWithLock(ctx, productID)
+	if err != nil {
+		if errors.Is(err, inventory.ErrProductNotFound) {
+			return model.Product{}, ErrNotFound
+		}
+		return model.Product{}, err
+	}
+
+	if p.Status == model.ProductStatusDeleted {
+		return model.Product{}, ErrProductDeleted
+	}
+
+	if p.Stock+delta < p.Reserved {
+		return model.Product{}, ErrInsufficientStock
+	}
+
+	adjusted := p
+	adjusted.Stock = p.Stock + delta
+	adjusted, err = repo.Inventory().UpdateProduct(ctx, adjusted)
+	if err != nil {
+		if errors.Is(err, inventory.ErrProductNotFound) {
+			return model.Product{}, ErrNotFound
+		}
+		return model.Product{}, err
+	}
+
+	if err = recordStockAdjustment(ctx, repo, p, adjusted, actorID); err != nil {
+		return model.Product{}, err
+	}
+
+	return adjusted, nil
+}
+
+// failImportRow marks the row as failed with the given error
+func failImportRow(result *model.ProductImportResult, idx int, err error) {
+	result.Rows[idx].Status = model.ProductImportRowStatusFailed
+	result.Rows[idx].Err = err
+	result.Failed++
+}
+
+// isImportRowErr tells the errors caused by the row itself from the ones which fail the whole import
+func isImportRowErr(err error) bool {
+	for _, rowErr := range []error{
+		ErrProductAlreadyExists,
+		ErrNotFound,
+		ErrProductDeleted,
+		ErrInsufficientStock,
+	} {
+		if errors.Is(err, rowErr) {
+			return true
+		}
+	}
+	return false
+}
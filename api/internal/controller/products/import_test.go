@@ -0,0 +1,208 @@
+package products
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/inventory"
+	"omg/api/internal/repository/outbox"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_Import(t *testing.T) {
+	createRow := func(line int, name string) model.ProductImportRow {
+		return model.ProductImportRow{
+			Line:   line,
+			Action: model.ProductImportActionCreate,
+			Create: model.CreateProductInput{
+				Name:  name,
+				Desc:  "Product description",
+				Price: decimal.RequireFromString("9.99"),
+				Stock: 10,
+			},
+		}
+	}
+	adjustRow := func(line int, productID, delta int64) model.ProductImportRow {
+		return model.ProductImportRow{
+			Line:      line,
+			Action:    model.ProductImportActionAdjust,
+			ProductID: productID,
+			Delta:     delta,
+		}
+	}
+	mockCreate := func(inv *inventory.MockRepository, name string, id int64) {
+		inv.On("GetProductByName", mock.Anything, name).Return(model.Product{}, inventory.ErrProductNotFound).Once()
+		inv.On("CreateProduct", mock.Anything, mock.MatchedBy(func(p model.Product) bool {
+			return p.Name == name
+		})).Return(model.Product{ID: id, Name: name, Stock: 10}, nil).Once()
+		inv.On("CreateStockMovement", mock.Anything, model.StockMovement{
+			ProductID: id,
+			Delta:     10,
+			Balance:   10,
+			Reason:    model.StockMovementReasonInitial,
+			ActorID:   7,
+		}).Return(model.StockMovement{}, nil).Once()
+	}
+	mockAdjust := func(inv *inventory.MockRepository, ob *outbox.MockRepository, p model.Product, delta int64) {
+		inv.On("GetProductByIDWithLock", mock.Anything, p.ID).Return(p, nil).Once()
+		adjusted := p
+		adjusted.Stock += delta
+		inv.On("UpdateProduct", mock.Anything, adjusted).Return(adjusted, nil).Once()
+		inv.On("CreateStockMovement", mock.Anything, model.StockMovement{
+			ProductID: p.ID,
+			Delta:     delta,
+			Balance:   adjusted.Stock,
+			Reason:    model.StockMovementReasonAdjustment,
+			ActorID:   7,
+		}).Return(model.StockMovement{}, nil).Once()
+		ob.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+			return e.Type == model.EventTypeProductStockChanged
+		})).Return(model.Event{}, nil).Once()
+	}
+	product := model.Product{ID: 5, Name: "Apple", Status: model.ProductStatusActive, Stock: 4, Reserved: 2}
+
+	type args struct {
+		givenInput model.ImportProductsInput
+		mockFn     func(*inventory.MockRepository, *outbox.MockRepository)
+		expResult  model.ProductImportResult
+		expErr     error
+	}
+	tcs := map[string]args{
+		"all_rows_applied": {
+			givenInput: model.ImportProductsInput{
+				Rows:       []model.ProductImportRow{createRow(2, "Banana"), adjustRow(3, 5, 6)},
+				ImportedBy: 7,
+			},
+			mockFn: func(inv *inventory.MockRepository, ob *outbox.MockRepository) {
+				mockCreate(inv, "Banana", 11)
+				mockAdjust(inv, ob, product, 6)
+			},
+			expResult: model.ProductImportResult{
+				Rows: []model.ProductImportRowResult{
+					{Line: 2, Status: model.ProductImportRowStatusApplied, ProductID: 11},
+					{Line: 3, Status: model.ProductImportRowStatusApplied, ProductID: 5},
+				},
+				Applied: 2,
+			},
+		},
+		"invalid_row_skips_all": {
+			givenInput: model.ImportProductsInput{
+				Rows: []model.ProductImportRow{
+					createRow(2, "Banana"),
+					createRow(3, "Banana"),
+					adjustRow(4, 5, 0),
+					{Line: 5, Action: "DELETE"},
+				},
+				ImportedBy: 7,
+			},
+			expResult: model.ProductImportResult{
+				Rows: []model.ProductImportRowResult{
+					{Line: 2, Status: model.ProductImportRowStatusSkipped},
+					{Line: 3, Status: model.ProductImportRowStatusFailed, Err: ErrDuplicateImportName},
+					{Line: 4, Status: model.ProductImportRowStatusFailed, ProductID: 5, Err: ErrInvalidStockAdjustment},
+					{Line: 5, Status: model.ProductImportRowStatusFailed, Err: ErrInvalidImportAction},
+				},
+				Failed: 3,
+			},
+		},
+		"failing_row_rolls_back_all": {
+			givenInput: model.ImportProductsInput{
+				Rows:       []model.ProductImportRow{createRow(2, "Banana"), adjustRow(3, 5, -3)},
+				ImportedBy: 7,
+			},
+			mockFn: func(inv *inventory.MockRepository, ob *outbox.MockRepository) {
+				mockCreate(inv, "Banana", 11)
+				inv.On("GetProductByIDWithLock", mock.Anything, int64(5)).Return(product, nil)
+			},
+			expResult: model.ProductImportResult{
+				Rows: []model.ProductImportRowResult{
+					{Line: 2, Status: model.ProductImportRowStatusSkipped},
+					{Line: 3, Status: model.ProductImportRowStatusFailed, ProductID: 5, Err: ErrInsufficientStock},
+				},
+				Failed: 1,
+			},
+		},
+		"db_error_fails_import": {
+			givenInput: model.ImportProductsInput{
+				Rows:       []model.ProductImportRow{adjustRow(2, 5, 1)},
+				ImportedBy: 7,
+			},
+			mockFn: func(inv *inventory.MockRepository, ob *outbox.MockRepository) {
+				inv.On("GetProductByIDWithLock", mock.Anything, int64(5)).Return(model.Product{}, errors.New("db error"))
+			},
+			expErr: errors.New("db error"),
+		},
+		"partial_applies_valid_rows": {
+			givenInput: model.ImportProductsInput{
+				Rows: []model.ProductImportRow{
+					createRow(2, "Banana"),
+					createRow(3, ""),
+					adjustRow(4, 9, 1),
+					adjustRow(5, 5, 6),
+				},
+				Partial:    true,
+				ImportedBy: 7,
+			},
+			mockFn: func(inv *inventory.MockRepository, ob *outbox.MockRepository) {
+				mockCreate(inv, "Banana", 11)
+				inv.On("GetProductByIDWithLock", mock.Anything, int64(9)).Return(model.Product{}, inventory.ErrProductNotFound)
+				mockAdjust(inv, ob, product, 6)
+			},
+			expResult: model.ProductImportResult{
+				Rows: []model.ProductImportRowResult{
+					{Line: 2, Status: model.ProductImportRowStatusApplied, ProductID: 11},
+					{Line: 3, Status: model.ProductImportRowStatusFailed, Err: ErrInvalidProductName},
+					{Line: 4, Status: model.ProductImportRowStatusFailed, ProductID: 9, Err: ErrNotFound},
+					{Line: 5, Status: model.ProductImportRowStatusApplied, ProductID: 5},
+				},
+				Applied: 2,
+				Failed:  2,
+			},
+		},
+		"empty_import": {
+			givenInput: model.ImportProductsInput{},
+			expErr:     ErrEmptyImport,
+		},
+		"too_many_rows": {
+			givenInput: model.ImportProductsInput{Rows: make([]model.ProductImportRow, maxImportRows+1)},
+			expErr:     ErrTooManyImportRows,
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			mockInv := inventory.NewMockRepository(t)
+			mockOutbox := outbox.NewMockRepository(t)
+			if tc.mockFn != nil {
+				tc.mockFn(mockInv, mockOutbox)
+			}
+
+			mockRepo := repository.NewMockRegistry(t)
+			mockRepo.On("Inventory").Return(mockInv).Maybe()
+			mockRepo.On("Outbox").Return(mockOutbox).Maybe()
+			mockRepo.On("DoInTx", mock.Anything, mock.AnythingOfType("func(context.Context, repository.Registry) error"), mock.Anything).
+				Return(func(ctx context.Context, txFunc func(context.Context, repository.Registry) error, _ backoff.BackOff) error {
+					return txFunc(ctx, mockRepo)
+				}).Maybe()
+
+			// When:
+			result, err := New(mockRepo).Import(context.Background(), tc.givenInput)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResult, result)
+			}
+		})
+	}
+}
@@ -4,13 +4,66 @@ import (
 	"context"
 
 	"omg/api/internal/model"
+	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/pagination"
 )
 
-// List gets a list of products from DB
-func (i impl) List(ctx context.Context) ([]model.Product, error) {
-	rs, err := i.repo.Inventory().ListProducts(ctx)
+const (
+	// defaultListLimit is the page size when the client does not ask for one
+	defaultListLimit = 20
+	// maxListLimit is the biggest page size a client can ask for
+	maxListLimit = 100
+)
+
+// List gets a page of products from DB matching the given filters
+func (i impl) List(ctx context.Context, inp model.ListProductsInput) (model.ProductList, error) {
+	for _, s := range inp.Status {
+		if !s.IsValid() {
+			return model.ProductList{}, ErrInvalidProductStatus
+		}
+	}
+
+	if inp.MinPrice.Valid && inp.MaxPrice.Valid && inp.MinPrice.Decimal.GreaterThan(inp.MaxPrice.Decimal) {
+		return model.ProductList{}, ErrInvalidPriceRange
+	}
+
+	limit := inp.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 0 || limit > maxListLimit {
+		return model.ProductList{}, ErrInvalidLimit
+	}
+
+	filter := inventory.ProductsFilter{
+		Status:     inp.Status,
+		MinPrice:   inp.MinPrice,
+		MaxPrice:   inp.MaxPrice,
+		InStock:    inp.InStock,
+		NamePrefix: inp.NamePrefix,
+		Limit:      limit + 1, // one extra to know if there is a next page
+	}
+
+	if inp.Cursor != "" {
+		after, err := pagination.DecodeCursor(inp.Cursor)
+		if err != nil {
+			return model.ProductList{}, ErrInvalidCursor
+		}
+		filter.After = &after
+	}
+
+	rs, err := i.repo.Inventory().ListProducts(ctx, filter)
 	if err != nil {
-		return nil, err
+		return model.ProductList{}, err
+	}
+
+	var result model.ProductList
+	if len(rs) > limit {
+		rs = rs[:limit]
+		last := rs[limit-1]
+		result.NextCursor = pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode()
 	}
-	return rs, nil
+	result.Products = rs
+
+	return result, nil
 }
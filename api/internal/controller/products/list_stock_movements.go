@@ -0,0 +1,57 @@
+package products
+
+import (
+	"context"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/pagination"
+)
+
+// ListStockMovements gets a page of the stock ledger of the product from DB, newest first
+func (i impl) ListStockMovements(ctx context.Context, inp model.ListStockMovementsInput) (model.StockMovementList, error) {
+	limit := inp.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 0 || limit > maxListLimit {
+		return model.StockMovementList{}, ErrInvalidLimit
+	}
+
+	filter := inventory.StockMovementsFilter{
+		ProductID: inp.ProductID,
+		Limit:     limit + 1, // one extra to know if there is a next page
+	}
+
+	if inp.Cursor != "" {
+		after, err := pagination.DecodeSeqCursor(inp.Cursor)
+		if err != nil {
+			return model.StockMovementList{}, ErrInvalidCursor
+		}
+		filter.After = &after
+	}
+
+	// Tell a product without movements apart from a missing one
+	if _, err := i.repo.Inventory().GetProductByID(ctx, inp.ProductID); err != nil {
+		if errors.Is(err, inventory.ErrProductNotFound) {
+			return model.StockMovementList{}, ErrNotFound
+		}
+		return model.StockMovementList{}, err
+	}
+
+	rs, err := i.repo.Inventory().ListStockMovements(ctx, filter)
+	if err != nil {
+		return model.StockMovementList{}, err
+	}
+
+	var result model.StockMovementList
+	if len(rs) > limit {
+		rs = rs[:limit]
+		last := rs[limit-1]
+		result.NextCursor = pagination.SeqCursor{Seq: last.Seq}.Encode()
+	}
+	result.Movements = rs
+
+	return result, nil
+}
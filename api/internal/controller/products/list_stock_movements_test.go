@@ -0,0 +1,116 @@
+package products
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/pagination"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListStockMovements(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC)
+	cursor := pagination.SeqCursor{Seq: 42}
+
+	type arg struct {
+		givenInput    model.ListStockMovementsInput
+		mockGetErr    error
+		mockMovements []model.StockMovement
+		mockListErr   error
+		expGetCalled  bool
+		expListCalled bool
+		expFilter     inventory.StockMovementsFilter
+		expResult     model.StockMovementList
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenInput: model.ListStockMovementsInput{ProductID: 123},
+			mockMovements: []model.StockMovement{
+				{ID: 21, ProductID: 123, Delta: -2, Balance: 8, Reason: model.StockMovementReasonSale, CreatedAt: createdAt},
+			},
+			expGetCalled:  true,
+			expListCalled: true,
+			expFilter:     inventory.StockMovementsFilter{ProductID: 123, Limit: defaultListLimit + 1},
+			expResult: model.StockMovementList{
+				Movements: []model.StockMovement{
+					{ID: 21, ProductID: 123, Delta: -2, Balance: 8, Reason: model.StockMovementReasonSale, CreatedAt: createdAt},
+				},
+			},
+		},
+		"success_with_next_page": {
+			givenInput: model.ListStockMovementsInput{ProductID: 123, Cursor: cursor.Encode(), Limit: 1},
+			mockMovements: []model.StockMovement{
+				{ID: 22, ProductID: 123, Seq: 42, CreatedAt: createdAt},
+				{ID: 21, ProductID: 123, Seq: 41, CreatedAt: createdAt},
+			},
+			expGetCalled:  true,
+			expListCalled: true,
+			expFilter:     inventory.StockMovementsFilter{ProductID: 123, After: &cursor, Limit: 2},
+			expResult: model.StockMovementList{
+				Movements:  []model.StockMovement{{ID: 22, ProductID: 123, Seq: 42, CreatedAt: createdAt}},
+				NextCursor: cursor.Encode(),
+			},
+		},
+		"product_not_found": {
+			givenInput:   model.ListStockMovementsInput{ProductID: 123},
+			mockGetErr:   inventory.ErrProductNotFound,
+			expGetCalled: true,
+			expErr:       ErrNotFound,
+		},
+		"invalid_cursor": {
+			givenInput: model.ListStockMovementsInput{ProductID: 123, Cursor: "not-a-cursor"},
+			expErr:     ErrInvalidCursor,
+		},
+		"limit_too_big": {
+			givenInput: model.ListStockMovementsInput{ProductID: 123, Limit: maxListLimit + 1},
+			expErr:     ErrInvalidLimit,
+		},
+		"database_error": {
+			givenInput:    model.ListStockMovementsInput{ProductID: 123},
+			mockListErr:   errors.New("database error"),
+			expGetCalled:  true,
+			expListCalled: true,
+			expFilter:     inventory.StockMovementsFilter{ProductID: 123, Limit: defaultListLimit + 1},
+			expErr:        errors.New("database error"),
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			invRepo := inventory.NewMockRepository(t)
+			if tc.expGetCalled {
+				invRepo.On("GetProductByID", mock.Anything, tc.givenInput.ProductID).Return(model.Product{ID: tc.givenInput.ProductID}, tc.mockGetErr)
+			}
+			if tc.expListCalled {
+				invRepo.On("ListStockMovements", mock.Anything, tc.expFilter).Return(tc.mockMovements, tc.mockListErr)
+			}
+
+			mockRepo := &repository.MockRegistry{}
+			mockRepo.On("Inventory").Return(invRepo)
+
+			impl := New(mockRepo)
+
+			// When:
+			rs, err := impl.ListStockMovements(context.Background(), tc.givenInput)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, pkgerrors.Cause(err), tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResult, rs)
+			}
+		})
+	}
+}
@@ -4,44 +4,111 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"omg/api/internal/model"
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/pagination"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func Test_impl_List(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC)
+	cursor := pagination.Cursor{CreatedAt: createdAt, ID: 122}
+
 	type arg struct {
+		givenInput     model.ListProductsInput
 		mockProducts   []model.Product
 		mockErr        error
 		expReposCalled bool
+		expFilter      inventory.ProductsFilter
+		expResult      model.ProductList
 		expErr         error
 	}
 
 	tcs := map[string]arg{
 		"success": {
 			mockProducts: []model.Product{
-				{
-					ID:     123,
-					Status: model.ProductStatusActive,
+				{ID: 123, Status: model.ProductStatusActive, CreatedAt: createdAt},
+			},
+			expReposCalled: true,
+			expFilter:      inventory.ProductsFilter{Limit: defaultListLimit + 1},
+			expResult: model.ProductList{
+				Products: []model.Product{
+					{ID: 123, Status: model.ProductStatusActive, CreatedAt: createdAt},
 				},
 			},
+		},
+		"success_with_next_page": {
+			givenInput: model.ListProductsInput{Limit: 2},
+			mockProducts: []model.Product{
+				{ID: 124, CreatedAt: createdAt},
+				{ID: 122, CreatedAt: createdAt},
+				{ID: 121, CreatedAt: createdAt},
+			},
 			expReposCalled: true,
+			expFilter:      inventory.ProductsFilter{Limit: 3},
+			expResult: model.ProductList{
+				Products: []model.Product{
+					{ID: 124, CreatedAt: createdAt},
+					{ID: 122, CreatedAt: createdAt},
+				},
+				NextCursor: cursor.Encode(),
+			},
 		},
-		"empty": {
-			mockProducts:   nil,
-			mockErr:        nil,
+		"success_with_cursor_and_filters": {
+			givenInput: model.ListProductsInput{
+				Status:     []model.ProductStatus{model.ProductStatusActive},
+				MinPrice:   decimal.NewNullDecimal(decimal.RequireFromString("10")),
+				MaxPrice:   decimal.NewNullDecimal(decimal.RequireFromString("20.5")),
+				InStock:    true,
+				NamePrefix: "tes",
+				Cursor:     cursor.Encode(),
+				Limit:      5,
+			},
 			expReposCalled: true,
-			expErr:         nil,
+			expFilter: inventory.ProductsFilter{
+				Status:     []model.ProductStatus{model.ProductStatusActive},
+				MinPrice:   decimal.NewNullDecimal(decimal.RequireFromString("10")),
+				MaxPrice:   decimal.NewNullDecimal(decimal.RequireFromString("20.5")),
+				InStock:    true,
+				NamePrefix: "tes",
+				After:      &cursor,
+				Limit:      6,
+			},
+		},
+		"invalid_status": {
+			givenInput: model.ListProductsInput{Status: []model.ProductStatus{"UNKNOWN"}},
+			expErr:     ErrInvalidProductStatus,
+		},
+		"invalid_price_range": {
+			givenInput: model.ListProductsInput{
+				MinPrice: decimal.NewNullDecimal(decimal.RequireFromString("20")),
+				MaxPrice: decimal.NewNullDecimal(decimal.RequireFromString("10")),
+			},
+			expErr: ErrInvalidPriceRange,
+		},
+		"invalid_cursor": {
+			givenInput: model.ListProductsInput{Cursor: "not-a-cursor"},
+			expErr:     ErrInvalidCursor,
+		},
+		"limit_too_big": {
+			givenInput: model.ListProductsInput{Limit: maxListLimit + 1},
+			expErr:     ErrInvalidLimit,
+		},
+		"negative_limit": {
+			givenInput: model.ListProductsInput{Limit: -1},
+			expErr:     ErrInvalidLimit,
 		},
 		"database_error": {
-			mockProducts:   nil,
 			mockErr:        errors.New("database error"),
 			expReposCalled: true,
+			expFilter:      inventory.ProductsFilter{Limit: defaultListLimit + 1},
 			expErr:         errors.New("database error"),
 		},
 	}
@@ -49,9 +116,9 @@ func Test_impl_List(t *testing.T) {
 	for s, tc := range tcs {
 		t.Run(s, func(t *testing.T) {
 			// Given:
-			invRepo := &inventory.MockRepository{}
+			invRepo := inventory.NewMockRepository(t)
 			if tc.expReposCalled {
-				invRepo.On("ListProducts", mock.Anything).Return(tc.mockProducts, tc.mockErr)
+				invRepo.On("ListProducts", mock.Anything, tc.expFilter).Return(tc.mockProducts, tc.mockErr)
 			}
 
 			mockRepo := &repository.MockRegistry{}
@@ -60,14 +127,14 @@ func Test_impl_List(t *testing.T) {
 			impl := New(mockRepo)
 
 			// When:
-			products, err := impl.List(context.Background())
+			rs, err := impl.List(context.Background(), tc.givenInput)
 
 			// Then:
 			if tc.expErr != nil {
-				require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				require.EqualError(t, pkgerrors.Cause(err), tc.expErr.Error())
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.mockProducts, products)
+				require.Equal(t, tc.expResult, rs)
 			}
 		})
 	}
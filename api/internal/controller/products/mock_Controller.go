@@ -88,29 +88,83 @@ func (_m *MockController) GetByID(_a0 context.Context, _a1 int64) (model.Product
 	return r0, r1
 }
 
-// List provides a mock function with given fields: _a0
-func (_m *MockController) List(_a0 context.Context) ([]model.Product, error) {
-	ret := _m.Called(_a0)
+// Import provides a mock function with given fields: _a0, _a1
+func (_m *MockController) Import(_a0 context.Context, _a1 model.ImportProductsInput) (model.ProductImportResult, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 model.ProductImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ImportProductsInput) (model.ProductImportResult, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ImportProductsInput) model.ProductImportResult); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.ProductImportResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ImportProductsInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: _a0, _a1
+func (_m *MockController) List(_a0 context.Context, _a1 model.ListProductsInput) (model.ProductList, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
 	}
 
-	var r0 []model.Product
+	var r0 model.ProductList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListProductsInput) (model.ProductList, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListProductsInput) model.ProductList); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.ProductList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListProductsInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStockMovements provides a mock function with given fields: _a0, _a1
+func (_m *MockController) ListStockMovements(_a0 context.Context, _a1 model.ListStockMovementsInput) (model.StockMovementList, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStockMovements")
+	}
+
+	var r0 model.StockMovementList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Product, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListStockMovementsInput) (model.StockMovementList, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []model.Product); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListStockMovementsInput) model.StockMovementList); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Product)
-		}
+		r0 = ret.Get(0).(model.StockMovementList)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListStockMovementsInput) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
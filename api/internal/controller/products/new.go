@@ -9,11 +9,13 @@ import (
 
 // Controller represents the specification of this pkg
 type Controller interface {
-	List(context.Context) ([]model.Product, error)
+	List(context.Context, model.ListProductsInput) (model.ProductList, error)
 	GetByID(context.Context, int64) (model.Product, error)
 	Create(context.Context, model.CreateProductInput) (model.Product, error)
 	Delete(context.Context, int64) error
 	Update(context.Context, model.UpdateProductInput) (model.Product, error)
+	ListStockMovements(context.Context, model.ListStockMovementsInput) (model.StockMovementList, error)
+	Import(context.Context, model.ImportProductsInput) (model.ProductImportResult, error)
 }
 
 // New initializes a new Controller instance and returns it
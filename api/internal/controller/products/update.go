@@ -3,39 +3,89 @@ package products
 import (
 	"context"
 	"errors"
+	"time"
 
+	"omg/api/internal/controller/ledger"
 	"omg/api/internal/model"
+	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
+	"omg/api/pkg/db/pg"
 )
 
 func (i impl) Update(ctx context.Context, inp model.UpdateProductInput) (model.Product, error) {
-	// Check if product with this id already exists
-	p, err := i.repo.Inventory().GetProductByID(ctx, inp.ID)
-	if err != nil {
-		if errors.Is(err, inventory.ErrProductNotFound) {
-			return model.Product{}, ErrNotFound
+	var productUpToDate model.Product
+	txFunc := func(newCtx context.Context, repo repository.Registry) error {
+		// Check if product with this id already exists, locking it so the stock delta below is exact
+		p, err := repo.Inventory().GetProductByIDWithLock(newCtx, inp.ID)
+		if err != nil {
+			if errors.Is(err, inventory.ErrProductNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		if p.Status == model.ProductStatusDeleted {
+			return ErrProductDeleted
+		}
+
+		// The stock held by the pending orders stays on hand, for paying them to take it, the same as for the imports
+		if inp.Stock < p.Reserved {
+			return ErrInsufficientStock
 		}
+
+		productUpToDate, err = repo.Inventory().UpdateProduct(newCtx, model.Product{
+			ID:          p.ID,
+			Name:        inp.Name,
+			Description: inp.Description,
+			Price:       inp.Price,
+			Stock:       inp.Stock,
+			Status:      p.Status,
+		})
+		if err != nil {
+			if errors.Is(err, inventory.ErrProductNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		// Let the stock watchers know & keep the ledger, in the same tx as the change
+		return recordStockAdjustment(newCtx, repo, p, productUpToDate, inp.UpdatedBy)
+	}
+
+	// Create a new context with timeout for the transaction
+	newCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	if err := i.repo.DoInTx(newCtx, txFunc, pg.ExponentialBackOff(2, 2*time.Minute)); err != nil {
 		return model.Product{}, err
 	}
 
-	if p.Status == model.ProductStatusDeleted {
-		return model.Product{}, ErrProductDeleted
+	return productUpToDate, nil
+}
+
+// recordStockAdjustment appends the manual change of the stock on hand to the ledger & emits the product stock event
+func recordStockAdjustment(ctx context.Context, repo repository.Registry, before, after model.Product, actorID int64) error {
+	if after.Stock == before.Stock {
+		return nil
+	}
+
+	if err := ledger.RecordStockMovement(ctx, repo, model.StockMovement{
+		ProductID: after.ID,
+		Delta:     after.Stock - before.Stock,
+		Balance:   after.Stock,
+		Reason:    model.StockMovementReasonAdjustment,
+		ActorID:   actorID,
+	}); err != nil {
+		return err
 	}
 
-	productUpToDate, err := i.repo.Inventory().UpdateProduct(ctx, model.Product{
-		ID:          p.ID,
-		Name:        inp.Name,
-		Description: inp.Description,
-		Price:       inp.Price,
-		Stock:       inp.Stock,
-		Status:      p.Status,
-	})
+	event, err := model.NewProductStockEvent(after)
 	if err != nil {
-		if errors.Is(err, inventory.ErrProductNotFound) {
-			return model.Product{}, ErrNotFound
-		}
-		return model.Product{}, err
+		return ErrRecordStockEvent
+	}
+	if _, err = repo.Outbox().CreateEvent(ctx, event); err != nil {
+		return ErrRecordStockEvent
 	}
 
-	return productUpToDate, nil
+	return nil
 }
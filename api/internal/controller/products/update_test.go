@@ -8,7 +8,10 @@ import (
 	"omg/api/internal/model"
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/inventory"
+	"omg/api/internal/repository/outbox"
 
+	"github.com/cenkalti/backoff/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +23,8 @@ func TestImpl_Update(t *testing.T) {
 		getProductErr    error
 		updateProductOut model.Product
 		updateProductErr error
+		createEventErr   error
+		movementErr      error
 		expectedResult   model.Product
 		expectedErr      error
 	}
@@ -30,8 +35,9 @@ func TestImpl_Update(t *testing.T) {
 				ID:          123,
 				Name:        "Updated Name",
 				Description: "Updated Description",
-				Price:       100.0,
+				Price:       decimal.RequireFromString("100.0"),
 				Stock:       50,
+				UpdatedBy:   7,
 			},
 			existingProduct: model.Product{
 				ID:     123,
@@ -42,7 +48,7 @@ func TestImpl_Update(t *testing.T) {
 				ID:          123,
 				Name:        "Updated Name",
 				Description: "Updated Description",
-				Price:       100.0,
+				Price:       decimal.RequireFromString("100.0"),
 				Stock:       50,
 				Status:      "active",
 			},
@@ -50,7 +56,7 @@ func TestImpl_Update(t *testing.T) {
 				ID:          123,
 				Name:        "Updated Name",
 				Description: "Updated Description",
-				Price:       100.0,
+				Price:       decimal.RequireFromString("100.0"),
 				Stock:       50,
 				Status:      "active",
 			},
@@ -67,7 +73,7 @@ func TestImpl_Update(t *testing.T) {
 				ID:          123,
 				Name:        "Updated Name",
 				Description: "Updated Description",
-				Price:       100.0,
+				Price:       decimal.RequireFromString("100.0"),
 				Stock:       50,
 			},
 			existingProduct: model.Product{
@@ -77,6 +83,93 @@ func TestImpl_Update(t *testing.T) {
 			},
 			expectedErr: ErrProductDeleted,
 		},
+		"stock_below_reserved": {
+			input: model.UpdateProductInput{
+				ID:    123,
+				Name:  "Name",
+				Price: decimal.RequireFromString("10"),
+				Stock: 2,
+			},
+			existingProduct: model.Product{
+				ID:       123,
+				Stock:    10,
+				Reserved: 3,
+				Status:   "active",
+			},
+			expectedErr: ErrInsufficientStock,
+		},
+		"success_same_stock": {
+			input: model.UpdateProductInput{
+				ID:    123,
+				Name:  "Updated Name",
+				Price: decimal.RequireFromString("10"),
+				Stock: 50,
+			},
+			existingProduct: model.Product{
+				ID:     123,
+				Name:   "Old Name",
+				Stock:  50,
+				Status: "active",
+			},
+			updateProductOut: model.Product{
+				ID:     123,
+				Name:   "Updated Name",
+				Price:  decimal.RequireFromString("10"),
+				Stock:  50,
+				Status: "active",
+			},
+			expectedResult: model.Product{
+				ID:     123,
+				Name:   "Updated Name",
+				Price:  decimal.RequireFromString("10"),
+				Stock:  50,
+				Status: "active",
+			},
+		},
+		"record_stock_event_error": {
+			input: model.UpdateProductInput{
+				ID:    123,
+				Name:  "Name",
+				Price: decimal.RequireFromString("10"),
+				Stock: 5,
+			},
+			existingProduct: model.Product{
+				ID:     123,
+				Stock:  10,
+				Status: "active",
+			},
+			updateProductOut: model.Product{
+				ID:     123,
+				Name:   "Name",
+				Price:  decimal.RequireFromString("10"),
+				Stock:  5,
+				Status: "active",
+			},
+			createEventErr: errors.New("insert error"),
+			expectedErr:    ErrRecordStockEvent,
+		},
+		"record_stock_movement_error": {
+			input: model.UpdateProductInput{
+				ID:    123,
+				Name:  "Name",
+				Price: decimal.RequireFromString("10"),
+				Stock: 5,
+			},
+			existingProduct: model.Product{
+				ID:     123,
+				Stock:  10,
+				Status: "active",
+			},
+			updateProductOut: model.Product{
+				ID:     123,
+				Name:   "Name",
+				Price:  decimal.RequireFromString("10"),
+				Stock:  5,
+				Status: "active",
+			},
+			movementErr: errors.New("insert error"),
+			expectedErr: ErrRecordStockMovement,
+		},
 		"unexpected_get_error": {
 			input: model.UpdateProductInput{
 				ID: 123,
@@ -88,7 +181,7 @@ func TestImpl_Update(t *testing.T) {
 			input: model.UpdateProductInput{
 				ID:    123,
 				Name:  "Name",
-				Price: 10,
+				Price: decimal.RequireFromString("10"),
 			},
 			existingProduct: model.Product{
 				ID:     123,
@@ -105,7 +198,7 @@ func TestImpl_Update(t *testing.T) {
 			mockRepo := &repository.MockRegistry{}
 
 			// Setup expected calls
-			mockInv.On("GetProductByID", mock.Anything, tc.input.ID).
+			mockInv.On("GetProductByIDWithLock", mock.Anything, tc.input.ID).
 				Return(tc.existingProduct, tc.getProductErr)
 
 			if tc.getProductErr == nil {
@@ -114,7 +207,33 @@ func TestImpl_Update(t *testing.T) {
 				})).Return(tc.updateProductOut, tc.updateProductErr)
 			}
 
+			// The stock cannot drop below the reserved stock
+			stockMoved := tc.getProductErr == nil && tc.input.Stock >= tc.existingProduct.Reserved &&
+				tc.updateProductErr == nil && tc.updateProductOut.Stock != tc.existingProduct.Stock
+			if stockMoved {
+				// The edit is appended to the stock ledger by how much it moved the stock
+				mockInv.On("CreateStockMovement", mock.Anything, model.StockMovement{
+					ProductID: tc.input.ID,
+					Delta:     tc.updateProductOut.Stock - tc.existingProduct.Stock,
+					Balance:   tc.updateProductOut.Stock,
+					Reason:    model.StockMovementReasonAdjustment,
+					ActorID:   tc.input.UpdatedBy,
+				}).Return(model.StockMovement{}, tc.movementErr)
+			}
+
+			mockOutbox := outbox.NewMockRepository(t)
+			if stockMoved && tc.movementErr == nil {
+				mockOutbox.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e model.Event) bool {
+					return e.Type == model.EventTypeProductStockChanged && e.AggregateID == tc.input.ID
+				})).Return(model.Event{}, tc.createEventErr)
+			}
+
 			mockRepo.On("Inventory").Return(mockInv)
+			mockRepo.On("Outbox").Return(mockOutbox).Maybe()
+			mockRepo.On("DoInTx", mock.Anything, mock.AnythingOfType("func(context.Context, repository.Registry) error"), mock.Anything).
+				Return(func(ctx context.Context, txFunc func(context.Context, repository.Registry) error, _ backoff.BackOff) error {
+					return txFunc(ctx, mockRepo)
+				})
 
 			svc := impl{repo: mockRepo}
 
@@ -4,6 +4,7 @@ package system
 
 import (
 	context "context"
+	model "omg/api/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -14,23 +15,28 @@ type MockController struct {
 }
 
 // CheckReadiness provides a mock function with given fields: ctx
-func (_m *MockController) CheckReadiness(ctx context.Context) error {
+func (_m *MockController) CheckReadiness(ctx context.Context) model.Readiness {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CheckReadiness")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+	var r0 model.Readiness
+	if rf, ok := ret.Get(0).(func(context.Context) model.Readiness); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.Readiness)
 	}
 
 	return r0
 }
 
+// Drain provides a mock function with given fields:
+func (_m *MockController) Drain() {
+	_m.Called()
+}
+
 // NewMockController creates a new instance of MockController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockController(t interface {
@@ -2,21 +2,28 @@ package system
 
 import (
 	"context"
+	"sync/atomic"
 
+	"omg/api/internal/model"
 	"omg/api/internal/repository"
+	"omg/api/internal/ws"
 )
 
 // Controller represents the specification of this pkg
 type Controller interface {
-	// CheckReadiness checks if the system is ready for operation or not
-	CheckReadiness(ctx context.Context) error
+	// CheckReadiness checks if the system is ready for operation or not, reporting on each of its dependencies
+	CheckReadiness(ctx context.Context) model.Readiness
+	// Drain flags the system as shutting down, failing the readiness from then on
+	Drain()
 }
 
 // New initializes a new Controller instance and returns it
-func New(repo repository.Registry) Controller {
-	return impl{repo: repo}
+func New(repo repository.Registry, hub ws.Hub) Controller {
+	return impl{repo: repo, hub: hub, draining: &atomic.Bool{}}
 }
 
 type impl struct {
-	repo repository.Registry
+	repo     repository.Registry
+	hub      ws.Hub
+	draining *atomic.Bool
 }
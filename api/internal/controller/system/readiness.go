@@ -2,9 +2,33 @@ package system
 
 import (
 	"context"
+	"time"
+
+	"omg/api/internal/model"
 )
 
-// CheckReadiness checks if the system is ready for operation or not
-func (i impl) CheckReadiness(ctx context.Context) error {
-	return i.repo.System().CheckDB(ctx)
+// readinessCheckTimeout bounds each dependency check, so a hanging dependency fails the probe instead of timing it out
+const readinessCheckTimeout = 2 * time.Second
+
+// CheckReadiness checks if the system is ready for operation or not, reporting on each of its dependencies
+func (i impl) CheckReadiness(ctx context.Context) model.Readiness {
+	return model.Readiness{
+		Draining: i.draining.Load(),
+		Checks: []model.DependencyCheck{
+			i.check(ctx, model.DependencyDB, i.repo.System().CheckDB),
+			i.check(ctx, model.DependencyWSHub, i.hub.Ping),
+		},
+	}
+}
+
+// Drain flags the system as shutting down, failing the readiness from then on
+func (i impl) Drain() {
+	i.draining.Store(true)
+}
+
+func (i impl) check(ctx context.Context, dependency model.Dependency, fn func(context.Context) error) model.DependencyCheck {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+
+	return model.DependencyCheck{Dependency: dependency, Err: fn(ctx)}
 }
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"testing"
 
+	"omg/api/internal/model"
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/system"
+	"omg/api/internal/ws"
 
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -15,47 +17,60 @@ import (
 
 func TestImpl_CheckReadiness(t *testing.T) {
 	type arg struct {
-		givenIAMReady    bool
+		givenDraining    bool
 		mockDBRepoOutErr error
-		expDBMockCalled  bool
-		expErr           error
+		mockHubOutErr    error
+		expReady         bool
+		expDBErr         error
+		expHubErr        error
 	}
 	tcs := map[string]arg{
 		"success": {
-			givenIAMReady:   true,
-			expDBMockCalled: true,
+			expReady: true,
 		},
 		"dberr": {
-			givenIAMReady:    true,
-			expDBMockCalled:  true,
 			mockDBRepoOutErr: errors.New("some error"),
-			expErr:           errors.New("some error"),
+			expDBErr:         errors.New("some error"),
+		},
+		"hub_not_running": {
+			mockHubOutErr: ws.ErrHubNotRunning,
+			expHubErr:     ws.ErrHubNotRunning,
+		},
+		"draining": {
+			givenDraining: true,
 		},
 	}
+
 	for s, tc := range tcs {
 		t.Run(s, func(t *testing.T) {
 			// Given:
 			systemRepo := system.MockRepository{}
-			if tc.expDBMockCalled {
-				systemRepo.ExpectedCalls = []*mock.Call{
-					systemRepo.On("CheckDB", mock.Anything).Return(tc.mockDBRepoOutErr),
-				}
+			systemRepo.ExpectedCalls = []*mock.Call{
+				systemRepo.On("CheckDB", mock.Anything).Return(tc.mockDBRepoOutErr),
 			}
-
 			repo := repository.MockRegistry{}
-			if tc.expDBMockCalled {
-				repo.ExpectedCalls = []*mock.Call{
-					repo.On("System").Return(&systemRepo),
-				}
+			repo.ExpectedCalls = []*mock.Call{
+				repo.On("System").Return(&systemRepo),
 			}
+			hub := ws.NewMockHub(t)
+			hub.On("Ping", mock.Anything).Return(tc.mockHubOutErr)
 
-			c := New(&repo)
+			c := New(&repo, hub)
+			if tc.givenDraining {
+				c.Drain()
+			}
 
 			// When:
-			err := c.CheckReadiness(context.Background())
+			readiness := c.CheckReadiness(context.Background())
 
 			// Then:
-			require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+			require.Equal(t, tc.expReady, readiness.IsReady())
+			require.Equal(t, tc.givenDraining, readiness.Draining)
+			require.Len(t, readiness.Checks, 2)
+			require.Equal(t, model.DependencyDB, readiness.Checks[0].Dependency)
+			require.Equal(t, tc.expDBErr, pkgerrors.Cause(readiness.Checks[0].Err))
+			require.Equal(t, model.DependencyWSHub, readiness.Checks[1].Dependency)
+			require.Equal(t, tc.expHubErr, readiness.Checks[1].Err)
 		})
 	}
 }
@@ -33,6 +33,7 @@ func (i impl) Create(ctx context.Context, inp model.CreateUserInput) (model.User
 		Email:    inp.Email,
 		Password: string(hashedPassword),
 		Status:   model.UserStatusActive,
+		Role:     model.UserRoleCustomer,
 	}
 
 	return i.repo.User().CreateUser(ctx, m)
@@ -37,6 +37,7 @@ func TestImpl_Create(t *testing.T) {
 				Name:     "Test User",
 				Email:    "test@example.com",
 				Status:   model.UserStatusActive,
+				Role:     model.UserRoleCustomer,
 				Password: "$2a$10$somehashedpassword",
 			},
 			mockGetByEmailErr: user.ErrNotFound,
@@ -46,6 +47,7 @@ func TestImpl_Create(t *testing.T) {
 				Name:     "Test User",
 				Email:    "test@example.com",
 				Status:   model.UserStatusActive,
+				Role:     model.UserRoleCustomer,
 				Password: "$2a$10$somehashedpassword",
 			},
 			expErr: nil,
@@ -107,6 +109,7 @@ func TestImpl_Create(t *testing.T) {
 						return u.Email == tc.givenInput.Email &&
 							u.Name == tc.givenInput.Name &&
 							u.Status == model.UserStatusActive &&
+							u.Role == model.UserRoleCustomer &&
 							len(u.Password) > 0 // Just check that password is not empty
 					})).Return(tc.mockUserRepoOut, tc.mockCreateUserErr)
 				}
@@ -131,6 +134,7 @@ func TestImpl_Create(t *testing.T) {
 				require.Equal(t, tc.expResult.Name, result.Name)
 				require.Equal(t, tc.expResult.Email, result.Email)
 				require.Equal(t, tc.expResult.Status, result.Status)
+				require.Equal(t, tc.expResult.Role, result.Role)
 				require.NotEmpty(t, result.Password)
 			}
 		})
@@ -6,4 +6,8 @@ var (
 	ErrUserNotFound      = errors.New("user not found")
 	ErrUserAlreadyExists = errors.New("user already exists")
 	ErrHashedPassword    = errors.New("failed to hash password")
+	ErrInvalidUserStatus = errors.New("invalid user status")
+	ErrInvalidDateRange  = errors.New("invalid date range")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+	ErrInvalidLimit      = errors.New("invalid limit")
 )
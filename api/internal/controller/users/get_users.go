@@ -4,14 +4,65 @@ import (
 	"context"
 
 	"omg/api/internal/model"
+	"omg/api/internal/repository/user"
+	"omg/api/pkg/pagination"
 )
 
-// GetUsers retrieve all users data
-func (i impl) GetUsers(ctx context.Context) ([]model.User, error) {
-	users, err := i.repo.User().GetUsers(ctx)
+const (
+	// defaultListLimit is the page size when the client does not ask for one
+	defaultListLimit = 20
+	// maxListLimit is the biggest page size a client can ask for
+	maxListLimit = 100
+)
+
+// GetUsers retrieve a page of users matching the given filters
+func (i impl) GetUsers(ctx context.Context, inp model.ListUsersInput) (model.UserList, error) {
+	for _, s := range inp.Status {
+		if !s.IsValid() {
+			return model.UserList{}, ErrInvalidUserStatus
+		}
+	}
+
+	if !inp.CreatedFrom.IsZero() && !inp.CreatedTo.IsZero() && inp.CreatedFrom.After(inp.CreatedTo) {
+		return model.UserList{}, ErrInvalidDateRange
+	}
+
+	limit := inp.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 0 || limit > maxListLimit {
+		return model.UserList{}, ErrInvalidLimit
+	}
+
+	filter := user.UsersFilter{
+		Search:      inp.Search,
+		Status:      inp.Status,
+		CreatedFrom: inp.CreatedFrom,
+		CreatedTo:   inp.CreatedTo,
+		Limit:       limit + 1, // one extra to know if there is a next page
+	}
+
+	if inp.Cursor != "" {
+		after, err := pagination.DecodeCursor(inp.Cursor)
+		if err != nil {
+			return model.UserList{}, ErrInvalidCursor
+		}
+		filter.After = &after
+	}
+
+	users, err := i.repo.User().GetUsers(ctx, filter)
 	if err != nil {
-		return nil, err
+		return model.UserList{}, err
+	}
+
+	var result model.UserList
+	if len(users) > limit {
+		users = users[:limit]
+		last := users[limit-1]
+		result.NextCursor = pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode()
 	}
+	result.Users = users
 
-	return users, nil
+	return result, nil
 }
@@ -4,21 +4,28 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"omg/api/internal/model"
 	"omg/api/internal/repository"
 	"omg/api/internal/repository/user"
+	"omg/api/pkg/pagination"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func Test_impl_GetUsers(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	cursor := pagination.Cursor{CreatedAt: createdAt, ID: 2}
+
 	type arg struct {
+		givenInput        model.ListUsersInput
 		mockGetUsersOut   []model.User
 		mockGetUsersErr   error
 		expRepoMockCalled bool
-		expResult         []model.User
+		expFilter         user.UsersFilter
+		expResult         model.UserList
 		expErr            error
 	}
 
@@ -34,27 +41,82 @@ func Test_impl_GetUsers(t *testing.T) {
 			},
 			mockGetUsersErr:   nil,
 			expRepoMockCalled: true,
-			expResult: []model.User{
-				{
-					ID:     1,
-					Name:   "Test User",
-					Email:  "test@example.com",
-					Status: model.UserStatusActive,
+			expFilter:         user.UsersFilter{Limit: defaultListLimit + 1},
+			expResult: model.UserList{
+				Users: []model.User{
+					{
+						ID:     1,
+						Name:   "Test User",
+						Email:  "test@example.com",
+						Status: model.UserStatusActive,
+					},
 				},
 			},
 			expErr: nil,
 		},
+		"success_with_next_page": {
+			givenInput: model.ListUsersInput{Limit: 2},
+			mockGetUsersOut: []model.User{
+				{ID: 3, CreatedAt: createdAt},
+				{ID: 2, CreatedAt: createdAt},
+				{ID: 1, CreatedAt: createdAt},
+			},
+			expRepoMockCalled: true,
+			expFilter:         user.UsersFilter{Limit: 3},
+			expResult: model.UserList{
+				Users: []model.User{
+					{ID: 3, CreatedAt: createdAt},
+					{ID: 2, CreatedAt: createdAt},
+				},
+				NextCursor: cursor.Encode(),
+			},
+		},
+		"success_with_cursor_and_filters": {
+			givenInput: model.ListUsersInput{
+				Search:      "test",
+				Status:      []model.UserStatus{model.UserStatusDeleted},
+				CreatedFrom: createdAt.Add(-time.Hour),
+				CreatedTo:   createdAt,
+				Cursor:      cursor.Encode(),
+				Limit:       10,
+			},
+			expRepoMockCalled: true,
+			expFilter: user.UsersFilter{
+				Search:      "test",
+				Status:      []model.UserStatus{model.UserStatusDeleted},
+				CreatedFrom: createdAt.Add(-time.Hour),
+				CreatedTo:   createdAt,
+				After:       &cursor,
+				Limit:       11,
+			},
+		},
 		"empty": {
 			mockGetUsersOut:   nil,
 			mockGetUsersErr:   nil,
 			expRepoMockCalled: true,
-			expResult:         nil,
+			expFilter:         user.UsersFilter{Limit: defaultListLimit + 1},
 			expErr:            nil,
 		},
+		"invalid_status": {
+			givenInput: model.ListUsersInput{Status: []model.UserStatus{"UNKNOWN"}},
+			expErr:     ErrInvalidUserStatus,
+		},
+		"invalid_date_range": {
+			givenInput: model.ListUsersInput{CreatedFrom: createdAt, CreatedTo: createdAt.Add(-time.Hour)},
+			expErr:     ErrInvalidDateRange,
+		},
+		"invalid_cursor": {
+			givenInput: model.ListUsersInput{Cursor: "not-a-cursor"},
+			expErr:     ErrInvalidCursor,
+		},
+		"invalid_limit": {
+			givenInput: model.ListUsersInput{Limit: maxListLimit + 1},
+			expErr:     ErrInvalidLimit,
+		},
 		"database_error": {
 			mockGetUsersErr:   errors.New("database error"),
 			expRepoMockCalled: true,
-			expResult:         nil,
+			expFilter:         user.UsersFilter{Limit: defaultListLimit + 1},
 			expErr:            errors.New("database error"),
 		},
 	}
@@ -66,25 +128,26 @@ func Test_impl_GetUsers(t *testing.T) {
 
 			if tc.expRepoMockCalled {
 				// Mock GetUsers call
-				userRepo.On("GetUsers", mock.Anything).Return(tc.mockGetUsersOut, tc.mockGetUsersErr)
+				userRepo.On("GetUsers", mock.Anything, tc.expFilter).Return(tc.mockGetUsersOut, tc.mockGetUsersErr)
 			}
 
 			repo := repository.MockRegistry{}
-			repo.On("User").Return(&userRepo)
+			repo.On("User").Return(&userRepo).Maybe()
 
 			impl := impl{repo: &repo}
 
 			// When:
-			result, err := impl.GetUsers(context.Background())
+			result, err := impl.GetUsers(context.Background(), tc.givenInput)
 
 			// Then:
 			if tc.expErr != nil {
 				require.Error(t, err)
 				require.Equal(t, tc.expErr.Error(), err.Error())
-				require.Equal(t, tc.expResult, result)
+				require.Equal(t, model.UserList{}, result)
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.expResult, result)
+				require.Equal(t, tc.expResult.NextCursor != "", result.HasMore())
 			}
 
 			userRepo.AssertExpectations(t)
@@ -116,29 +116,27 @@ func (_m *MockController) GetByID(_a0 context.Context, _a1 int64) (model.User, e
 	return r0, r1
 }
 
-// GetUsers provides a mock function with given fields: _a0
-func (_m *MockController) GetUsers(_a0 context.Context) ([]model.User, error) {
-	ret := _m.Called(_a0)
+// GetUsers provides a mock function with given fields: _a0, _a1
+func (_m *MockController) GetUsers(_a0 context.Context, _a1 model.ListUsersInput) (model.UserList, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUsers")
 	}
 
-	var r0 []model.User
+	var r0 model.UserList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]model.User, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListUsersInput) (model.UserList, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []model.User); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListUsersInput) model.UserList); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.User)
-		}
+		r0 = ret.Get(0).(model.UserList)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListUsersInput) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -11,7 +11,7 @@ type Controller interface {
 	Create(context.Context, model.CreateUserInput) (model.User, error)
 	GetByID(context.Context, int64) (model.User, error)
 	GetByEmail(context.Context, string) (model.User, error)
-	GetUsers(context.Context) ([]model.User, error)
+	GetUsers(context.Context, model.ListUsersInput) (model.UserList, error)
 	Update(context.Context, model.UpdateUserInput) (model.User, error)
 	Delete(context.Context, int64) error
 }
@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"slices"
+
+	"omg/api/internal/model"
+)
+
+// minSecretLen is the shortest secret a partner can choose, a generated one is 32 random bytes
+const minSecretLen = 16
+
+// CreateEndpoint registers the webhook endpoint, with a generated secret when none is given
+func (i impl) CreateEndpoint(ctx context.Context, inp model.CreateWebhookEndpointInput) (model.WebhookEndpoint, error) {
+	u, err := url.Parse(inp.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return model.WebhookEndpoint{}, ErrInvalidURL
+	}
+
+	if len(inp.EventTypes) == 0 {
+		return model.WebhookEndpoint{}, ErrNoEventTypes
+	}
+
+	var eventTypes []model.EventType
+	for _, t := range inp.EventTypes {
+		if !t.IsOrderEvent() {
+			return model.WebhookEndpoint{}, ErrInvalidEventType
+		}
+		if !slices.Contains(eventTypes, t) {
+			eventTypes = append(eventTypes, t)
+		}
+	}
+
+	secret := inp.Secret
+	if secret == "" {
+		b := make([]byte, 32)
+		if _, err = rand.Read(b); err != nil {
+			return model.WebhookEndpoint{}, ErrGenerateSecret
+		}
+		secret = hex.EncodeToString(b)
+	} else if len(secret) < minSecretLen {
+		return model.WebhookEndpoint{}, ErrInvalidSecret
+	}
+
+	return i.repo.Webhook().CreateEndpoint(ctx, model.WebhookEndpoint{
+		URL:        u.String(),
+		Secret:     secret,
+		EventTypes: eventTypes,
+		Status:     model.WebhookEndpointStatusActive,
+	})
+}
@@ -0,0 +1,119 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/webhook"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_CreateEndpoint(t *testing.T) {
+	type arg struct {
+		givenInput        model.CreateWebhookEndpointInput
+		mockCreateErr     error
+		expRepoMockCalled bool
+		expEventTypes     []model.EventType
+		expSecret         string
+		expErr            error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenInput: model.CreateWebhookEndpointInput{
+				URL:        "https://partner.example.com/hooks",
+				Secret:     "0123456789abcdef",
+				EventTypes: []model.EventType{model.EventTypeOrderCreated, model.EventTypeOrderCreated},
+			},
+			expRepoMockCalled: true,
+			expEventTypes:     []model.EventType{model.EventTypeOrderCreated},
+			expSecret:         "0123456789abcdef",
+		},
+		"success_generated_secret": {
+			givenInput: model.CreateWebhookEndpointInput{
+				URL:        "https://partner.example.com/hooks",
+				EventTypes: []model.EventType{model.EventTypeOrderStatusChanged},
+			},
+			expRepoMockCalled: true,
+			expEventTypes:     []model.EventType{model.EventTypeOrderStatusChanged},
+		},
+		"invalid_url": {
+			givenInput: model.CreateWebhookEndpointInput{
+				URL:        "ftp://partner.example.com/hooks",
+				EventTypes: []model.EventType{model.EventTypeOrderCreated},
+			},
+			expErr: ErrInvalidURL,
+		},
+		"no_event_types": {
+			givenInput: model.CreateWebhookEndpointInput{URL: "https://partner.example.com/hooks"},
+			expErr:     ErrNoEventTypes,
+		},
+		"invalid_event_type": {
+			givenInput: model.CreateWebhookEndpointInput{
+				URL:        "https://partner.example.com/hooks",
+				EventTypes: []model.EventType{model.EventTypeProductStockChanged},
+			},
+			expErr: ErrInvalidEventType,
+		},
+		"invalid_secret": {
+			givenInput: model.CreateWebhookEndpointInput{
+				URL:        "https://partner.example.com/hooks",
+				Secret:     "short",
+				EventTypes: []model.EventType{model.EventTypeOrderCreated},
+			},
+			expErr: ErrInvalidSecret,
+		},
+		"database_error": {
+			givenInput: model.CreateWebhookEndpointInput{
+				URL:        "https://partner.example.com/hooks",
+				Secret:     "0123456789abcdef",
+				EventTypes: []model.EventType{model.EventTypeOrderCreated},
+			},
+			mockCreateErr:     errors.New("database error"),
+			expRepoMockCalled: true,
+			expEventTypes:     []model.EventType{model.EventTypeOrderCreated},
+			expSecret:         "0123456789abcdef",
+			expErr:            errors.New("database error"),
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			webhookRepo := webhook.NewMockRepository(t)
+			if tc.expRepoMockCalled {
+				webhookRepo.On("CreateEndpoint", mock.Anything, mock.MatchedBy(func(e model.WebhookEndpoint) bool {
+					secretOK := e.Secret == tc.expSecret || (tc.expSecret == "" && len(e.Secret) == 64)
+					return e.URL == tc.givenInput.URL &&
+						secretOK &&
+						e.Status == model.WebhookEndpointStatusActive &&
+						slices.Equal(tc.expEventTypes, e.EventTypes)
+				})).Return(func(_ context.Context, e model.WebhookEndpoint) model.WebhookEndpoint {
+					e.ID = 1
+					return e
+				}, tc.mockCreateErr)
+			}
+
+			repo := repository.NewMockRegistry(t)
+			repo.On("Webhook").Return(webhookRepo).Maybe()
+
+			// When:
+			endpoint, err := New(repo).CreateEndpoint(context.Background(), tc.givenInput)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, int64(1), endpoint.ID)
+				require.NotEmpty(t, endpoint.Secret)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/webhook"
+)
+
+// DeleteEndpoint disables the webhook endpoint, its deliveries are kept for the back-office
+func (i impl) DeleteEndpoint(ctx context.Context, id int64) error {
+	if err := i.repo.Webhook().UpdateEndpointStatus(ctx, id, model.WebhookEndpointStatusDisabled); err != nil {
+		if errors.Is(err, webhook.ErrEndpointNotFound) {
+			return ErrEndpointNotFound
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/webhook"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_DeleteEndpoint(t *testing.T) {
+	type arg struct {
+		mockUpdateErr error
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"success": {},
+		"not_found": {
+			mockUpdateErr: pkgerrors.WithStack(webhook.ErrEndpointNotFound),
+			expErr:        ErrEndpointNotFound,
+		},
+		"database_error": {
+			mockUpdateErr: errors.New("database error"),
+			expErr:        errors.New("database error"),
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			webhookRepo := webhook.NewMockRepository(t)
+			webhookRepo.On("UpdateEndpointStatus", mock.Anything, int64(1), model.WebhookEndpointStatusDisabled).Return(tc.mockUpdateErr)
+
+			repo := repository.NewMockRegistry(t)
+			repo.On("Webhook").Return(webhookRepo)
+
+			// When:
+			err := New(repo).DeleteEndpoint(context.Background(), 1)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
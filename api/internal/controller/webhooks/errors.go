@@ -0,0 +1,18 @@
+package webhooks
+
+import "errors"
+
+var (
+	ErrEndpointNotFound      = errors.New("webhook endpoint not found")
+	ErrEndpointDisabled      = errors.New("webhook endpoint disabled")
+	ErrInvalidURL            = errors.New("invalid webhook url")
+	ErrInvalidSecret         = errors.New("webhook secret must be at least 16 characters")
+	ErrInvalidEventType      = errors.New("invalid webhook event type")
+	ErrNoEventTypes          = errors.New("at least one event type is required")
+	ErrDeliveryNotFound      = errors.New("webhook delivery not found")
+	ErrDeliveryNotDead       = errors.New("only dead webhook deliveries can be redelivered")
+	ErrInvalidDeliveryStatus = errors.New("invalid webhook delivery status")
+	ErrInvalidCursor         = errors.New("invalid cursor")
+	ErrInvalidLimit          = errors.New("invalid limit")
+	ErrGenerateSecret        = errors.New("fail to generate webhook secret")
+)
@@ -0,0 +1,62 @@
+package webhooks
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/webhook"
+	"omg/api/pkg/pagination"
+)
+
+const (
+	// defaultListLimit is the page size when the client does not ask for one
+	defaultListLimit = 20
+	// maxListLimit is the biggest page size a client can ask for
+	maxListLimit = 100
+)
+
+// ListDeliveries retrieve a page of webhook deliveries matching the given filters
+func (i impl) ListDeliveries(ctx context.Context, inp model.ListWebhookDeliveriesInput) (model.WebhookDeliveryList, error) {
+	for _, s := range inp.Status {
+		if !s.IsValid() {
+			return model.WebhookDeliveryList{}, ErrInvalidDeliveryStatus
+		}
+	}
+
+	limit := inp.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 0 || limit > maxListLimit {
+		return model.WebhookDeliveryList{}, ErrInvalidLimit
+	}
+
+	filter := webhook.DeliveriesFilter{
+		EndpointID: inp.EndpointID,
+		Status:     inp.Status,
+		Limit:      limit + 1, // one extra to know if there is a next page
+	}
+
+	if inp.Cursor != "" {
+		after, err := pagination.DecodeCursor(inp.Cursor)
+		if err != nil {
+			return model.WebhookDeliveryList{}, ErrInvalidCursor
+		}
+		filter.After = &after
+	}
+
+	deliveries, err := i.repo.Webhook().ListDeliveries(ctx, filter)
+	if err != nil {
+		return model.WebhookDeliveryList{}, err
+	}
+
+	var result model.WebhookDeliveryList
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+		last := deliveries[limit-1]
+		result.NextCursor = pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode()
+	}
+	result.Deliveries = deliveries
+
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/webhook"
+	"omg/api/pkg/pagination"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_ListDeliveries(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	cursor := pagination.Cursor{CreatedAt: createdAt, ID: 2}
+
+	type arg struct {
+		givenInput        model.ListWebhookDeliveriesInput
+		mockOut           []model.WebhookDelivery
+		mockErr           error
+		expRepoMockCalled bool
+		expFilter         webhook.DeliveriesFilter
+		expResult         model.WebhookDeliveryList
+		expErr            error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenInput: model.ListWebhookDeliveriesInput{
+				EndpointID: 9,
+				Status:     []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusDead},
+			},
+			mockOut:           []model.WebhookDelivery{{ID: 1, Status: model.WebhookDeliveryStatusDead}},
+			expRepoMockCalled: true,
+			expFilter: webhook.DeliveriesFilter{
+				EndpointID: 9,
+				Status:     []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusDead},
+				Limit:      defaultListLimit + 1,
+			},
+			expResult: model.WebhookDeliveryList{
+				Deliveries: []model.WebhookDelivery{{ID: 1, Status: model.WebhookDeliveryStatusDead}},
+			},
+		},
+		"success_with_next_page": {
+			givenInput: model.ListWebhookDeliveriesInput{Limit: 2, Cursor: cursor.Encode()},
+			mockOut: []model.WebhookDelivery{
+				{ID: 3, CreatedAt: createdAt},
+				{ID: 2, CreatedAt: createdAt},
+				{ID: 1, CreatedAt: createdAt},
+			},
+			expRepoMockCalled: true,
+			expFilter:         webhook.DeliveriesFilter{After: &cursor, Limit: 3},
+			expResult: model.WebhookDeliveryList{
+				Deliveries: []model.WebhookDelivery{
+					{ID: 3, CreatedAt: createdAt},
+					{ID: 2, CreatedAt: createdAt},
+				},
+				NextCursor: cursor.Encode(),
+			},
+		},
+		"invalid_status": {
+			givenInput: model.ListWebhookDeliveriesInput{Status: []model.WebhookDeliveryStatus{"UNKNOWN"}},
+			expErr:     ErrInvalidDeliveryStatus,
+		},
+		"invalid_cursor": {
+			givenInput: model.ListWebhookDeliveriesInput{Cursor: "not-a-cursor"},
+			expErr:     ErrInvalidCursor,
+		},
+		"invalid_limit": {
+			givenInput: model.ListWebhookDeliveriesInput{Limit: maxListLimit + 1},
+			expErr:     ErrInvalidLimit,
+		},
+		"database_error": {
+			mockErr:           errors.New("database error"),
+			expRepoMockCalled: true,
+			expFilter:         webhook.DeliveriesFilter{Limit: defaultListLimit + 1},
+			expErr:            errors.New("database error"),
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			webhookRepo := webhook.NewMockRepository(t)
+			if tc.expRepoMockCalled {
+				webhookRepo.On("ListDeliveries", mock.Anything, tc.expFilter).Return(tc.mockOut, tc.mockErr)
+			}
+
+			repo := repository.NewMockRegistry(t)
+			repo.On("Webhook").Return(webhookRepo).Maybe()
+
+			// When:
+			result, err := New(repo).ListDeliveries(context.Background(), tc.givenInput)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+				require.Equal(t, model.WebhookDeliveryList{}, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResult, result)
+				require.Equal(t, tc.expResult.NextCursor != "", result.HasMore())
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package webhooks
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+)
+
+// ListEndpoints retrieve all the webhook endpoints
+func (i impl) ListEndpoints(ctx context.Context) ([]model.WebhookEndpoint, error) {
+	return i.repo.Webhook().ListEndpoints(ctx)
+}
@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/webhook"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_ListEndpoints(t *testing.T) {
+	type arg struct {
+		mockOut []model.WebhookEndpoint
+		mockErr error
+		expErr  error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			mockOut: []model.WebhookEndpoint{{ID: 1, URL: "https://partner.example.com/hooks"}},
+		},
+		"database_error": {
+			mockErr: errors.New("database error"),
+			expErr:  errors.New("database error"),
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			webhookRepo := webhook.NewMockRepository(t)
+			webhookRepo.On("ListEndpoints", mock.Anything).Return(tc.mockOut, tc.mockErr)
+
+			repo := repository.NewMockRegistry(t)
+			repo.On("Webhook").Return(webhookRepo)
+
+			// When:
+			endpoints, err := New(repo).ListEndpoints(context.Background())
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.mockOut, endpoints)
+			}
+		})
+	}
+}
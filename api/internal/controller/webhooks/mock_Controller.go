@@ -0,0 +1,161 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package webhooks
+
+import (
+	context "context"
+	model "omg/api/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockController is an autogenerated mock type for the Controller type
+type MockController struct {
+	mock.Mock
+}
+
+// CreateEndpoint provides a mock function with given fields: _a0, _a1
+func (_m *MockController) CreateEndpoint(_a0 context.Context, _a1 model.CreateWebhookEndpointInput) (model.WebhookEndpoint, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEndpoint")
+	}
+
+	var r0 model.WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.CreateWebhookEndpointInput) (model.WebhookEndpoint, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.CreateWebhookEndpointInput) model.WebhookEndpoint); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.WebhookEndpoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.CreateWebhookEndpointInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteEndpoint provides a mock function with given fields: _a0, _a1
+func (_m *MockController) DeleteEndpoint(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListDeliveries provides a mock function with given fields: _a0, _a1
+func (_m *MockController) ListDeliveries(_a0 context.Context, _a1 model.ListWebhookDeliveriesInput) (model.WebhookDeliveryList, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 model.WebhookDeliveryList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListWebhookDeliveriesInput) (model.WebhookDeliveryList, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListWebhookDeliveriesInput) model.WebhookDeliveryList); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDeliveryList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListWebhookDeliveriesInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEndpoints provides a mock function with given fields: _a0
+func (_m *MockController) ListEndpoints(_a0 context.Context) ([]model.WebhookEndpoint, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEndpoints")
+	}
+
+	var r0 []model.WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.WebhookEndpoint, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.WebhookEndpoint); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WebhookEndpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Redeliver provides a mock function with given fields: _a0, _a1
+func (_m *MockController) Redeliver(_a0 context.Context, _a1 int64) (model.WebhookDelivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 model.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.WebhookDelivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.WebhookDelivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockController creates a new instance of MockController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockController {
+	mock := &MockController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
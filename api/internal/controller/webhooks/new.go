@@ -0,0 +1,26 @@
+package webhooks
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+)
+
+// Controller represents the specification of this pkg
+type Controller interface {
+	CreateEndpoint(context.Context, model.CreateWebhookEndpointInput) (model.WebhookEndpoint, error)
+	ListEndpoints(context.Context) ([]model.WebhookEndpoint, error)
+	DeleteEndpoint(context.Context, int64) error
+	ListDeliveries(context.Context, model.ListWebhookDeliveriesInput) (model.WebhookDeliveryList, error)
+	Redeliver(context.Context, int64) (model.WebhookDelivery, error)
+}
+
+// New initializes a new Controller instance and returns it
+func New(repo repository.Registry) Controller {
+	return impl{repo: repo}
+}
+
+type impl struct {
+	repo repository.Registry
+}
@@ -0,0 +1,44 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/webhook"
+)
+
+// Redeliver queues the dead delivery again, due right away & with a fresh set of retries
+func (i impl) Redeliver(ctx context.Context, id int64) (model.WebhookDelivery, error) {
+	d, err := i.repo.Webhook().GetDeliveryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, webhook.ErrDeliveryNotFound) {
+			return model.WebhookDelivery{}, ErrDeliveryNotFound
+		}
+		return model.WebhookDelivery{}, err
+	}
+
+	// Only the dead deliveries are left alone by the deliverer, so they are safe to reset
+	if d.Status != model.WebhookDeliveryStatusDead {
+		return model.WebhookDelivery{}, ErrDeliveryNotDead
+	}
+
+	endpoint, err := i.repo.Webhook().GetEndpointByID(ctx, d.EndpointID)
+	if err != nil {
+		return model.WebhookDelivery{}, err
+	}
+	if endpoint.Status != model.WebhookEndpointStatusActive {
+		return model.WebhookDelivery{}, ErrEndpointDisabled
+	}
+
+	// Reset only if still dead, as another redeliver may have requeued it since & the deliverer may be attempting it
+	d, err = i.repo.Webhook().RequeueDeadDelivery(ctx, id)
+	if err != nil {
+		if errors.Is(err, webhook.ErrDeliveryNotDead) {
+			return model.WebhookDelivery{}, ErrDeliveryNotDead
+		}
+		return model.WebhookDelivery{}, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,108 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/webhook"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_Redeliver(t *testing.T) {
+	dead := model.WebhookDelivery{ID: 1, EndpointID: 9, Status: model.WebhookDeliveryStatusDead, Attempts: 13, LastError: "timeout"}
+	active := model.WebhookEndpoint{ID: 9, Status: model.WebhookEndpointStatusActive}
+
+	type arg struct {
+		mockGetOut        model.WebhookDelivery
+		mockGetErr        error
+		mockEndpointOut   model.WebhookEndpoint
+		mockEndpointErr   error
+		expEndpointCalled bool
+		mockRequeueErr    error
+		expRequeueCalled  bool
+		expErr            error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			mockGetOut:        dead,
+			mockEndpointOut:   active,
+			expEndpointCalled: true,
+			expRequeueCalled:  true,
+		},
+		"not_found": {
+			mockGetErr: pkgerrors.WithStack(webhook.ErrDeliveryNotFound),
+			expErr:     ErrDeliveryNotFound,
+		},
+		"not_dead": {
+			mockGetOut: model.WebhookDelivery{ID: 1, EndpointID: 9, Status: model.WebhookDeliveryStatusPending},
+			expErr:     ErrDeliveryNotDead,
+		},
+		"endpoint_disabled": {
+			mockGetOut:        dead,
+			mockEndpointOut:   model.WebhookEndpoint{ID: 9, Status: model.WebhookEndpointStatusDisabled},
+			expEndpointCalled: true,
+			expErr:            ErrEndpointDisabled,
+		},
+		"endpoint_error": {
+			mockGetOut:        dead,
+			mockEndpointErr:   errors.New("database error"),
+			expEndpointCalled: true,
+			expErr:            errors.New("database error"),
+		},
+		"requeued_meanwhile": {
+			mockGetOut:        dead,
+			mockEndpointOut:   active,
+			expEndpointCalled: true,
+			mockRequeueErr:    pkgerrors.WithStack(webhook.ErrDeliveryNotDead),
+			expRequeueCalled:  true,
+			expErr:            ErrDeliveryNotDead,
+		},
+		"requeue_error": {
+			mockGetOut:        dead,
+			mockEndpointOut:   active,
+			expEndpointCalled: true,
+			mockRequeueErr:    errors.New("database error"),
+			expRequeueCalled:  true,
+			expErr:            errors.New("database error"),
+		},
+	}
+
+	for s, tc := range tcs {
+		t.Run(s, func(t *testing.T) {
+			// Given:
+			webhookRepo := webhook.NewMockRepository(t)
+			webhookRepo.On("GetDeliveryByID", mock.Anything, int64(1)).Return(tc.mockGetOut, tc.mockGetErr)
+			if tc.expEndpointCalled {
+				webhookRepo.On("GetEndpointByID", mock.Anything, int64(9)).Return(tc.mockEndpointOut, tc.mockEndpointErr)
+			}
+			if tc.expRequeueCalled {
+				requeued := tc.mockGetOut
+				requeued.Status = model.WebhookDeliveryStatusPending
+				requeued.Attempts = 0
+				webhookRepo.On("RequeueDeadDelivery", mock.Anything, int64(1)).Return(requeued, tc.mockRequeueErr)
+			}
+
+			repo := repository.NewMockRegistry(t)
+			repo.On("Webhook").Return(webhookRepo)
+
+			// When:
+			d, err := New(repo).Redeliver(context.Background(), 1)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, model.WebhookDeliveryStatusPending, d.Status)
+				require.Equal(t, "timeout", d.LastError)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/pkg/db/pg"
+)
+
+// DispatchPending publishes one batch of pending events & returns how many were published.
+// The events stay locked until the tx ends so that several replicas can dispatch concurrently, though the replicas
+// assigning the publishing seqs take turns.
+func (i impl) DispatchPending(ctx context.Context) (int, error) {
+	var published int
+	err := i.repo.DoInTx(ctx, func(ctx context.Context, txRepo repository.Registry) error {
+		published = 0
+
+		events, err := txRepo.Outbox().ListPendingEvents(ctx, i.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			// Assigned before publishing, as the clients resume from the seq they got
+			if event.PublishedSeq, err = txRepo.Outbox().NextPublishedSeq(ctx); err != nil {
+				return err
+			}
+			// The sinks write within a savepoint, so a failing one rolls its writes & notifications back without
+			// aborting the tx, which then records the failed attempt
+			if err := txRepo.DoInSavepoint(ctx, func(ctx context.Context) error {
+				return i.publish(ctx, txRepo, event)
+			}); err != nil {
+				log.Printf("Failed to publish event %d (%s), attempt %d: %v", event.ID, event.Type, event.Attempts+1, err)
+				if err := txRepo.Outbox().MarkEventAttemptFailed(ctx, event.ID, err.Error(), i.maxAttempts); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := txRepo.Outbox().MarkEventPublished(ctx, event.ID, event.PublishedSeq); err != nil {
+				return err
+			}
+			published++
+		}
+
+		return nil
+	}, pg.ExponentialBackOff(2, time.Minute))
+
+	return published, err
+}
+
+func (i impl) publish(ctx context.Context, txRepo repository.Registry, event model.Event) error {
+	for _, sink := range i.sinks {
+		if err := sink.Publish(ctx, txRepo, event); err != nil {
+			return fmt.Errorf("%s: %w", sink.Name(), err)
+		}
+	}
+	return nil
+}
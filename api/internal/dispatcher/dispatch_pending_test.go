@@ -0,0 +1,139 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/outbox"
+
+	"github.com/cenkalti/backoff/v4"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_DispatchPending(t *testing.T) {
+	events := []model.Event{
+		{ID: 1, Type: model.EventTypeOrderCreated, AggregateID: 11, Status: model.EventStatusPending},
+		{ID: 2, Type: model.EventTypeOrderStatusChanged, AggregateID: 11, Status: model.EventStatusPending, Attempts: 3},
+	}
+
+	type arg struct {
+		mockEvents     []model.Event
+		mockListErr    error
+		mockSeqErr     error
+		mockPublishErr map[int64]error
+		mockMarkErr    error
+		expPublished   []int64
+		expFailed      []int64
+		expResult      int
+		expErr         error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			mockEvents:   events,
+			expPublished: []int64{1, 2},
+			expResult:    2,
+		},
+		"no_pending_events": {},
+		"publish_error": {
+			mockEvents:     events,
+			mockPublishErr: map[int64]error{2: errors.New("sink down")},
+			expPublished:   []int64{1},
+			expFailed:      []int64{2},
+			expResult:      1,
+		},
+		"webhook_sink_db_error": {
+			mockEvents:     events,
+			mockPublishErr: map[int64]error{1: errors.New("database error")},
+			expPublished:   []int64{2},
+			expFailed:      []int64{1},
+			expResult:      1,
+		},
+		"list_error": {
+			mockListErr: errors.New("database error"),
+			expErr:      errors.New("database error"),
+		},
+		"next_seq_error": {
+			mockEvents: events,
+			mockSeqErr: errors.New("database error"),
+			expErr:     errors.New("database error"),
+		},
+		"mark_published_error": {
+			mockEvents:   events[:1],
+			mockMarkErr:  errors.New("database error"),
+			expPublished: []int64{1},
+			expErr:       errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given:
+			outboxRepo := outbox.NewMockRepository(t)
+			outboxRepo.On("ListPendingEvents", mock.Anything, defaultBatchSize).Return(tc.mockEvents, tc.mockListErr)
+			// The publishing seq of an event is 100 + its ID
+			var published []model.Event
+			for _, e := range tc.mockEvents {
+				outboxRepo.On("NextPublishedSeq", mock.Anything).Return(100+e.ID, tc.mockSeqErr).Once()
+				if tc.mockSeqErr != nil {
+					break
+				}
+				e.PublishedSeq = 100 + e.ID
+				published = append(published, e)
+			}
+			for _, id := range tc.expPublished {
+				outboxRepo.On("MarkEventPublished", mock.Anything, id, 100+id).Return(tc.mockMarkErr)
+			}
+			for _, id := range tc.expFailed {
+				outboxRepo.On("MarkEventAttemptFailed", mock.Anything, id, "webhooks: "+tc.mockPublishErr[id].Error(), defaultMaxAttempts).Return(nil)
+			}
+
+			txRepo := &repository.MockRegistry{}
+			txRepo.On("Outbox").Return(outboxRepo)
+			// The sinks write within a savepoint per event, rolled back when one fails so the tx can record the attempt
+			var rollbacks int
+			txRepo.On("DoInSavepoint", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+				Return(func(ctx context.Context, spFunc func(context.Context) error) error {
+					err := spFunc(ctx)
+					if err != nil {
+						rollbacks++
+					}
+					return err
+				})
+
+			// The sinks write within the tx of the dispatcher, the hub one first
+			hubSink := NewMockSink(t)
+			webhookSink := NewMockSink(t)
+			webhookSink.On("Name").Return("webhooks").Maybe()
+			for _, e := range published {
+				hubSink.On("Publish", mock.Anything, txRepo, e).Return(nil)
+				webhookSink.On("Publish", mock.Anything, txRepo, e).Return(tc.mockPublishErr[e.ID])
+			}
+
+			mockRepo := &repository.MockRegistry{}
+			mockRepo.On("DoInTx", mock.Anything, mock.AnythingOfType("func(context.Context, repository.Registry) error"), mock.Anything).
+				Return(func(ctx context.Context, txFunc func(context.Context, repository.Registry) error, _ backoff.BackOff) error {
+					return txFunc(ctx, txRepo)
+				})
+
+			d := New(mockRepo, hubSink, webhookSink)
+
+			// When:
+			rs, err := d.DispatchPending(context.Background())
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, pkgerrors.Cause(err), tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResult, rs)
+				require.Equal(t, len(tc.expFailed), rollbacks)
+			}
+		})
+	}
+}
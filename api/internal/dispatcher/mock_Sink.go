@@ -0,0 +1,67 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package dispatcher
+
+import (
+	context "context"
+	model "omg/api/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "omg/api/internal/repository"
+)
+
+// MockSink is an autogenerated mock type for the Sink type
+type MockSink struct {
+	mock.Mock
+}
+
+// Name provides a mock function with given fields:
+func (_m *MockSink) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Publish provides a mock function with given fields: ctx, txRepo, event
+func (_m *MockSink) Publish(ctx context.Context, txRepo repository.Registry, event model.Event) error {
+	ret := _m.Called(ctx, txRepo, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Registry, model.Event) error); ok {
+		r0 = rf(ctx, txRepo, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockSink creates a new instance of MockSink. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSink(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSink {
+	mock := &MockSink{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
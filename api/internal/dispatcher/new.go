@@ -0,0 +1,60 @@
+package dispatcher
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 100
+	defaultMaxAttempts  = 10
+	// The published events are kept for a while so that the clients can catch up on the ones they missed
+	defaultRetention     = 24 * time.Hour
+	defaultPurgeInterval = time.Hour
+)
+
+// Sink receives the events published from the outbox
+type Sink interface {
+	// Name identifies the sink in logs & errors
+	Name() string
+	// Publish delivers the event, it may be called more than once for the same event.
+	// The writes to the DB go through txRepo, the tx marking the event published.
+	Publish(ctx context.Context, txRepo repository.Registry, event model.Event) error
+}
+
+// Dispatcher publishes the pending outbox events to the sinks
+type Dispatcher interface {
+	// Run polls & publishes the pending events until the ctx is done
+	Run(ctx context.Context)
+	// DispatchPending publishes one batch of pending events & returns how many were published
+	DispatchPending(ctx context.Context) (int, error)
+	// PurgeExpired deletes the events published longer ago than the retention & returns how many were deleted
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+// New returns an implementation instance satisfying Dispatcher
+func New(repo repository.Registry, sinks ...Sink) Dispatcher {
+	return impl{
+		repo:          repo,
+		sinks:         sinks,
+		pollInterval:  defaultPollInterval,
+		batchSize:     defaultBatchSize,
+		maxAttempts:   defaultMaxAttempts,
+		retention:     defaultRetention,
+		purgeInterval: defaultPurgeInterval,
+	}
+}
+
+type impl struct {
+	repo          repository.Registry
+	sinks         []Sink
+	pollInterval  time.Duration
+	batchSize     int
+	maxAttempts   int
+	retention     time.Duration
+	purgeInterval time.Duration
+}
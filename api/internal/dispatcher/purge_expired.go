@@ -0,0 +1,11 @@
+package dispatcher
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeExpired deletes the events published longer ago than the retention & returns how many were deleted
+func (i impl) PurgeExpired(ctx context.Context) (int64, error) {
+	return i.repo.Outbox().PurgePublishedEvents(ctx, time.Now().Add(-i.retention))
+}
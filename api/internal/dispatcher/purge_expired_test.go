@@ -0,0 +1,58 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/outbox"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_PurgeExpired(t *testing.T) {
+	type arg struct {
+		mockDeleted int64
+		mockErr     error
+		expErr      error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			mockDeleted: 3,
+		},
+		"database_error": {
+			mockErr: errors.New("database error"),
+			expErr:  errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given:
+			now := time.Now()
+			outboxRepo := outbox.NewMockRepository(t)
+			outboxRepo.On("PurgePublishedEvents", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+				// Everything published before the retention window goes
+				return !before.After(now.Add(-defaultRetention).Add(time.Minute)) && before.After(now.Add(-defaultRetention).Add(-time.Minute))
+			})).Return(tc.mockDeleted, tc.mockErr)
+
+			mockRepo := repository.NewMockRegistry(t)
+			mockRepo.On("Outbox").Return(outboxRepo)
+
+			// When:
+			deleted, err := New(mockRepo).PurgeExpired(context.Background())
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.mockDeleted, deleted)
+			}
+		})
+	}
+}
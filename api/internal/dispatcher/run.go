@@ -0,0 +1,42 @@
+package dispatcher
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Run polls & publishes the pending events until the ctx is done
+func (i impl) Run(ctx context.Context) {
+	log.Printf("Starting outbox dispatcher")
+
+	ticker := time.NewTicker(i.pollInterval)
+	defer ticker.Stop()
+
+	purgeTicker := time.NewTicker(i.purgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopping outbox dispatcher")
+			return
+		case <-ticker.C:
+			// Keep draining while full batches come back so a backlog is not paced by the ticker
+			for {
+				published, err := i.DispatchPending(ctx)
+				if err != nil {
+					log.Printf("Failed to dispatch outbox events: %v", err)
+					break
+				}
+				if published < i.batchSize {
+					break
+				}
+			}
+		case <-purgeTicker.C:
+			if _, err := i.PurgeExpired(ctx); err != nil {
+				log.Printf("Failed to purge outbox events: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package gql
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+)
+
+type callerCtxKey struct{}
+
+// caller is the authenticated user sending the GraphQL request
+type caller struct {
+	userID int64
+	role   model.UserRole
+}
+
+func withCaller(ctx context.Context, c caller) context.Context {
+	return context.WithValue(ctx, callerCtxKey{}, c)
+}
+
+// callerFrom gets the caller of the request, failing the unauthenticated ones
+func callerFrom(ctx context.Context) (caller, error) {
+	c, ok := ctx.Value(callerCtxKey{}).(caller)
+	if !ok || c.userID == 0 {
+		return caller{}, errUnauthorized()
+	}
+	return c, nil
+}
@@ -0,0 +1,34 @@
+package gql
+
+import (
+	"net/http"
+
+	"omg/api/pkg/httpserv"
+)
+
+// The resolvers fail with *httpserv.Error so errorPresenter exposes their status & description, any other error is
+// presented as an internal one
+
+func errUnauthorized() *httpserv.Error {
+	return &httpserv.Error{Status: http.StatusUnauthorized, Code: "unauthorized", Desc: "unauthorized"}
+}
+
+func errForbidden(desc string) *httpserv.Error {
+	return &httpserv.Error{Status: http.StatusForbidden, Code: "forbidden", Desc: desc}
+}
+
+func errBadRequest(err error) *httpserv.Error {
+	return &httpserv.Error{Status: http.StatusBadRequest, Code: "bad_request", Desc: err.Error()}
+}
+
+func errNotFound(err error) *httpserv.Error {
+	return &httpserv.Error{Status: http.StatusNotFound, Code: "not_found", Desc: err.Error()}
+}
+
+func errConflict(err error) *httpserv.Error {
+	return &httpserv.Error{Status: http.StatusConflict, Code: "conflict", Desc: err.Error()}
+}
+
+func errUnprocessable(err error) *httpserv.Error {
+	return &httpserv.Error{Status: http.StatusUnprocessableEntity, Code: "unprocessable_entity", Desc: err.Error()}
+}
@@ -0,0 +1,71 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package gqlmodel
+
+import (
+	"omg/api/internal/model"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreateOrderInput struct {
+	Items []OrderItemInput `json:"items"`
+	// Places the order on behalf of another user, admins only
+	UserID *int64 `json:"userId"`
+	// Retrying with the same key & input returns the original order instead of creating another one
+	IdempotencyKey *string `json:"idempotencyKey"`
+}
+
+type OrderFilter struct {
+	Status []model.OrderStatus `json:"status"`
+	From   *time.Time          `json:"from"`
+	To     *time.Time          `json:"to"`
+}
+
+type OrderItemInput struct {
+	ProductID int64 `json:"productId"`
+	Quantity  int64 `json:"quantity"`
+}
+
+// An order of the caller got created or changed status
+type OrderStatusEvent struct {
+	// Sequence of the event, increasing over time
+	Seq       int64             `json:"seq"`
+	OrderID   int64             `json:"orderId"`
+	UserID    int64             `json:"userId"`
+	Status    model.OrderStatus `json:"status"`
+	TotalCost decimal.Decimal   `json:"totalCost"`
+}
+
+// A page of products, newest first
+type ProductConnection struct {
+	Nodes []model.Product `json:"nodes"`
+	// Cursor to get the next page with, null on the last page
+	NextCursor *string `json:"nextCursor"`
+}
+
+type ProductFilter struct {
+	Status   []model.ProductStatus `json:"status"`
+	MinPrice *decimal.Decimal      `json:"minPrice"`
+	MaxPrice *decimal.Decimal      `json:"maxPrice"`
+	// Only the products with available stock
+	InStock    *bool   `json:"inStock"`
+	NamePrefix *string `json:"namePrefix"`
+}
+
+// A page of users, newest first
+type UserConnection struct {
+	Nodes []model.User `json:"nodes"`
+	// Cursor to get the next page with, null on the last page
+	NextCursor *string `json:"nextCursor"`
+	HasMore    bool    `json:"hasMore"`
+}
+
+type UserFilter struct {
+	// Substring of the email or the name
+	Search *string            `json:"search"`
+	Status []model.UserStatus `json:"status"`
+	From   *time.Time         `json:"from"`
+	To     *time.Time         `json:"to"`
+}
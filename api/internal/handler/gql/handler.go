@@ -0,0 +1,63 @@
+package gql
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"omg/api/internal/authenticate"
+	"omg/api/internal/handler/gql/generated"
+	"omg/api/internal/model"
+	httpgql "omg/api/pkg/httpserv/gql"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler serves the GraphQL schema
+type Handler struct {
+	srv http.Handler
+}
+
+// NewHandler initializes a new Handler instance and returns it
+func NewHandler(resolver *Resolver, authService authenticate.Auth, isIntrospectionEnabled bool) Handler {
+	return Handler{
+		srv: httpgql.Handler(
+			generated.NewExecutableSchema(generated.Config{Resolvers: resolver}),
+			isIntrospectionEnabled,
+			initWebSocket(authService),
+		),
+	}
+}
+
+// Serve serves the queries & mutations to the callers authenticated by the auth middleware of the gin route
+func (h Handler) Serve(c *gin.Context) {
+	ctx := withCaller(c.Request.Context(), caller{
+		userID: c.GetInt64("user_id"),
+		role:   model.UserRole(c.GetString("role")),
+	})
+	h.srv.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+}
+
+// ServeWebSocket serves the subscriptions over WebSocket. Browsers cannot send the Authorization header when upgrading,
+// so the connection is authenticated by the access token of its init payload instead.
+func (h Handler) ServeWebSocket(c *gin.Context) {
+	h.srv.ServeHTTP(c.Writer, c.Request)
+}
+
+// initWebSocket authenticates the WebSocket connection with the `Authorization: Bearer <token>` of its init payload
+func initWebSocket(authService authenticate.Auth) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+		token := strings.TrimPrefix(payload.Authorization(), "Bearer ")
+		if token == "" {
+			return nil, errUnauthorized()
+		}
+
+		claims, err := authService.ValidateToken(token)
+		if err != nil {
+			return nil, errUnauthorized()
+		}
+
+		return withCaller(ctx, caller{userID: claims.UserID, role: claims.Role}), nil
+	}
+}
@@ -0,0 +1,189 @@
+package gql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"omg/api/internal/authenticate"
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/controller/products"
+	"omg/api/internal/controller/users"
+	"omg/api/internal/model"
+	"omg/api/internal/ws"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// execGQL sends the GraphQL request as the given caller & returns the status & body of the answer
+func execGQL(t *testing.T, resolver *Resolver, userID int64, role model.UserRole, query string, variables map[string]interface{}) (int, string) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.POST("/authenticated/graphql", func(c *gin.Context) {
+		if userID != 0 {
+			c.Set("user_id", userID)
+			c.Set("role", role.String())
+		}
+	}, NewHandler(resolver, authenticate.NewMockAuth(t), false).Serve)
+
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/authenticated/graphql", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	return w.Code, w.Body.String()
+}
+
+func TestHandler(t *testing.T) {
+	tcs := map[string]struct {
+		givenUserID    int64
+		givenQuery     string
+		expectedStatus int
+		expectedBody   string
+	}{
+		"unauthenticated": {
+			givenQuery:     `{ me { id } }`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"errors":[{"message":"unauthorized","extensions":{"status":401,"error":"unauthorized","error_description":"unauthorized"}}],"data":null}`,
+		},
+		"invalid_query": {
+			givenUserID:    7,
+			givenQuery:     `{ me { password } }`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   `{"errors":[{"message":"Something went wrong","extensions":{"status":400,"error":"gql_validation_failed","error_description":"Something went wrong"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			resolver := NewResolver(products.NewMockController(t), orders.NewMockController(t), users.NewMockController(t), ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, tc.givenUserID, model.UserRoleCustomer, tc.givenQuery, nil)
+
+			require.Equal(t, tc.expectedStatus, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
+
+func TestHandler_ServeWebSocket(t *testing.T) {
+	tcs := map[string]struct {
+		givenToken       string
+		mockClaims       *authenticate.Claims
+		mockErr          error
+		expectedMessages []string
+		expectedClose    bool
+	}{
+		"success": {
+			givenToken: "valid",
+			mockClaims: &authenticate.Claims{UserID: 1, Role: model.UserRoleCustomer},
+			expectedMessages: []string{
+				`{"type":"connection_ack"}`,
+				`{"id":"1","type":"next","payload":{"data":{"orderStatusChanged":{"seq":"42","orderId":"123","userId":"1","status":"PAID","totalCost":"15.5"}}}}`,
+			},
+		},
+		"missing_token": {
+			expectedClose: true,
+		},
+		"invalid_token": {
+			givenToken:    "invalid",
+			mockErr:       errors.New("token is expired"),
+			expectedClose: true,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			// Given:
+			hub := ws.NewHub()
+			go hub.Run()
+
+			authService := authenticate.NewMockAuth(t)
+			if tc.givenToken != "" {
+				authService.On("ValidateToken", tc.givenToken).Return(tc.mockClaims, tc.mockErr)
+			}
+
+			resolver := NewResolver(products.NewMockController(t), orders.NewMockController(t), users.NewMockController(t), hub)
+			router := gin.New()
+			router.GET("/public/graphql", NewHandler(resolver, authService, false).ServeWebSocket)
+			srv := httptest.NewServer(router)
+			defer srv.Close()
+
+			dialer := websocket.Dialer{Subprotocols: []string{"graphql-transport-ws"}}
+			conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/public/graphql", nil)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			// When:
+			require.NoError(t, conn.WriteJSON(map[string]interface{}{
+				"type":    "connection_init",
+				"payload": map[string]interface{}{"Authorization": "Bearer " + tc.givenToken},
+			}))
+			if tc.mockClaims != nil {
+				require.NoError(t, conn.WriteJSON(map[string]interface{}{
+					"id":      "1",
+					"type":    "subscribe",
+					"payload": map[string]interface{}{"query": `subscription { orderStatusChanged(orderId: "123") { seq orderId userId status totalCost } }`},
+				}))
+			}
+
+			// Then:
+			msgs := make(chan string)
+			closed := make(chan error, 1)
+			go func() {
+				defer close(msgs)
+				for {
+					_, msg, err := conn.ReadMessage()
+					if err != nil {
+						closed <- err
+						return
+					}
+					msgs <- string(msg)
+				}
+			}()
+
+			// The events are published until delivered, as the subscription is registered asynchronously
+			ticker := time.NewTicker(20 * time.Millisecond)
+			defer ticker.Stop()
+			timeout := time.After(5 * time.Second)
+			for _, expected := range tc.expectedMessages {
+			read:
+				for {
+					select {
+					case msg, ok := <-msgs:
+						require.True(t, ok, "connection closed")
+						require.JSONEq(t, expected, msg)
+						break read
+					case <-ticker.C:
+						hub.Publish(ws.Publication{Seq: 41, OrderID: 122, UserID: 1, Data: []byte(`{"type":"order_status","order_id":"122","user_id":"1","status":"PAID","total_cost":"10"}`)})
+						hub.Publish(ws.Publication{Seq: 42, OrderID: 123, UserID: 1, Data: []byte(`{"type":"order_status","order_id":"123","user_id":"1","status":"PAID","total_cost":"15.5"}`)})
+					case <-timeout:
+						t.Fatal("message not received")
+					}
+				}
+			}
+
+			if tc.expectedClose {
+				select {
+				case err := <-closed:
+					require.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+				case <-timeout:
+					t.Fatal("connection not closed")
+				}
+			}
+		})
+	}
+}
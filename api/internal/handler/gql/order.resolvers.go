@@ -0,0 +1,186 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+	"log"
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/handler/gql/gqlmodel"
+	"omg/api/internal/model"
+	"omg/api/internal/ws"
+	"strings"
+)
+
+func (r *mutationResolver) CreateOrder(ctx context.Context, input gqlmodel.CreateOrderInput) (*model.Order, error) {
+	c, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The order owner comes from the token claims, only admins may place orders on behalf of another user
+	ownerID := c.userID
+	if input.UserID != nil && *input.UserID != c.userID {
+		if c.role != model.UserRoleAdmin {
+			return nil, errForbidden("cannot create order for another user")
+		}
+		ownerID = *input.UserID
+	}
+
+	inp := model.CreateOrderInput{
+		UserID:      ownerID,
+		RequestedBy: c.userID,
+	}
+	if input.IdempotencyKey != nil {
+		inp.IdempotencyKey = strings.TrimSpace(*input.IdempotencyKey)
+	}
+
+	for _, item := range input.Items {
+		if item.ProductID == 0 {
+			return nil, errBadRequest(errors.New("product_id required"))
+		}
+		if item.Quantity <= 0 {
+			return nil, errBadRequest(errors.New("quantity required"))
+		}
+		inp.Items = append(inp.Items, model.CreateOrderItemInput{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	order, err := r.orderCtrl.CreateOrder(ctx, inp)
+	if err != nil {
+		switch {
+		case errors.Is(err, orders.ErrInvalidIdempotencyKey),
+			errors.Is(err, orders.ErrProductNotFound),
+			errors.Is(err, orders.ErrProductOutOfStock):
+			return nil, errBadRequest(err)
+		case errors.Is(err, orders.ErrIdempotencyKeyMismatch):
+			return nil, errUnprocessable(err)
+		}
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (r *mutationResolver) UpdateOrderStatus(ctx context.Context, id int64, status model.OrderStatus) (*model.Order, error) {
+	c, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := r.orderCtrl.UpdateOrderStatus(ctx, c.userID, c.role, id, status)
+	if err != nil {
+		switch {
+		case errors.Is(err, orders.ErrOrderNotFound):
+			return nil, errNotFound(err)
+		case errors.Is(err, orders.ErrInvalidOrderStatus),
+			errors.Is(err, orders.ErrProductNotFound),
+			errors.Is(err, orders.ErrProductOutOfStock):
+			return nil, errBadRequest(err)
+		case errors.Is(err, orders.ErrStatusChangeForbidden):
+			return nil, errForbidden(err.Error())
+		case errors.Is(err, orders.ErrInvalidStatusTransition):
+			return nil, errConflict(err)
+		}
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (r *queryResolver) Order(ctx context.Context, id int64) (*model.Order, error) {
+	c, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Back-office users may act on any order
+	ownerID := c.userID
+	if c.role.IsBackOffice() {
+		ownerID = 0
+	}
+
+	order, err := r.orderCtrl.GetOrderByID(ctx, ownerID, id)
+	if err != nil {
+		if errors.Is(err, orders.ErrOrderNotFound) {
+			return nil, errNotFound(err)
+		}
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (r *queryResolver) Orders(ctx context.Context, filter *gqlmodel.OrderFilter) ([]model.Order, error) {
+	c, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := model.ListOrdersInput{UserID: c.userID}
+	if filter != nil {
+		input.Status = filter.Status
+		if filter.From != nil {
+			input.CreatedFrom = *filter.From
+		}
+		if filter.To != nil {
+			input.CreatedTo = *filter.To
+		}
+	}
+
+	list, err := r.orderCtrl.ListOrders(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, orders.ErrInvalidOrderStatus),
+			errors.Is(err, orders.ErrInvalidDateRange):
+			return nil, errBadRequest(err)
+		}
+		return nil, err
+	}
+
+	if list == nil {
+		list = []model.Order{}
+	}
+
+	return list, nil
+}
+
+func (r *subscriptionResolver) OrderStatusChanged(ctx context.Context, orderID *int64) (<-chan *gqlmodel.OrderStatusEvent, error) {
+	c, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := ws.Subscribe(ctx, r.hub, c.userID, c.role, ws.TopicMyOrders)
+	if err != nil {
+		return nil, errForbidden(err.Error())
+	}
+
+	events := make(chan *gqlmodel.OrderStatusEvent)
+	go func() {
+		defer close(events)
+
+		for msg := range msgs {
+			event, err := toOrderStatusEvent(msg)
+			if err != nil {
+				log.Printf("Failed to convert order status event %d: %v", msg.Seq, err)
+				continue
+			}
+			if orderID != nil && event.OrderID != *orderID {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
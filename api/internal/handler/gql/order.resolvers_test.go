@@ -0,0 +1,268 @@
+package gql
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/controller/products"
+	"omg/api/internal/controller/users"
+	"omg/api/internal/model"
+	"omg/api/internal/ws"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutationResolver_CreateOrder(t *testing.T) {
+	order := model.Order{
+		ID:        100,
+		UserID:    9,
+		Status:    model.OrderStatusPending,
+		TotalCost: decimal.RequireFromString("3"),
+		OrderItems: []model.OrderItem{
+			{ID: 1, OrderID: 100, ProductID: 5, Quantity: 2, Price: decimal.RequireFromString("1.5")},
+		},
+	}
+
+	type mockCreateCtrl struct {
+		wantCall bool
+		input    model.CreateOrderInput
+		out      model.Order
+		err      error
+	}
+
+	tcs := map[string]struct {
+		givenRole      model.UserRole
+		givenInput     map[string]interface{}
+		mockCreateCtrl mockCreateCtrl
+		expectedBody   string
+	}{
+		"success": {
+			givenRole: model.UserRoleCustomer,
+			givenInput: map[string]interface{}{
+				"items":          []map[string]interface{}{{"productId": "5", "quantity": "2"}},
+				"idempotencyKey": " key-1 ",
+			},
+			mockCreateCtrl: mockCreateCtrl{
+				wantCall: true,
+				input: model.CreateOrderInput{
+					UserID:         7,
+					Items:          []model.CreateOrderItemInput{{ProductID: 5, Quantity: 2}},
+					IdempotencyKey: "key-1",
+					RequestedBy:    7,
+				},
+				out: order,
+			},
+			expectedBody: `{"data":{"createOrder":{"id":"100","userId":"9","status":"PENDING","totalCost":"3","items":[{"productId":"5","quantity":"2","price":"1.5"}]}}}`,
+		},
+		"admin_on_behalf_of_user": {
+			givenRole: model.UserRoleAdmin,
+			givenInput: map[string]interface{}{
+				"items":  []map[string]interface{}{{"productId": "5", "quantity": "2"}},
+				"userId": "9",
+			},
+			mockCreateCtrl: mockCreateCtrl{
+				wantCall: true,
+				input: model.CreateOrderInput{
+					UserID:      9,
+					Items:       []model.CreateOrderItemInput{{ProductID: 5, Quantity: 2}},
+					RequestedBy: 7,
+				},
+				out: order,
+			},
+			expectedBody: `{"data":{"createOrder":{"id":"100","userId":"9","status":"PENDING","totalCost":"3","items":[{"productId":"5","quantity":"2","price":"1.5"}]}}}`,
+		},
+		"customer_on_behalf_of_user": {
+			givenRole: model.UserRoleCustomer,
+			givenInput: map[string]interface{}{
+				"items":  []map[string]interface{}{{"productId": "5", "quantity": "2"}},
+				"userId": "9",
+			},
+			expectedBody: `{"errors":[{"message":"cannot create order for another user","extensions":{"status":403,"error":"forbidden","error_description":"cannot create order for another user"}}],"data":null}`,
+		},
+		"missing_quantity": {
+			givenRole: model.UserRoleCustomer,
+			givenInput: map[string]interface{}{
+				"items": []map[string]interface{}{{"productId": "5", "quantity": "0"}},
+			},
+			expectedBody: `{"errors":[{"message":"quantity required","extensions":{"status":400,"error":"bad_request","error_description":"quantity required"}}],"data":null}`,
+		},
+		"idempotency_key_mismatch": {
+			givenRole: model.UserRoleCustomer,
+			givenInput: map[string]interface{}{
+				"items":          []map[string]interface{}{{"productId": "5", "quantity": "2"}},
+				"idempotencyKey": "key-1",
+			},
+			mockCreateCtrl: mockCreateCtrl{
+				wantCall: true,
+				input: model.CreateOrderInput{
+					UserID:         7,
+					Items:          []model.CreateOrderItemInput{{ProductID: 5, Quantity: 2}},
+					IdempotencyKey: "key-1",
+					RequestedBy:    7,
+				},
+				err: orders.ErrIdempotencyKeyMismatch,
+			},
+			expectedBody: `{"errors":[{"message":"idempotency key already used for a different request","extensions":{"status":422,"error":"unprocessable_entity","error_description":"idempotency key already used for a different request"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			orderCtrl := orders.NewMockController(t)
+			if tc.mockCreateCtrl.wantCall {
+				orderCtrl.On("CreateOrder", mock.Anything, tc.mockCreateCtrl.input).Return(tc.mockCreateCtrl.out, tc.mockCreateCtrl.err)
+			}
+			resolver := NewResolver(products.NewMockController(t), orderCtrl, users.NewMockController(t), ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, 7, tc.givenRole,
+				`mutation($input: CreateOrderInput!) { createOrder(input: $input) { id userId status totalCost items { productId quantity price } } }`,
+				map[string]interface{}{"input": tc.givenInput})
+
+			require.Equal(t, http.StatusOK, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
+
+func TestMutationResolver_UpdateOrderStatus(t *testing.T) {
+	tcs := map[string]struct {
+		givenRole    model.UserRole
+		givenStatus  model.OrderStatus
+		mockOut      model.Order
+		mockErr      error
+		expectedBody string
+	}{
+		"owner": {
+			givenRole:    model.UserRoleCustomer,
+			mockOut:      model.Order{ID: 100, Status: model.OrderStatusCancelled},
+			expectedBody: `{"data":{"updateOrderStatus":{"id":"100","status":"CANCELLED"}}}`,
+		},
+		"back_office_any_order": {
+			givenRole:    model.UserRoleStaff,
+			mockOut:      model.Order{ID: 100, Status: model.OrderStatusCancelled},
+			expectedBody: `{"data":{"updateOrderStatus":{"id":"100","status":"CANCELLED"}}}`,
+		},
+		"invalid_transition": {
+			givenRole:    model.UserRoleCustomer,
+			mockErr:      orders.ErrInvalidStatusTransition,
+			expectedBody: `{"errors":[{"message":"invalid order status transition","extensions":{"status":409,"error":"conflict","error_description":"invalid order status transition"}}],"data":null}`,
+		},
+		"customer_cannot_set_status": {
+			givenRole:    model.UserRoleCustomer,
+			givenStatus:  model.OrderStatusPaid,
+			mockErr:      orders.ErrStatusChangeForbidden,
+			expectedBody: `{"errors":[{"message":"order status change not allowed for the user role","extensions":{"status":403,"error":"forbidden","error_description":"order status change not allowed for the user role"}}],"data":null}`,
+		},
+		"not_found": {
+			givenRole:    model.UserRoleCustomer,
+			mockErr:      orders.ErrOrderNotFound,
+			expectedBody: `{"errors":[{"message":"order not found","extensions":{"status":404,"error":"not_found","error_description":"order not found"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			if tc.givenStatus == "" {
+				tc.givenStatus = model.OrderStatusCancelled
+			}
+
+			orderCtrl := orders.NewMockController(t)
+			orderCtrl.On("UpdateOrderStatus", mock.Anything, int64(7), tc.givenRole, int64(100), tc.givenStatus).
+				Return(tc.mockOut, tc.mockErr)
+			resolver := NewResolver(products.NewMockController(t), orderCtrl, users.NewMockController(t), ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, 7, tc.givenRole,
+				`mutation($status: OrderStatus!) { updateOrderStatus(id: "100", status: $status) { id status } }`,
+				map[string]interface{}{"status": tc.givenStatus})
+
+			require.Equal(t, http.StatusOK, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
+
+func TestQueryResolver_Order(t *testing.T) {
+	tcs := map[string]struct {
+		givenRole     model.UserRole
+		expectedOwner int64
+		mockErr       error
+		expectedBody  string
+	}{
+		"owner": {
+			givenRole:     model.UserRoleCustomer,
+			expectedOwner: 7,
+			expectedBody:  `{"data":{"order":{"id":"100"}}}`,
+		},
+		"back_office_any_order": {
+			givenRole:    model.UserRoleAdmin,
+			expectedBody: `{"data":{"order":{"id":"100"}}}`,
+		},
+		"not_found": {
+			givenRole:     model.UserRoleCustomer,
+			expectedOwner: 7,
+			mockErr:       orders.ErrOrderNotFound,
+			expectedBody:  `{"errors":[{"message":"order not found","extensions":{"status":404,"error":"not_found","error_description":"order not found"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			orderCtrl := orders.NewMockController(t)
+			orderCtrl.On("GetOrderByID", mock.Anything, tc.expectedOwner, int64(100)).Return(model.Order{ID: 100}, tc.mockErr)
+			resolver := NewResolver(products.NewMockController(t), orderCtrl, users.NewMockController(t), ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, 7, tc.givenRole, `{ order(id: "100") { id } }`, nil)
+
+			require.Equal(t, http.StatusOK, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
+
+func TestQueryResolver_Orders(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tcs := map[string]struct {
+		givenVariables map[string]interface{}
+		mockInput      model.ListOrdersInput
+		mockOut        []model.Order
+		mockErr        error
+		expectedBody   string
+	}{
+		"success": {
+			givenVariables: map[string]interface{}{
+				"filter": map[string]interface{}{"status": []string{"PAID"}, "from": "2024-05-01T00:00:00Z"},
+			},
+			mockInput:    model.ListOrdersInput{UserID: 7, Status: []model.OrderStatus{model.OrderStatusPaid}, CreatedFrom: from},
+			mockOut:      []model.Order{{ID: 100, UserID: 7, Status: model.OrderStatusPaid}},
+			expectedBody: `{"data":{"orders":[{"id":"100","status":"PAID"}]}}`,
+		},
+		"empty": {
+			mockInput:    model.ListOrdersInput{UserID: 7},
+			expectedBody: `{"data":{"orders":[]}}`,
+		},
+		"invalid_date_range": {
+			mockInput:    model.ListOrdersInput{UserID: 7},
+			mockErr:      orders.ErrInvalidDateRange,
+			expectedBody: `{"errors":[{"message":"invalid date range","extensions":{"status":400,"error":"bad_request","error_description":"invalid date range"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			orderCtrl := orders.NewMockController(t)
+			orderCtrl.On("ListOrders", mock.Anything, tc.mockInput).Return(tc.mockOut, tc.mockErr)
+			resolver := NewResolver(products.NewMockController(t), orderCtrl, users.NewMockController(t), ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, 7, model.UserRoleCustomer,
+				`query($filter: OrderFilter) { orders(filter: $filter) { id status } }`, tc.givenVariables)
+
+			require.Equal(t, http.StatusOK, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+	"omg/api/internal/controller/products"
+	"omg/api/internal/handler/gql/gqlmodel"
+	"omg/api/internal/model"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+func (r *queryResolver) Product(ctx context.Context, id int64) (*model.Product, error) {
+	if _, err := callerFrom(ctx); err != nil {
+		return nil, err
+	}
+
+	p, err := r.productCtrl.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, products.ErrNotFound) {
+			return nil, errNotFound(err)
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (r *queryResolver) Products(ctx context.Context, filter *gqlmodel.ProductFilter, first *int, after *string) (*gqlmodel.ProductConnection, error) {
+	if _, err := callerFrom(ctx); err != nil {
+		return nil, err
+	}
+
+	input := model.ListProductsInput{}
+	if first != nil {
+		input.Limit = *first
+	}
+	if after != nil {
+		input.Cursor = *after
+	}
+	if filter != nil {
+		input.Status = filter.Status
+		if filter.MinPrice != nil {
+			input.MinPrice = decimal.NewNullDecimal(*filter.MinPrice)
+		}
+		if filter.MaxPrice != nil {
+			input.MaxPrice = decimal.NewNullDecimal(*filter.MaxPrice)
+		}
+		if filter.InStock != nil {
+			input.InStock = *filter.InStock
+		}
+		if filter.NamePrefix != nil {
+			input.NamePrefix = strings.TrimSpace(*filter.NamePrefix)
+		}
+	}
+
+	list, err := r.productCtrl.List(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, products.ErrInvalidProductStatus),
+			errors.Is(err, products.ErrInvalidPriceRange),
+			errors.Is(err, products.ErrInvalidCursor),
+			errors.Is(err, products.ErrInvalidLimit):
+			return nil, errBadRequest(err)
+		}
+		return nil, err
+	}
+
+	conn := &gqlmodel.ProductConnection{Nodes: list.Products}
+	if conn.Nodes == nil {
+		conn.Nodes = []model.Product{}
+	}
+	if list.NextCursor != "" {
+		conn.NextCursor = &list.NextCursor
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,125 @@
+package gql
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/controller/products"
+	"omg/api/internal/controller/users"
+	"omg/api/internal/model"
+	"omg/api/internal/ws"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryResolver_Product(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tcs := map[string]struct {
+		givenID      string
+		mockOut      model.Product
+		mockErr      error
+		expectedBody string
+	}{
+		"success": {
+			givenID: "5",
+			mockOut: model.Product{
+				ID:          5,
+				Name:        "Apple",
+				Description: "Red",
+				Status:      model.ProductStatusActive,
+				Price:       decimal.RequireFromString("1.50"),
+				Stock:       10,
+				Reserved:    3,
+				CreatedAt:   createdAt,
+				UpdatedAt:   createdAt,
+			},
+			expectedBody: `{"data":{"product":{"id":"5","name":"Apple","status":"ACTIVE","price":"1.5","stock":"10","availableStock":"7","createdAt":"2024-05-01T10:00:00Z"}}}`,
+		},
+		"not_found": {
+			givenID:      "5",
+			mockErr:      products.ErrNotFound,
+			expectedBody: `{"errors":[{"message":"product not found","extensions":{"status":404,"error":"not_found","error_description":"product not found"}}],"data":null}`,
+		},
+		"internal_error": {
+			givenID:      "5",
+			mockErr:      errors.New("database error"),
+			expectedBody: `{"errors":[{"message":"Something went wrong","extensions":{"status":500,"error":"internal_error","error_description":"Something went wrong"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			productCtrl := products.NewMockController(t)
+			productCtrl.On("GetByID", mock.Anything, int64(5)).Return(tc.mockOut, tc.mockErr)
+			resolver := NewResolver(productCtrl, orders.NewMockController(t), users.NewMockController(t), ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, 7, model.UserRoleCustomer,
+				`query($id: Int64!) { product(id: $id) { id name status price stock availableStock createdAt } }`,
+				map[string]interface{}{"id": tc.givenID})
+
+			require.Equal(t, http.StatusOK, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
+
+func TestQueryResolver_Products(t *testing.T) {
+	tcs := map[string]struct {
+		givenVariables map[string]interface{}
+		mockInput      model.ListProductsInput
+		mockOut        model.ProductList
+		mockErr        error
+		expectedBody   string
+	}{
+		"success": {
+			givenVariables: map[string]interface{}{
+				"filter": map[string]interface{}{"status": []string{"ACTIVE"}, "minPrice": "1", "inStock": true, "namePrefix": " App "},
+				"first":  2,
+				"after":  "abc",
+			},
+			mockInput: model.ListProductsInput{
+				Status:     []model.ProductStatus{model.ProductStatusActive},
+				MinPrice:   decimal.NewNullDecimal(decimal.RequireFromString("1")),
+				InStock:    true,
+				NamePrefix: "App",
+				Cursor:     "abc",
+				Limit:      2,
+			},
+			mockOut: model.ProductList{
+				Products:   []model.Product{{ID: 5, Name: "Apple"}, {ID: 4, Name: "Apricot"}},
+				NextCursor: "next",
+			},
+			expectedBody: `{"data":{"products":{"nodes":[{"id":"5","name":"Apple"},{"id":"4","name":"Apricot"}],"nextCursor":"next"}}}`,
+		},
+		"last_page": {
+			expectedBody: `{"data":{"products":{"nodes":[],"nextCursor":null}}}`,
+		},
+		"invalid_cursor": {
+			givenVariables: map[string]interface{}{"after": "abc"},
+			mockInput:      model.ListProductsInput{Cursor: "abc"},
+			mockErr:        products.ErrInvalidCursor,
+			expectedBody:   `{"errors":[{"message":"invalid cursor","extensions":{"status":400,"error":"bad_request","error_description":"invalid cursor"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			productCtrl := products.NewMockController(t)
+			productCtrl.On("List", mock.Anything, tc.mockInput).Return(tc.mockOut, tc.mockErr)
+			resolver := NewResolver(productCtrl, orders.NewMockController(t), users.NewMockController(t), ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, 7, model.UserRoleCustomer,
+				`query($filter: ProductFilter, $first: Int, $after: String) { products(filter: $filter, first: $first, after: $after) { nodes { id name } nextCursor } }`,
+				tc.givenVariables)
+
+			require.Equal(t, http.StatusOK, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/controller/products"
+	"omg/api/internal/controller/users"
+	"omg/api/internal/handler/gql/gqlmodel"
+	"omg/api/internal/model"
+	"omg/api/internal/ws"
+
+	"github.com/shopspring/decimal"
+)
+
+// Resolver resolves the GraphQL schema with the controllers
+type Resolver struct {
+	productCtrl products.Controller
+	orderCtrl   orders.Controller
+	userCtrl    users.Controller
+	hub         ws.Hub
+}
+
+// NewResolver initializes a new Resolver instance and returns it
+func NewResolver(productCtrl products.Controller, orderCtrl orders.Controller, userCtrl users.Controller, hub ws.Hub) *Resolver {
+	return &Resolver{
+		productCtrl: productCtrl,
+		orderCtrl:   orderCtrl,
+		userCtrl:    userCtrl,
+		hub:         hub,
+	}
+}
+
+// getUser gets the user by its id, once the caller is allowed to read it
+func (r *Resolver) getUser(ctx context.Context, id int64) (*model.User, error) {
+	u, err := r.userCtrl.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, users.ErrUserNotFound) {
+			return nil, errNotFound(err)
+		}
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// toOrderStatusEvent converts the order status event delivered by the hub to its GraphQL type
+func toOrderStatusEvent(msg ws.ServerMessage) (*gqlmodel.OrderStatusEvent, error) {
+	var data ws.OrderStatusMessage
+	if err := json.Unmarshal(msg.Data, &data); err != nil {
+		return nil, err
+	}
+
+	orderID, err := strconv.ParseInt(data.OrderID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := strconv.ParseInt(data.UserID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	totalCost, err := decimal.NewFromString(data.TotalCost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.OrderStatusEvent{
+		Seq:       msg.Seq,
+		OrderID:   orderID,
+		UserID:    userID,
+		Status:    model.OrderStatus(data.Status),
+		TotalCost: totalCost,
+	}, nil
+}
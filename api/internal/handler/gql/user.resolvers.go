@@ -0,0 +1,88 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"errors"
+	"omg/api/internal/controller/users"
+	"omg/api/internal/handler/gql/gqlmodel"
+	"omg/api/internal/model"
+	"strings"
+)
+
+func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
+	c, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.getUser(ctx, c.userID)
+}
+
+func (r *queryResolver) User(ctx context.Context, id int64) (*model.User, error) {
+	c, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if id != c.userID && !c.role.IsBackOffice() {
+		return nil, errForbidden("cannot read another user")
+	}
+
+	return r.getUser(ctx, id)
+}
+
+func (r *queryResolver) Users(ctx context.Context, filter *gqlmodel.UserFilter, first *int, after *string) (*gqlmodel.UserConnection, error) {
+	c, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.role.IsBackOffice() {
+		return nil, errForbidden("permission denied")
+	}
+
+	input := model.ListUsersInput{}
+	if first != nil {
+		input.Limit = *first
+	}
+	if after != nil {
+		input.Cursor = *after
+	}
+	if filter != nil {
+		input.Status = filter.Status
+		if filter.Search != nil {
+			input.Search = strings.TrimSpace(*filter.Search)
+		}
+		if filter.From != nil {
+			input.CreatedFrom = *filter.From
+		}
+		if filter.To != nil {
+			input.CreatedTo = *filter.To
+		}
+	}
+
+	list, err := r.userCtrl.GetUsers(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, users.ErrInvalidUserStatus),
+			errors.Is(err, users.ErrInvalidDateRange),
+			errors.Is(err, users.ErrInvalidCursor),
+			errors.Is(err, users.ErrInvalidLimit):
+			return nil, errBadRequest(err)
+		}
+		return nil, err
+	}
+
+	conn := &gqlmodel.UserConnection{Nodes: list.Users, HasMore: list.HasMore()}
+	if conn.Nodes == nil {
+		conn.Nodes = []model.User{}
+	}
+	if list.NextCursor != "" {
+		conn.NextCursor = &list.NextCursor
+	}
+
+	return conn, nil
+}
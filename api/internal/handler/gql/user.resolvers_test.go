@@ -0,0 +1,132 @@
+package gql
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/controller/products"
+	"omg/api/internal/controller/users"
+	"omg/api/internal/model"
+	"omg/api/internal/ws"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryResolver_Me(t *testing.T) {
+	userCtrl := users.NewMockController(t)
+	userCtrl.On("GetByID", mock.Anything, int64(7)).Return(model.User{
+		ID:       7,
+		Name:     "Jane",
+		Email:    "jane@example.com",
+		Password: "hashed",
+		Status:   model.UserStatusActive,
+		Role:     model.UserRoleCustomer,
+	}, nil)
+	resolver := NewResolver(products.NewMockController(t), orders.NewMockController(t), userCtrl, ws.NewMockHub(t))
+
+	status, body := execGQL(t, resolver, 7, model.UserRoleCustomer, `{ me { id name email status role } }`, nil)
+
+	require.Equal(t, http.StatusOK, status)
+	require.JSONEq(t, `{"data":{"me":{"id":"7","name":"Jane","email":"jane@example.com","status":"ACTIVE","role":"CUSTOMER"}}}`, body)
+}
+
+func TestQueryResolver_User(t *testing.T) {
+	tcs := map[string]struct {
+		givenRole    model.UserRole
+		givenID      string
+		wantCall     bool
+		mockErr      error
+		expectedBody string
+	}{
+		"self": {
+			givenRole:    model.UserRoleCustomer,
+			givenID:      "7",
+			wantCall:     true,
+			expectedBody: `{"data":{"user":{"id":"7"}}}`,
+		},
+		"back_office_any_user": {
+			givenRole:    model.UserRoleStaff,
+			givenID:      "9",
+			wantCall:     true,
+			expectedBody: `{"data":{"user":{"id":"9"}}}`,
+		},
+		"another_user": {
+			givenRole:    model.UserRoleCustomer,
+			givenID:      "9",
+			expectedBody: `{"errors":[{"message":"cannot read another user","extensions":{"status":403,"error":"forbidden","error_description":"cannot read another user"}}],"data":null}`,
+		},
+		"not_found": {
+			givenRole:    model.UserRoleAdmin,
+			givenID:      "9",
+			wantCall:     true,
+			mockErr:      users.ErrUserNotFound,
+			expectedBody: `{"errors":[{"message":"user not found","extensions":{"status":404,"error":"not_found","error_description":"user not found"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			userCtrl := users.NewMockController(t)
+			if tc.wantCall {
+				id, _ := strconv.ParseInt(tc.givenID, 10, 64)
+				userCtrl.On("GetByID", mock.Anything, id).Return(model.User{ID: id}, tc.mockErr)
+			}
+			resolver := NewResolver(products.NewMockController(t), orders.NewMockController(t), userCtrl, ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, 7, tc.givenRole,
+				`query($id: Int64!) { user(id: $id) { id } }`, map[string]interface{}{"id": tc.givenID})
+
+			require.Equal(t, http.StatusOK, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
+
+func TestQueryResolver_Users(t *testing.T) {
+	tcs := map[string]struct {
+		givenRole    model.UserRole
+		wantCall     bool
+		mockOut      model.UserList
+		mockErr      error
+		expectedBody string
+	}{
+		"back_office": {
+			givenRole: model.UserRoleStaff,
+			wantCall:  true,
+			mockOut: model.UserList{
+				Users:      []model.User{{ID: 9, Email: "john@example.com"}},
+				NextCursor: "next",
+			},
+			expectedBody: `{"data":{"users":{"nodes":[{"id":"9","email":"john@example.com"}],"nextCursor":"next","hasMore":true}}}`,
+		},
+		"invalid_limit": {
+			givenRole:    model.UserRoleAdmin,
+			wantCall:     true,
+			mockErr:      users.ErrInvalidLimit,
+			expectedBody: `{"errors":[{"message":"invalid limit","extensions":{"status":400,"error":"bad_request","error_description":"invalid limit"}}],"data":null}`,
+		},
+		"customer": {
+			givenRole:    model.UserRoleCustomer,
+			expectedBody: `{"errors":[{"message":"permission denied","extensions":{"status":403,"error":"forbidden","error_description":"permission denied"}}],"data":null}`,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			userCtrl := users.NewMockController(t)
+			if tc.wantCall {
+				userCtrl.On("GetUsers", mock.Anything, model.ListUsersInput{Search: "john", Limit: 1}).Return(tc.mockOut, tc.mockErr)
+			}
+			resolver := NewResolver(products.NewMockController(t), orders.NewMockController(t), userCtrl, ws.NewMockHub(t))
+
+			status, body := execGQL(t, resolver, 7, tc.givenRole,
+				`{ users(filter: {search: "john"}, first: 1) { nodes { id email } nextCursor hasMore } }`, nil)
+
+			require.Equal(t, http.StatusOK, status)
+			require.JSONEq(t, tc.expectedBody, body)
+		})
+	}
+}
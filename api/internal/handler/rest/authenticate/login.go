@@ -28,7 +28,7 @@ func (h *Handler) Login(c *gin.Context) {
 		return
 	}
 
-	accessToken, err := h.authService.Login(c, req.Email, req.Password)
+	pair, err := h.authService.Login(c, req.Email, req.Password)
 	if err != nil {
 		switch {
 		case errors.Is(err, authenticate.ErrInvalidCredentials):
@@ -39,21 +39,8 @@ func (h *Handler) Login(c *gin.Context) {
 		return
 	}
 
-	// Get user ID from the token claims
-	claims, err := h.authService.ValidateToken(accessToken)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate token"})
-		return
-	}
-
-	refreshToken, err := h.authService.GenerateRefreshToken(claims.UserID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate refresh token"})
-		return
-	}
-
 	c.JSON(http.StatusOK, loginResponse{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
 	})
 }
@@ -0,0 +1,37 @@
+package authenticate
+
+import (
+	"errors"
+	"net/http"
+
+	"omg/api/internal/authenticate"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Logout revokes the refresh token of the calling user
+func (h *Handler) Logout(c *gin.Context) {
+	var req refreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.RefreshToken == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "refresh_token is required"})
+		return
+	}
+
+	if err := h.authService.Logout(c.Request.Context(), c.GetInt64("user_id"), req.RefreshToken); err != nil {
+		switch {
+		case errors.Is(err, authenticate.ErrTokenExpired),
+			errors.Is(err, authenticate.ErrInvalidRefreshToken):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, "Logout successfully")
+}
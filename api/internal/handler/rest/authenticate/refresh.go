@@ -0,0 +1,48 @@
+package authenticate
+
+import (
+	"errors"
+	"net/http"
+
+	"omg/api/internal/authenticate"
+
+	"github.com/gin-gonic/gin"
+)
+
+// refreshRequest presents the refresh & logout request fields
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Refresh exchanges a refresh token for a new access & refresh token pair
+func (h *Handler) Refresh(c *gin.Context) {
+	var req refreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.RefreshToken == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "refresh_token is required"})
+		return
+	}
+
+	pair, err := h.authService.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, authenticate.ErrTokenExpired):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token expired"})
+		case errors.Is(err, authenticate.ErrInvalidRefreshToken),
+			errors.Is(err, authenticate.ErrRefreshTokenReused):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, loginResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	})
+}
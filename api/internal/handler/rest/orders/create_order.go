@@ -4,16 +4,19 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"omg/api/internal/controller/orders"
 	"omg/api/internal/model"
-	"omg/api/internal/ws"
 
 	"github.com/gin-gonic/gin"
 )
 
+// idempotencyKeyHeader lets the clients retry an order creation without creating the order twice
+const idempotencyKeyHeader = "Idempotency-Key"
+
 type createOrderRequest struct {
-	UserID string `json:"user_id"`
+	UserID string `json:"user_id,omitempty"`
 	Items  []struct {
 		ProductID string `json:"product_id"`
 		Quantity  string `json:"quantity"`
@@ -44,19 +47,34 @@ func (h *Handler) Create(c *gin.Context) {
 		return
 	}
 
-	userID, err := strconv.ParseInt(req.UserID, 10, 64)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	// The order owner always comes from the token claims
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
 		return
 	}
 
-	if userID == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "user_id required"})
-		return
+	if req.UserID != "" {
+		reqUserID, err := strconv.ParseInt(req.UserID, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Only admins may place orders on behalf of another user
+		if reqUserID != userID {
+			if model.UserRole(c.GetString("role")) != model.UserRoleAdmin {
+				c.JSON(http.StatusForbidden, gin.H{"error": "cannot create order for another user"})
+				return
+			}
+			userID = reqUserID
+		}
 	}
 
 	input := model.CreateOrderInput{
-		UserID: userID,
+		UserID:         userID,
+		IdempotencyKey: strings.TrimSpace(c.GetHeader(idempotencyKeyHeader)),
+		RequestedBy:    c.GetInt64("user_id"),
 	}
 
 	for _, item := range req.Items {
@@ -87,6 +105,10 @@ func (h *Handler) Create(c *gin.Context) {
 	order, err := h.controller.CreateOrder(c.Request.Context(), input)
 	if err != nil {
 		switch {
+		case errors.Is(err, orders.ErrInvalidIdempotencyKey):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, orders.ErrIdempotencyKeyMismatch):
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
 		case errors.Is(err, orders.ErrProductNotFound):
 			c.JSON(http.StatusBadRequest, gin.H{"error": "product not found"})
 		case errors.Is(err, orders.ErrGetProduct):
@@ -107,16 +129,10 @@ func (h *Handler) Create(c *gin.Context) {
 		return
 	}
 
-	// Broadcast order status update via WebSocket
-	msg := ws.NewOrderStatusMessage(order.ID, order.UserID, order.Status.String(), order.TotalCost)
-	if msgBytes, err := msg.ToJSON(); err == nil {
-		h.wsHub.BroadcastMessage(msgBytes)
-	}
-
 	resp := createOrderResponse{
 		ID:        strconv.FormatInt(order.ID, 10),
 		UserID:    strconv.FormatInt(order.UserID, 10),
-		TotalCost: strconv.FormatFloat(order.TotalCost, 'f', -1, 64),
+		TotalCost: order.TotalCost.String(),
 		Status:    order.Status.String(),
 	}
 
@@ -126,7 +142,7 @@ func (h *Handler) Create(c *gin.Context) {
 			OrderId:   strconv.FormatInt(item.OrderID, 10),
 			ProductID: strconv.FormatInt(item.ProductID, 10),
 			Quantity:  strconv.FormatInt(item.Quantity, 10),
-			Price:     strconv.FormatFloat(item.Price, 'f', -1, 64),
+			Price:     item.Price.String(),
 		})
 	}
 	c.JSON(http.StatusCreated, resp)
@@ -10,9 +10,9 @@ import (
 
 	"omg/api/internal/controller/orders"
 	"omg/api/internal/model"
-	"omg/api/internal/ws"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -27,13 +27,16 @@ func TestHandler_Create(t *testing.T) {
 		err      error
 	}
 	tests := map[string]struct {
-		requestBody     createOrderRequest
-		mockOrderCtrl   mockOrderCtrl
-		expStatus       int
-		expResponse     map[string]interface{}
-		shouldBroadcast bool
+		givenUserID   int64
+		givenRole     string
+		givenIdemKey  string
+		requestBody   createOrderRequest
+		mockOrderCtrl mockOrderCtrl
+		expStatus     int
+		expResponse   map[string]interface{}
 	}{
 		"successful order creation": {
+			givenUserID: 1,
 			requestBody: createOrderRequest{
 				UserID: "1",
 				Items: []struct {
@@ -49,7 +52,8 @@ func TestHandler_Create(t *testing.T) {
 			mockOrderCtrl: mockOrderCtrl{
 				wantCall: true,
 				input: model.CreateOrderInput{
-					UserID: 1,
+					UserID:      1,
+					RequestedBy: 1,
 					Items: []model.CreateOrderItemInput{
 						{
 							ProductID: 1,
@@ -61,14 +65,14 @@ func TestHandler_Create(t *testing.T) {
 					ID:        1,
 					UserID:    1,
 					Status:    model.OrderStatusPending,
-					TotalCost: 100.0,
+					TotalCost: decimal.RequireFromString("100.0"),
 					OrderItems: []model.OrderItem{
 						{
 							ID:        1,
 							OrderID:   1,
 							ProductID: 1,
 							Quantity:  2,
-							Price:     50.0,
+							Price:     decimal.RequireFromString("50.0"),
 						},
 					},
 				},
@@ -90,9 +94,9 @@ func TestHandler_Create(t *testing.T) {
 					},
 				},
 			},
-			shouldBroadcast: true,
 		},
 		"invalid user_id": {
+			givenUserID: 1,
 			requestBody: createOrderRequest{
 				UserID: "invalid",
 				Items: []struct {
@@ -110,9 +114,8 @@ func TestHandler_Create(t *testing.T) {
 				"error": "strconv.ParseInt: parsing \"invalid\": invalid syntax",
 			},
 		},
-		"missing user_id": {
+		"missing user in token": {
 			requestBody: createOrderRequest{
-				UserID: "0",
 				Items: []struct {
 					ProductID string `json:"product_id"`
 					Quantity  string `json:"quantity"`
@@ -123,12 +126,118 @@ func TestHandler_Create(t *testing.T) {
 					},
 				},
 			},
+			expStatus: http.StatusUnauthorized,
+			expResponse: map[string]interface{}{
+				"error": "unauthorized",
+			},
+		},
+		"order for another user": {
+			givenUserID: 1,
+			requestBody: createOrderRequest{
+				UserID: "2",
+				Items: []struct {
+					ProductID string `json:"product_id"`
+					Quantity  string `json:"quantity"`
+				}{
+					{
+						ProductID: "1",
+						Quantity:  "2",
+					},
+				},
+			},
+			expStatus: http.StatusForbidden,
+			expResponse: map[string]interface{}{
+				"error": "cannot create order for another user",
+			},
+		},
+		"admin creates order for another user": {
+			givenUserID: 1,
+			givenRole:   "ADMIN",
+			requestBody: createOrderRequest{
+				UserID: "2",
+				Items: []struct {
+					ProductID string `json:"product_id"`
+					Quantity  string `json:"quantity"`
+				}{
+					{
+						ProductID: "1",
+						Quantity:  "2",
+					},
+				},
+			},
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				input: model.CreateOrderInput{
+					UserID:      2,
+					RequestedBy: 1,
+					Items: []model.CreateOrderItemInput{
+						{
+							ProductID: 1,
+							Quantity:  2,
+						},
+					},
+				},
+				err: orders.ErrProductOutOfStock,
+			},
+			expStatus: http.StatusBadRequest,
+			expResponse: map[string]interface{}{
+				"error": "product out of stock",
+			},
+		},
+		"staff cannot create order for another user": {
+			givenUserID: 1,
+			givenRole:   "STAFF",
+			requestBody: createOrderRequest{
+				UserID: "2",
+				Items: []struct {
+					ProductID string `json:"product_id"`
+					Quantity  string `json:"quantity"`
+				}{
+					{
+						ProductID: "1",
+						Quantity:  "2",
+					},
+				},
+			},
+			expStatus: http.StatusForbidden,
+			expResponse: map[string]interface{}{
+				"error": "cannot create order for another user",
+			},
+		},
+		"owner from token without user_id in body": {
+			givenUserID: 3,
+			requestBody: createOrderRequest{
+				Items: []struct {
+					ProductID string `json:"product_id"`
+					Quantity  string `json:"quantity"`
+				}{
+					{
+						ProductID: "1",
+						Quantity:  "2",
+					},
+				},
+			},
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				input: model.CreateOrderInput{
+					UserID:      3,
+					RequestedBy: 3,
+					Items: []model.CreateOrderItemInput{
+						{
+							ProductID: 1,
+							Quantity:  2,
+						},
+					},
+				},
+				err: orders.ErrProductOutOfStock,
+			},
 			expStatus: http.StatusBadRequest,
 			expResponse: map[string]interface{}{
-				"error": "user_id required",
+				"error": "product out of stock",
 			},
 		},
 		"product not found": {
+			givenUserID: 1,
 			requestBody: createOrderRequest{
 				UserID: "1",
 				Items: []struct {
@@ -144,7 +253,8 @@ func TestHandler_Create(t *testing.T) {
 			mockOrderCtrl: mockOrderCtrl{
 				wantCall: true,
 				input: model.CreateOrderInput{
-					UserID: 1,
+					UserID:      1,
+					RequestedBy: 1,
 					Items: []model.CreateOrderItemInput{
 						{
 							ProductID: 1,
@@ -160,6 +270,7 @@ func TestHandler_Create(t *testing.T) {
 			},
 		},
 		"product out of stock": {
+			givenUserID: 1,
 			requestBody: createOrderRequest{
 				UserID: "1",
 				Items: []struct {
@@ -175,7 +286,8 @@ func TestHandler_Create(t *testing.T) {
 			mockOrderCtrl: mockOrderCtrl{
 				wantCall: true,
 				input: model.CreateOrderInput{
-					UserID: 1,
+					UserID:      1,
+					RequestedBy: 1,
 					Items: []model.CreateOrderItemInput{
 						{
 							ProductID: 1,
@@ -191,6 +303,7 @@ func TestHandler_Create(t *testing.T) {
 			},
 		},
 		"internal server error": {
+			givenUserID: 1,
 			requestBody: createOrderRequest{
 				UserID: "1",
 				Items: []struct {
@@ -206,7 +319,8 @@ func TestHandler_Create(t *testing.T) {
 			mockOrderCtrl: mockOrderCtrl{
 				wantCall: true,
 				input: model.CreateOrderInput{
-					UserID: 1,
+					UserID:      1,
+					RequestedBy: 1,
 					Items: []model.CreateOrderItemInput{
 						{
 							ProductID: 1,
@@ -221,6 +335,73 @@ func TestHandler_Create(t *testing.T) {
 				"error": "internal server error",
 			},
 		},
+		"idempotency key forwarded": {
+			givenUserID:  1,
+			givenIdemKey: " retry-me ",
+			requestBody: createOrderRequest{
+				Items: []struct {
+					ProductID string `json:"product_id"`
+					Quantity  string `json:"quantity"`
+				}{
+					{
+						ProductID: "1",
+						Quantity:  "2",
+					},
+				},
+			},
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				input: model.CreateOrderInput{
+					UserID:         1,
+					Items:          []model.CreateOrderItemInput{{ProductID: 1, Quantity: 2}},
+					IdempotencyKey: "retry-me",
+					RequestedBy:    1,
+				},
+				output: model.Order{
+					ID:        1,
+					UserID:    1,
+					Status:    model.OrderStatusPending,
+					TotalCost: decimal.RequireFromString("100.0"),
+				},
+			},
+			expStatus: http.StatusCreated,
+			expResponse: map[string]interface{}{
+				"id":         "1",
+				"user_id":    "1",
+				"total_cost": "100",
+				"status":     "PENDING",
+				"items":      nil,
+			},
+		},
+		"idempotency key reused for another request": {
+			givenUserID:  1,
+			givenIdemKey: "retry-me",
+			requestBody: createOrderRequest{
+				Items: []struct {
+					ProductID string `json:"product_id"`
+					Quantity  string `json:"quantity"`
+				}{
+					{
+						ProductID: "1",
+						Quantity:  "3",
+					},
+				},
+			},
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				input: model.CreateOrderInput{
+					UserID:         1,
+					Items:          []model.CreateOrderItemInput{{ProductID: 1, Quantity: 3}},
+					IdempotencyKey: "retry-me",
+					RequestedBy:    1,
+				},
+				err: orders.ErrIdempotencyKeyMismatch,
+			},
+			expStatus: http.StatusUnprocessableEntity,
+			expResponse: map[string]interface{}{
+				"error": orders.ErrIdempotencyKeyMismatch.Error(),
+			},
+		},
 	}
 
 	for desc, tc := range tests {
@@ -235,12 +416,6 @@ func TestHandler_Create(t *testing.T) {
 			h := Handler{
 				controller: mockCtrl,
 			}
-			if tc.shouldBroadcast {
-				// Create a mock hub without starting its goroutine
-				mockHub := ws.NewMockHub(t)
-				mockHub.On("BroadcastMessage", mock.Anything).Return()
-				h.wsHub = mockHub
-			}
 
 			// Create request body
 			body, err := json.Marshal(tc.requestBody)
@@ -251,6 +426,15 @@ func TestHandler_Create(t *testing.T) {
 			c, _ := gin.CreateTestContext(w)
 			c.Request = httptest.NewRequest(http.MethodPost, "/orders", bytes.NewBuffer(body))
 			c.Request.Header.Set("Content-Type", "application/json")
+			if tc.givenIdemKey != "" {
+				c.Request.Header.Set(idempotencyKeyHeader, tc.givenIdemKey)
+			}
+			if tc.givenUserID != 0 {
+				c.Set("user_id", tc.givenUserID)
+			}
+			if tc.givenRole != "" {
+				c.Set("role", tc.givenRole)
+			}
 
 			// Execute request
 			h.Create(c)
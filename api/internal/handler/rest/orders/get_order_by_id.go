@@ -0,0 +1,90 @@
+package orders
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+type getOrderResponse struct {
+	ID        string                 `json:"id"`
+	UserID    string                 `json:"user_id"`
+	TotalCost string                 `json:"total_cost"`
+	Status    string                 `json:"status"`
+	CreatedAt string                 `json:"created_at"`
+	Items     []getOrderItemResponse `json:"items"`
+}
+
+type getOrderItemResponse struct {
+	ID        string `json:"id"`
+	OrderId   string `json:"order_id"`
+	ProductID string `json:"product_id"`
+	Quantity  string `json:"quantity"`
+	Price     string `json:"price"`
+}
+
+// GetOrderByID handles getting an order of the calling user
+func (h *Handler) GetOrderByID(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	orderID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order ID format"})
+		return
+	}
+	if orderID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order ID"})
+		return
+	}
+
+	// Back-office users may act on any order
+	ownerID := userID
+	if model.UserRole(c.GetString("role")).IsBackOffice() {
+		ownerID = 0
+	}
+
+	order, err := h.controller.GetOrderByID(c.Request.Context(), ownerID, orderID)
+	if err != nil {
+		switch {
+		case errors.Is(err, orders.ErrOrderNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "order not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toGetOrderResponse(order))
+}
+
+func toGetOrderResponse(order model.Order) getOrderResponse {
+	resp := getOrderResponse{
+		ID:        strconv.FormatInt(order.ID, 10),
+		UserID:    strconv.FormatInt(order.UserID, 10),
+		TotalCost: order.TotalCost.String(),
+		Status:    order.Status.String(),
+		CreatedAt: order.CreatedAt.Format(time.RFC3339),
+	}
+
+	for _, item := range order.OrderItems {
+		resp.Items = append(resp.Items, getOrderItemResponse{
+			ID:        strconv.FormatInt(item.ID, 10),
+			OrderId:   strconv.FormatInt(item.OrderID, 10),
+			ProductID: strconv.FormatInt(item.ProductID, 10),
+			Quantity:  strconv.FormatInt(item.Quantity, 10),
+			Price:     item.Price.String(),
+		})
+	}
+
+	return resp
+}
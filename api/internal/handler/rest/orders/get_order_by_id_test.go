@@ -0,0 +1,178 @@
+package orders
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetOrderByID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type mockOrderCtrl struct {
+		wantCall bool
+		userID   int64
+		orderID  int64
+		output   model.Order
+		err      error
+	}
+	tests := map[string]struct {
+		givenUserID   int64
+		givenRole     string
+		givenID       string
+		mockOrderCtrl mockOrderCtrl
+		expStatus     int
+		expResponse   map[string]interface{}
+	}{
+		"success": {
+			givenUserID: 1,
+			givenID:     "11",
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				userID:   1,
+				orderID:  11,
+				output: model.Order{
+					ID:        11,
+					UserID:    1,
+					Status:    model.OrderStatusPaid,
+					TotalCost: decimal.RequireFromString("100.0"),
+					CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+					OrderItems: []model.OrderItem{
+						{ID: 1, OrderID: 11, ProductID: 1, Quantity: 2, Price: decimal.RequireFromString("50.0")},
+					},
+				},
+			},
+			expStatus: http.StatusOK,
+			expResponse: map[string]interface{}{
+				"id":         "11",
+				"user_id":    "1",
+				"total_cost": "100",
+				"status":     "PAID",
+				"created_at": "2024-01-02T03:04:05Z",
+				"items": []interface{}{
+					map[string]interface{}{
+						"id":         "1",
+						"order_id":   "11",
+						"product_id": "1",
+						"quantity":   "2",
+						"price":      "50",
+					},
+				},
+			},
+		},
+		"staff_reads_any_order": {
+			givenUserID: 5,
+			givenRole:   "STAFF",
+			givenID:     "11",
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				userID:   0,
+				orderID:  11,
+				err:      orders.ErrOrderNotFound,
+			},
+			expStatus: http.StatusNotFound,
+			expResponse: map[string]interface{}{
+				"error": "order not found",
+			},
+		},
+		"unauthorized": {
+			givenID:   "11",
+			expStatus: http.StatusUnauthorized,
+			expResponse: map[string]interface{}{
+				"error": "unauthorized",
+			},
+		},
+		"invalid_id": {
+			givenUserID: 1,
+			givenID:     "abc",
+			expStatus:   http.StatusBadRequest,
+			expResponse: map[string]interface{}{
+				"error": "invalid order ID format",
+			},
+		},
+		"zero_id": {
+			givenUserID: 1,
+			givenID:     "0",
+			expStatus:   http.StatusBadRequest,
+			expResponse: map[string]interface{}{
+				"error": "invalid order ID",
+			},
+		},
+		"not_found": {
+			givenUserID: 2,
+			givenID:     "11",
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				userID:   2,
+				orderID:  11,
+				err:      orders.ErrOrderNotFound,
+			},
+			expStatus: http.StatusNotFound,
+			expResponse: map[string]interface{}{
+				"error": "order not found",
+			},
+		},
+		"internal_server_error": {
+			givenUserID: 1,
+			givenID:     "11",
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				userID:   1,
+				orderID:  11,
+				err:      errors.New("unexpected error"),
+			},
+			expStatus: http.StatusInternalServerError,
+			expResponse: map[string]interface{}{
+				"error": "internal server error",
+			},
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// Create mocks
+			mockCtrl := orders.NewMockController(t)
+			handler := NewHandler(mockCtrl)
+
+			// Create a test router
+			router := gin.New()
+			router.GET("/authenticated/order/:id", func(c *gin.Context) {
+				if tc.givenUserID != 0 {
+					c.Set("user_id", tc.givenUserID)
+				}
+				if tc.givenRole != "" {
+					c.Set("role", tc.givenRole)
+				}
+			}, handler.GetOrderByID)
+
+			if tc.mockOrderCtrl.wantCall {
+				mockCtrl.On("GetOrderByID", mock.Anything, tc.mockOrderCtrl.userID, tc.mockOrderCtrl.orderID).Return(tc.mockOrderCtrl.output, tc.mockOrderCtrl.err)
+			}
+
+			// Create test request
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/authenticated/order/"+tc.givenID, nil)
+			router.ServeHTTP(w, req)
+
+			// Verify response
+			require.Equal(t, tc.expStatus, w.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expResponse, response)
+		})
+	}
+}
@@ -2,17 +2,14 @@ package orders
 
 import (
 	"omg/api/internal/controller/orders"
-	"omg/api/internal/ws"
 )
 
 type Handler struct {
 	controller orders.Controller
-	wsHub      ws.Hub
 }
 
-func NewHandler(controller orders.Controller, wsHub ws.Hub) Handler {
+func NewHandler(controller orders.Controller) Handler {
 	return Handler{
 		controller: controller,
-		wsHub:      wsHub,
 	}
 }
@@ -0,0 +1,76 @@
+package orders
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListOrders handles listing the orders of the calling user.
+// Supported query params: status (comma separated), from & to (RFC3339).
+func (h *Handler) ListOrders(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+
+	input := model.ListOrdersInput{
+		UserID: userID,
+	}
+
+	if v := c.Query("status"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			status := model.OrderStatus(strings.ToUpper(strings.TrimSpace(s)))
+			if !status.IsValid() {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order status"})
+				return
+			}
+			input.Status = append(input.Status, status)
+		}
+	}
+
+	if v := c.Query("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid from date format"})
+			return
+		}
+		input.CreatedFrom = from
+	}
+
+	if v := c.Query("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid to date format"})
+			return
+		}
+		input.CreatedTo = to
+	}
+
+	list, err := h.controller.ListOrders(c.Request.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, orders.ErrInvalidOrderStatus):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order status"})
+		case errors.Is(err, orders.ErrInvalidDateRange):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date range"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		}
+		return
+	}
+
+	response := []getOrderResponse{}
+	for _, o := range list {
+		response = append(response, toGetOrderResponse(o))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
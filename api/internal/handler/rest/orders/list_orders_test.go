@@ -0,0 +1,148 @@
+package orders
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ListOrders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type mockOrderCtrl struct {
+		wantCall bool
+		input    model.ListOrdersInput
+		output   []model.Order
+		err      error
+	}
+	tests := map[string]struct {
+		givenUserID   int64
+		givenQuery    string
+		mockOrderCtrl mockOrderCtrl
+		expStatus     int
+		expResponse   string
+	}{
+		"success": {
+			givenUserID: 1,
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				input:    model.ListOrdersInput{UserID: 1},
+				output: []model.Order{
+					{
+						ID:        11,
+						UserID:    1,
+						Status:    model.OrderStatusPaid,
+						TotalCost: decimal.RequireFromString("100.0"),
+						CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+						OrderItems: []model.OrderItem{
+							{ID: 1, OrderID: 11, ProductID: 1, Quantity: 2, Price: decimal.RequireFromString("50.0")},
+						},
+					},
+				},
+			},
+			expStatus:   http.StatusOK,
+			expResponse: `[{"id":"11","user_id":"1","total_cost":"100","status":"PAID","created_at":"2024-01-02T03:04:05Z","items":[{"id":"1","order_id":"11","product_id":"1","quantity":"2","price":"50"}]}]`,
+		},
+		"success_with_filters": {
+			givenUserID: 1,
+			givenQuery:  "?status=paid,PENDING&from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z",
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				input: model.ListOrdersInput{
+					UserID:      1,
+					Status:      []model.OrderStatus{model.OrderStatusPaid, model.OrderStatusPending},
+					CreatedFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					CreatedTo:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			expStatus:   http.StatusOK,
+			expResponse: `[]`,
+		},
+		"unauthorized": {
+			expStatus:   http.StatusUnauthorized,
+			expResponse: `{"error":"unauthorized"}`,
+		},
+		"invalid_status": {
+			givenUserID: 1,
+			givenQuery:  "?status=UNKNOWN",
+			expStatus:   http.StatusBadRequest,
+			expResponse: `{"error":"invalid order status"}`,
+		},
+		"invalid_from": {
+			givenUserID: 1,
+			givenQuery:  "?from=yesterday",
+			expStatus:   http.StatusBadRequest,
+			expResponse: `{"error":"invalid from date format"}`,
+		},
+		"invalid_to": {
+			givenUserID: 1,
+			givenQuery:  "?to=tomorrow",
+			expStatus:   http.StatusBadRequest,
+			expResponse: `{"error":"invalid to date format"}`,
+		},
+		"invalid_date_range": {
+			givenUserID: 1,
+			givenQuery:  "?from=2024-02-01T00:00:00Z&to=2024-01-01T00:00:00Z",
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				input: model.ListOrdersInput{
+					UserID:      1,
+					CreatedFrom: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+					CreatedTo:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				err: orders.ErrInvalidDateRange,
+			},
+			expStatus:   http.StatusBadRequest,
+			expResponse: `{"error":"invalid date range"}`,
+		},
+		"internal_server_error": {
+			givenUserID: 1,
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				input:    model.ListOrdersInput{UserID: 1},
+				err:      errors.New("unexpected error"),
+			},
+			expStatus:   http.StatusInternalServerError,
+			expResponse: `{"error":"internal server error"}`,
+		},
+	}
+
+	for desc, tc := range tests {
+		t.Run(desc, func(t *testing.T) {
+			// Create mocks
+			mockCtrl := orders.NewMockController(t)
+			handler := NewHandler(mockCtrl)
+
+			// Create a test router
+			router := gin.New()
+			router.GET("/authenticated/order/list", func(c *gin.Context) {
+				if tc.givenUserID != 0 {
+					c.Set("user_id", tc.givenUserID)
+				}
+			}, handler.ListOrders)
+
+			if tc.mockOrderCtrl.wantCall {
+				mockCtrl.On("ListOrders", mock.Anything, tc.mockOrderCtrl.input).Return(tc.mockOrderCtrl.output, tc.mockOrderCtrl.err)
+			}
+
+			// Create test request
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/authenticated/order/list"+tc.givenQuery, nil)
+			router.ServeHTTP(w, req)
+
+			// Verify response
+			require.Equal(t, tc.expStatus, w.Code)
+			require.JSONEq(t, tc.expResponse, w.Body.String())
+		})
+	}
+}
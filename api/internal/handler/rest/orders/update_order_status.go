@@ -7,7 +7,6 @@ import (
 
 	"omg/api/internal/controller/orders"
 	"omg/api/internal/model"
-	"omg/api/internal/ws"
 
 	"github.com/gin-gonic/gin"
 )
@@ -34,6 +33,11 @@ type updateOrderItemResponse struct {
 
 // UpdateOrderStatus handles order updating status
 func (h *Handler) UpdateOrderStatus(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
 
 	var req updateOrderRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -52,11 +56,23 @@ func (h *Handler) UpdateOrderStatus(c *gin.Context) {
 		return
 	}
 
-	order, err := h.controller.UpdateOrderStatus(c.Request.Context(), orderID, model.OrderStatus(req.Status))
+	status := model.OrderStatus(req.Status)
+	if !status.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order status"})
+		return
+	}
+
+	order, err := h.controller.UpdateOrderStatus(c.Request.Context(), userID, model.UserRole(c.GetString("role")), orderID, status)
 	if err != nil {
 		switch {
 		case errors.Is(err, orders.ErrOrderNotFound):
 			c.JSON(http.StatusBadRequest, gin.H{"error": "order not found"})
+		case errors.Is(err, orders.ErrInvalidOrderStatus):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order status"})
+		case errors.Is(err, orders.ErrInvalidStatusTransition):
+			c.JSON(http.StatusConflict, gin.H{"error": "invalid order status transition"})
+		case errors.Is(err, orders.ErrStatusChangeForbidden):
+			c.JSON(http.StatusForbidden, gin.H{"error": "forbidden"})
 		case errors.Is(err, orders.ErrProductNotFound):
 			c.JSON(http.StatusBadRequest, gin.H{"error": "product not found"})
 		case errors.Is(err, orders.ErrProductOutOfStock):
@@ -67,16 +83,10 @@ func (h *Handler) UpdateOrderStatus(c *gin.Context) {
 		return
 	}
 
-	// Broadcast order status update via WebSocket
-	msg := ws.NewOrderStatusMessage(order.ID, order.UserID, order.Status.String(), order.TotalCost)
-	if msgBytes, err := msg.ToJSON(); err == nil {
-		h.wsHub.BroadcastMessage(msgBytes)
-	}
-
 	resp := updateOrderResponse{
 		ID:        strconv.FormatInt(order.ID, 10),
 		UserID:    strconv.FormatInt(order.UserID, 10),
-		TotalCost: strconv.FormatFloat(order.TotalCost, 'f', -1, 64),
+		TotalCost: order.TotalCost.String(),
 		Status:    order.Status.String(),
 	}
 
@@ -86,7 +96,7 @@ func (h *Handler) UpdateOrderStatus(c *gin.Context) {
 			OrderId:   strconv.FormatInt(item.OrderID, 10),
 			ProductID: strconv.FormatInt(item.ProductID, 10),
 			Quantity:  strconv.FormatInt(item.Quantity, 10),
-			Price:     strconv.FormatFloat(item.Price, 'f', -1, 64),
+			Price:     item.Price.String(),
 		})
 	}
 	c.JSON(http.StatusCreated, resp)
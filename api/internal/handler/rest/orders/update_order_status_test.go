@@ -10,9 +10,9 @@ import (
 
 	"omg/api/internal/controller/orders"
 	"omg/api/internal/model"
-	"omg/api/internal/ws"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -28,12 +28,13 @@ func TestHandler_UpdateOrderStatus(t *testing.T) {
 		err      error
 	}
 	tests := map[string]struct {
-		givenID         string
-		requestBody     updateOrderRequest
-		mockOrderCtrl   mockOrderCtrl
-		expStatus       int
-		expResponse     map[string]interface{}
-		shouldBroadcast bool
+		givenID       string
+		requestBody   updateOrderRequest
+		mockOrderCtrl mockOrderCtrl
+		expStatus     int
+		expResponse   map[string]interface{}
+		anonymous     bool
+		role          string
 	}{
 		"successful_update_order_status": {
 			givenID: "1",
@@ -48,14 +49,14 @@ func TestHandler_UpdateOrderStatus(t *testing.T) {
 					ID:        1,
 					UserID:    1,
 					Status:    model.OrderStatusPaid,
-					TotalCost: 100.0,
+					TotalCost: decimal.RequireFromString("100.0"),
 					OrderItems: []model.OrderItem{
 						{
 							ID:        1,
 							OrderID:   1,
 							ProductID: 1,
 							Quantity:  2,
-							Price:     50.0,
+							Price:     decimal.RequireFromString("50.0"),
 						},
 					},
 				},
@@ -77,7 +78,6 @@ func TestHandler_UpdateOrderStatus(t *testing.T) {
 					},
 				},
 			},
-			shouldBroadcast: true,
 		},
 		"invalid user_id": {
 			givenID: "invalid",
@@ -89,6 +89,32 @@ func TestHandler_UpdateOrderStatus(t *testing.T) {
 				"error": "strconv.ParseInt: parsing \"invalid\": invalid syntax",
 			},
 		},
+		"invalid status": {
+			givenID: "1",
+			requestBody: updateOrderRequest{
+				Status: "UNKNOWN",
+			},
+			expStatus: http.StatusBadRequest,
+			expResponse: map[string]interface{}{
+				"error": "invalid order status",
+			},
+		},
+		"invalid status transition": {
+			givenID: "1",
+			requestBody: updateOrderRequest{
+				Status: model.OrderStatusPending.String(),
+			},
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				id:       1,
+				status:   model.OrderStatusPending,
+				err:      orders.ErrInvalidStatusTransition,
+			},
+			expStatus: http.StatusConflict,
+			expResponse: map[string]interface{}{
+				"error": "invalid order status transition",
+			},
+		},
 		"missing user_id": {
 			givenID:   "0",
 			expStatus: http.StatusBadRequest,
@@ -128,6 +154,67 @@ func TestHandler_UpdateOrderStatus(t *testing.T) {
 				"error": "product out of stock",
 			},
 		},
+		"missing user in token": {
+			givenID: "1",
+			requestBody: updateOrderRequest{
+				Status: model.OrderStatusPaid.String(),
+			},
+			anonymous: true,
+			expStatus: http.StatusUnauthorized,
+			expResponse: map[string]interface{}{
+				"error": "unauthorized",
+			},
+		},
+		"order of another user": {
+			givenID: "1",
+			requestBody: updateOrderRequest{
+				Status: model.OrderStatusPaid.String(),
+			},
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				id:       1,
+				status:   model.OrderStatusPaid,
+				err:      orders.ErrOrderNotFound,
+			},
+			expStatus: http.StatusBadRequest,
+			expResponse: map[string]interface{}{
+				"error": "order not found",
+			},
+		},
+		"customer cannot pay": {
+			givenID: "1",
+			requestBody: updateOrderRequest{
+				Status: model.OrderStatusPaid.String(),
+			},
+			role: "CUSTOMER",
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				id:       1,
+				status:   model.OrderStatusPaid,
+				err:      orders.ErrStatusChangeForbidden,
+			},
+			expStatus: http.StatusForbidden,
+			expResponse: map[string]interface{}{
+				"error": "forbidden",
+			},
+		},
+		"admin updates any order": {
+			givenID: "1",
+			requestBody: updateOrderRequest{
+				Status: model.OrderStatusPaid.String(),
+			},
+			role: "ADMIN",
+			mockOrderCtrl: mockOrderCtrl{
+				wantCall: true,
+				id:       1,
+				status:   model.OrderStatusPaid,
+				err:      orders.ErrInvalidStatusTransition,
+			},
+			expStatus: http.StatusConflict,
+			expResponse: map[string]interface{}{
+				"error": "invalid order status transition",
+			},
+		},
 		"internal server error": {
 			givenID: "1",
 			requestBody: updateOrderRequest{
@@ -150,20 +237,21 @@ func TestHandler_UpdateOrderStatus(t *testing.T) {
 		t.Run(desc, func(t *testing.T) {
 			// Create mocks
 			mockCtrl := orders.NewMockController(t)
-			mockHub := ws.NewMockHub(t)
-			handler := NewHandler(mockCtrl, mockHub)
+			handler := NewHandler(mockCtrl)
 
 			// Create a test router
 			router := gin.New()
-			router.PUT("/authenticated/orders/update/:id", handler.UpdateOrderStatus)
+			router.PUT("/authenticated/orders/update/:id", func(c *gin.Context) {
+				if !tc.anonymous {
+					c.Set("user_id", int64(1))
+				}
+				if tc.role != "" {
+					c.Set("role", tc.role)
+				}
+			}, handler.UpdateOrderStatus)
 
 			if tc.mockOrderCtrl.wantCall {
-				mockCtrl.On("UpdateOrderStatus", mock.Anything, tc.mockOrderCtrl.id, tc.mockOrderCtrl.status).Return(tc.mockOrderCtrl.output, tc.mockOrderCtrl.err)
-			}
-
-			if tc.shouldBroadcast {
-				// Create a mock hub without starting its goroutine
-				mockHub.On("BroadcastMessage", mock.Anything).Return()
+				mockCtrl.On("UpdateOrderStatus", mock.Anything, int64(1), model.UserRole(tc.role), tc.mockOrderCtrl.id, tc.mockOrderCtrl.status).Return(tc.mockOrderCtrl.output, tc.mockOrderCtrl.err)
 			}
 
 			// Create request body
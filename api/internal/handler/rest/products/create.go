@@ -7,7 +7,7 @@ import (
 
 	"omg/api/internal/controller/products"
 	"omg/api/internal/model"
-	"omg/api/pkg/floatutil"
+	"omg/api/pkg/decimalutil"
 
 	"github.com/gin-gonic/gin"
 )
@@ -36,7 +36,7 @@ func (h *Handler) Create(c *gin.Context) {
 		return
 	}
 
-	price, err := strconv.ParseFloat(req.Price, 64)
+	price, err := parsePrice(req.Price)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -49,10 +49,11 @@ func (h *Handler) Create(c *gin.Context) {
 	}
 
 	input := model.CreateProductInput{
-		Name:  req.Name,
-		Desc:  req.Description,
-		Price: price,
-		Stock: stock,
+		Name:      req.Name,
+		Desc:      req.Description,
+		Price:     price,
+		Stock:     stock,
+		CreatedBy: c.GetInt64("user_id"),
 	}
 
 	p, err := h.controller.Create(c.Request.Context(), input)
@@ -60,6 +61,11 @@ func (h *Handler) Create(c *gin.Context) {
 		switch {
 		case errors.Is(err, products.ErrProductAlreadyExists):
 			c.JSON(http.StatusBadRequest, gin.H{"error": "product already exists"})
+		case errors.Is(err, products.ErrInvalidProductName),
+			errors.Is(err, products.ErrInvalidProductDescription),
+			errors.Is(err, products.ErrInvalidProductPrice),
+			errors.Is(err, products.ErrInvalidProductStock):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 		}
@@ -70,7 +76,7 @@ func (h *Handler) Create(c *gin.Context) {
 		ID:          strconv.FormatInt(p.ID, 10),
 		Name:        p.Name,
 		Description: p.Description,
-		Price:       floatutil.FormatFloat(p.Price),
+		Price:       decimalutil.FormatDecimal(p.Price),
 		Stock:       strconv.FormatInt(p.Stock, 10),
 		Status:      p.Status.String(),
 	})
@@ -13,6 +13,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -44,14 +45,14 @@ func TestHandler_Create(t *testing.T) {
 				input: model.CreateProductInput{
 					Name:  "Test Product",
 					Desc:  "Test Description",
-					Price: 2000,
+					Price: decimal.RequireFromString("2000"),
 					Stock: 100,
 				},
 				output: model.Product{
 					ID:          1,
 					Name:        "Test Product",
 					Description: "Test Description",
-					Price:       2000,
+					Price:       decimal.RequireFromString("2000"),
 					Stock:       100,
 					Status:      model.ProductStatusActive,
 				},
@@ -106,6 +107,50 @@ func TestHandler_Create(t *testing.T) {
 			expStatus:    http.StatusBadRequest,
 			expectedBody: gin.H{"error": "Key: 'createRequest.Stock' Error:Field validation for 'Stock' failed on the 'required' tag"},
 		},
+		"invalid_price_precision": {
+			requestBody: createRequest{
+				Name:        "Test Product",
+				Description: "Test Description",
+				Price:       "10.555",
+				Stock:       "100",
+			},
+			expStatus:    http.StatusBadRequest,
+			expectedBody: gin.H{"error": "product price must have at most 2 decimal places"},
+		},
+		"successful_fractional_price": {
+			requestBody: createRequest{
+				Name:        "Test Product",
+				Description: "Test Description",
+				Price:       "10.10",
+				Stock:       "100",
+			},
+			mockProductCtrl: mockProductCtrl{
+				wantCall: true,
+				input: model.CreateProductInput{
+					Name:  "Test Product",
+					Desc:  "Test Description",
+					Price: decimal.RequireFromString("10.10"),
+					Stock: 100,
+				},
+				output: model.Product{
+					ID:          1,
+					Name:        "Test Product",
+					Description: "Test Description",
+					Price:       decimal.RequireFromString("10.10"),
+					Stock:       100,
+					Status:      model.ProductStatusActive,
+				},
+			},
+			expStatus: http.StatusCreated,
+			expectedBody: createResponse{
+				ID:          "1",
+				Name:        "Test Product",
+				Description: "Test Description",
+				Price:       "10.10",
+				Stock:       "100",
+				Status:      model.ProductStatusActive.String(),
+			},
+		},
 		"product_exists": {
 			requestBody: createRequest{
 				Name:        "Test Product",
@@ -118,7 +163,7 @@ func TestHandler_Create(t *testing.T) {
 				input: model.CreateProductInput{
 					Name:  "Test Product",
 					Desc:  "Test Description",
-					Price: 2000,
+					Price: decimal.RequireFromString("2000"),
 					Stock: 100,
 				},
 				err: products.ErrProductAlreadyExists,
@@ -126,6 +171,26 @@ func TestHandler_Create(t *testing.T) {
 			expStatus:    http.StatusBadRequest,
 			expectedBody: gin.H{"error": "product already exists"},
 		},
+		"negative_stock": {
+			requestBody: createRequest{
+				Name:        "Test Product",
+				Description: "Test Description",
+				Price:       "2000",
+				Stock:       "-1",
+			},
+			mockProductCtrl: mockProductCtrl{
+				wantCall: true,
+				input: model.CreateProductInput{
+					Name:  "Test Product",
+					Desc:  "Test Description",
+					Price: decimal.RequireFromString("2000"),
+					Stock: -1,
+				},
+				err: products.ErrInvalidProductStock,
+			},
+			expStatus:    http.StatusBadRequest,
+			expectedBody: gin.H{"error": "product stock must not be negative"},
+		},
 		"internal server error": {
 			requestBody: createRequest{
 				Name:        "Test Product",
@@ -138,7 +203,7 @@ func TestHandler_Create(t *testing.T) {
 				input: model.CreateProductInput{
 					Name:  "Test Product",
 					Desc:  "Test Description",
-					Price: 2000,
+					Price: decimal.RequireFromString("2000"),
 					Stock: 100,
 				},
 				err: errors.New("unexpected error"),
@@ -156,11 +221,16 @@ func TestHandler_Create(t *testing.T) {
 
 			// Create a test router
 			router := gin.New()
-			router.POST("/authenticated/products/create", handler.Create)
+			router.POST("/authenticated/products/create", func(c *gin.Context) {
+				c.Set("user_id", int64(7))
+			}, handler.Create)
 
 			// Setup mock expectations
 			if tc.mockProductCtrl.wantCall {
-				mockCtrl.On("Create", mock.Anything, tc.mockProductCtrl.input).Return(tc.mockProductCtrl.output, tc.mockProductCtrl.err)
+				// The caller is recorded as who created the product
+				input := tc.mockProductCtrl.input
+				input.CreatedBy = 7
+				mockCtrl.On("Create", mock.Anything, input).Return(tc.mockProductCtrl.output, tc.mockProductCtrl.err)
 			}
 
 			// Create test request
@@ -6,7 +6,7 @@ import (
 	"strconv"
 
 	"omg/api/internal/controller/products"
-	"omg/api/pkg/floatutil"
+	"omg/api/pkg/decimalutil"
 
 	"github.com/gin-gonic/gin"
 )
@@ -17,7 +17,9 @@ type getProductByIDResponse struct {
 	Description string `json:"description"`
 	Price       string `json:"price"`
 	Stock       string `json:"stock"`
-	Status      string `json:"status"`
+	// AvailableStock is the stock which is not reserved for pending orders
+	AvailableStock string `json:"available_stock"`
+	Status         string `json:"status"`
 }
 
 func (h *Handler) GetProductByID(c *gin.Context) {
@@ -46,11 +48,12 @@ func (h *Handler) GetProductByID(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, getProductByIDResponse{
-		ID:          strconv.FormatInt(p.ID, 10),
-		Name:        p.Name,
-		Description: p.Description,
-		Price:       floatutil.FormatFloat(p.Price),
-		Stock:       strconv.FormatInt(p.Stock, 10),
-		Status:      p.Status.String(),
+		ID:             strconv.FormatInt(p.ID, 10),
+		Name:           p.Name,
+		Description:    p.Description,
+		Price:          decimalutil.FormatDecimal(p.Price),
+		Stock:          strconv.FormatInt(p.Stock, 10),
+		AvailableStock: strconv.FormatInt(p.AvailableStock(), 10),
+		Status:         p.Status.String(),
 	})
 }
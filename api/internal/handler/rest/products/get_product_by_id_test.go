@@ -11,6 +11,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -42,20 +43,22 @@ func TestHandler_GetProductByID(t *testing.T) {
 					ID:          123,
 					Name:        "test product",
 					Description: "test description",
-					Price:       2000,
+					Price:       decimal.RequireFromString("2000"),
 					Stock:       100,
+					Reserved:    30,
 					Status:      model.ProductStatusActive,
 				},
 				err: nil,
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: getProductByIDResponse{
-				ID:          "123",
-				Name:        "test product",
-				Description: "test description",
-				Price:       "2000",
-				Stock:       "100",
-				Status:      model.ProductStatusActive.String(),
+				ID:             "123",
+				Name:           "test product",
+				Description:    "test description",
+				Price:          "2000",
+				Stock:          "100",
+				AvailableStock: "70",
+				Status:         model.ProductStatusActive.String(),
 			},
 		},
 		"invalid_product_id_format": {
@@ -0,0 +1,327 @@
+package products
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"omg/api/internal/controller/products"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// maxImportBodyBytes bounds the size of the imported document
+	maxImportBodyBytes = 5 << 20
+	// maxImportLineBytes bounds the size of a single JSON line of the imported document
+	maxImportLineBytes = 64 << 10
+)
+
+// importRow is a row of the imported document, before its values are parsed
+type importRow struct {
+	Action      string `json:"action"`
+	ProductID   string `json:"product_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Price       string `json:"price"`
+	Stock       string `json:"stock"`
+	Delta       string `json:"delta"`
+}
+
+type importRowResponse struct {
+	Line      int    `json:"line"`
+	Status    string `json:"status"`
+	ProductID string `json:"product_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type importResponse struct {
+	Mode    string              `json:"mode"`
+	Applied int                 `json:"applied"`
+	Failed  int                 `json:"failed"`
+	Rows    []importRowResponse `json:"rows"`
+}
+
+// Import handles bulk product creates & stock adjustments, sent as CSV or JSON lines
+func (h *Handler) Import(c *gin.Context) {
+	partial := false
+	switch mode := c.DefaultQuery("mode", "atomic"); mode {
+	case "atomic":
+	case "partial":
+		partial = true
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid mode"})
+		return
+	}
+
+	decode, err := importDecoder(c.GetHeader("Content-Type"))
+	if err != nil {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		return
+	}
+
+	rows, lines, err := decode(http.MaxBytesReader(c.Writer, c.Request.Body, maxImportBodyBytes))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := importResponse{Mode: "atomic", Rows: []importRowResponse{}}
+	if partial {
+		resp.Mode = "partial"
+	}
+
+	// Rows which can't even be parsed are reported as failed without reaching the controller
+	input := model.ImportProductsInput{Partial: partial, ImportedBy: c.GetInt64("user_id")}
+	for idx, row := range rows {
+		r, err := mapImportRow(lines[idx], row)
+		if err != nil {
+			resp.Rows = append(resp.Rows, importRowResponse{
+				Line:   lines[idx],
+				Status: model.ProductImportRowStatusFailed.String(),
+				Error:  err.Error(),
+			})
+			resp.Failed++
+			continue
+		}
+		input.Rows = append(input.Rows, r)
+	}
+
+	if resp.Failed > 0 && !partial {
+		for _, r := range input.Rows {
+			resp.Rows = append(resp.Rows, importRowResponse{
+				Line:   r.Line,
+				Status: model.ProductImportRowStatusSkipped.String(),
+			})
+		}
+		sortImportRows(resp.Rows)
+		c.JSON(http.StatusUnprocessableEntity, resp)
+		return
+	}
+
+	if len(input.Rows) > 0 || resp.Failed == 0 {
+		result, err := h.controller.Import(c.Request.Context(), input)
+		if err != nil {
+			switch {
+			case errors.Is(err, products.ErrEmptyImport),
+				errors.Is(err, products.ErrTooManyImportRows):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			}
+			return
+		}
+
+		for _, r := range result.Rows {
+			resp.Rows = append(resp.Rows, toImportRowResponse(r))
+		}
+		resp.Applied += result.Applied
+		resp.Failed += result.Failed
+	}
+
+	sortImportRows(resp.Rows)
+
+	if resp.Failed > 0 && !partial {
+		c.JSON(http.StatusUnprocessableEntity, resp)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// importDecoder picks the decoder of the imported document by its content type
+func importDecoder(contentType string) (func(io.Reader) ([]importRow, []int, error), error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, errors.New("content type must be text/csv or application/x-ndjson")
+	}
+
+	switch mediaType {
+	case "text/csv":
+		return decodeCSVRows, nil
+	case "application/x-ndjson", "application/jsonl":
+		return decodeJSONLinesRows, nil
+	}
+
+	return nil, errors.New("content type must be text/csv or application/x-ndjson")
+}
+
+// decodeCSVRows reads the CSV rows by the column names of its header, returning the line of each row
+func decodeCSVRows(r io.Reader) ([]importRow, []int, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("invalid csv: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for idx, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = idx
+	}
+	if _, ok := columns["action"]; !ok {
+		return nil, nil, errors.New("csv header must have an action column")
+	}
+
+	var rows []importRow
+	var lines []int
+	for {
+		record, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, lines, nil
+			}
+			return nil, nil, fmt.Errorf("invalid csv: %w", err)
+		}
+
+		value := func(column string) string {
+			idx, ok := columns[column]
+			if !ok {
+				return ""
+			}
+			return strings.TrimSpace(record[idx])
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, importRow{
+			Action:      value("action"),
+			ProductID:   value("product_id"),
+			Name:        value("name"),
+			Description: value("description"),
+			Price:       value("price"),
+			Stock:       value("stock"),
+			Delta:       value("delta"),
+		})
+		lines = append(lines, line)
+	}
+}
+
+// decodeJSONLinesRows reads a JSON object per non-blank line, returning the line of each row
+func decodeJSONLinesRows(r io.Reader) ([]importRow, []int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxImportLineBytes)
+
+	var rows []importRow
+	var lines []int
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		var row importRow
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			return nil, nil, fmt.Errorf("invalid json on line %d", line)
+		}
+		rows = append(rows, row)
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("invalid json lines: %w", err)
+	}
+
+	return rows, lines, nil
+}
+
+// mapImportRow parses the values of the row needed by its action, leaving the rest of the validation to the controller
+func mapImportRow(line int, row importRow) (model.ProductImportRow, error) {
+	action := model.ProductImportAction(strings.ToUpper(row.Action))
+	result := model.ProductImportRow{Line: line, Action: action}
+
+	switch action {
+	case model.ProductImportActionCreate:
+		if row.Price == "" {
+			return result, errors.New("product price is required")
+		}
+		price, err := parsePrice(row.Price)
+		if err != nil {
+			return result, errors.New("invalid product price")
+		}
+
+		if row.Stock == "" {
+			return result, errors.New("product stock is required")
+		}
+		stock, err := strconv.ParseInt(row.Stock, 10, 64)
+		if err != nil {
+			return result, errors.New("invalid product stock")
+		}
+
+		result.Create = model.CreateProductInput{
+			Name:  row.Name,
+			Desc:  row.Description,
+			Price: price,
+			Stock: stock,
+		}
+	case model.ProductImportActionAdjust:
+		id, err := strconv.ParseInt(row.ProductID, 10, 64)
+		if err != nil {
+			return result, errors.New("invalid product id")
+		}
+
+		delta, err := strconv.ParseInt(row.Delta, 10, 64)
+		if err != nil {
+			return result, errors.New("invalid stock delta")
+		}
+
+		result.ProductID = id
+		result.Delta = delta
+	default:
+		return result, errors.New("invalid import action")
+	}
+
+	return result, nil
+}
+
+func toImportRowResponse(r model.ProductImportRowResult) importRowResponse {
+	resp := importRowResponse{
+		Line:   r.Line,
+		Status: r.Status.String(),
+	}
+	if r.ProductID != 0 {
+		resp.ProductID = strconv.FormatInt(r.ProductID, 10)
+	}
+	if r.Err != nil {
+		resp.Error = importRowErrorMessage(r.Err)
+	}
+	return resp
+}
+
+// importRowErrorMessage exposes the errors caused by the row, hiding the internal ones
+func importRowErrorMessage(err error) string {
+	for _, rowErr := range []error{
+		products.ErrInvalidProductName,
+		products.ErrInvalidProductDescription,
+		products.ErrInvalidProductPrice,
+		products.ErrInvalidProductStock,
+		products.ErrInvalidStockAdjustment,
+		products.ErrInvalidImportAction,
+		products.ErrDuplicateImportName,
+		products.ErrInsufficientStock,
+		products.ErrProductAlreadyExists,
+		products.ErrProductDeleted,
+		products.ErrNotFound,
+	} {
+		if errors.Is(err, rowErr) {
+			return rowErr.Error()
+		}
+	}
+	return "internal server error"
+}
+
+func sortImportRows(rows []importRowResponse) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].Line < rows[j].Line
+	})
+}
@@ -0,0 +1,240 @@
+package products
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"omg/api/internal/controller/products"
+	"omg/api/internal/model"
+	"omg/api/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Import(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	createRow := model.ProductImportRow{
+		Line:   2,
+		Action: model.ProductImportActionCreate,
+		Create: model.CreateProductInput{
+			Name:  "Banana",
+			Desc:  "Yellow",
+			Price: decimal.RequireFromString("1.50"),
+			Stock: 10,
+		},
+	}
+	adjustRow := model.ProductImportRow{
+		Line:      3,
+		Action:    model.ProductImportActionAdjust,
+		ProductID: 5,
+		Delta:     -2,
+	}
+
+	type mockImportCtrl struct {
+		wantCall bool
+		input    model.ImportProductsInput
+		out      model.ProductImportResult
+		err      error
+	}
+
+	tcs := map[string]struct {
+		givenURL         string
+		givenContentType string
+		givenBody        string
+		mockImportCtrl   mockImportCtrl
+		expectedStatus   int
+		expectedBody     interface{}
+	}{
+		"csv_success": {
+			givenURL:         "/authenticated/products/import",
+			givenContentType: "text/csv; charset=utf-8",
+			givenBody:        "action,product_id,name,description,price,stock,delta\ncreate,,Banana,Yellow,1.50,10,\nADJUST,5,,,,,-2\n",
+			mockImportCtrl: mockImportCtrl{
+				wantCall: true,
+				input:    model.ImportProductsInput{Rows: []model.ProductImportRow{createRow, adjustRow}, ImportedBy: 7},
+				out: model.ProductImportResult{
+					Rows: []model.ProductImportRowResult{
+						{Line: 2, Status: model.ProductImportRowStatusApplied, ProductID: 11},
+						{Line: 3, Status: model.ProductImportRowStatusApplied, ProductID: 5},
+					},
+					Applied: 2,
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: gin.H{
+				"mode":    "atomic",
+				"applied": 2,
+				"failed":  0,
+				"rows": []gin.H{
+					{"line": 2, "status": "APPLIED", "product_id": "11"},
+					{"line": 3, "status": "APPLIED", "product_id": "5"},
+				},
+			},
+		},
+		"csv_row_fails_import": {
+			givenURL:         "/authenticated/products/import",
+			givenContentType: "text/csv",
+			givenBody:        "action,product_id,name,description,price,stock,delta\ncreate,,Banana,Yellow,1.50,10,\nadjust,5,,,,,-2\n",
+			mockImportCtrl: mockImportCtrl{
+				wantCall: true,
+				input:    model.ImportProductsInput{Rows: []model.ProductImportRow{createRow, adjustRow}, ImportedBy: 7},
+				out: model.ProductImportResult{
+					Rows: []model.ProductImportRowResult{
+						{Line: 2, Status: model.ProductImportRowStatusSkipped},
+						{Line: 3, Status: model.ProductImportRowStatusFailed, ProductID: 5, Err: products.ErrInsufficientStock},
+					},
+					Failed: 1,
+				},
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: gin.H{
+				"mode":    "atomic",
+				"applied": 0,
+				"failed":  1,
+				"rows": []gin.H{
+					{"line": 2, "status": "SKIPPED"},
+					{"line": 3, "status": "FAILED", "product_id": "5", "error": "stock adjustment takes the stock below the reserved stock"},
+				},
+			},
+		},
+		"csv_unparsable_row_skips_all": {
+			givenURL:         "/authenticated/products/import",
+			givenContentType: "text/csv",
+			givenBody:        "action,name,description,price,stock\ncreate,Banana,Yellow,1.50,10\ncreate,Cherry,Red,abc,10\n",
+			expectedStatus:   http.StatusUnprocessableEntity,
+			expectedBody: gin.H{
+				"mode":    "atomic",
+				"applied": 0,
+				"failed":  1,
+				"rows": []gin.H{
+					{"line": 2, "status": "SKIPPED"},
+					{"line": 3, "status": "FAILED", "error": "invalid product price"},
+				},
+			},
+		},
+		"json_lines_partial": {
+			givenURL:         "/authenticated/products/import?mode=partial",
+			givenContentType: "application/x-ndjson",
+			givenBody: `{"action":"create","name":"Banana","description":"Yellow","price":"1.50","stock":"10"}
+
+{"action":"adjust","product_id":"5","delta":"-2"}
+{"action":"adjust","product_id":"x","delta":"1"}
+{"action":"adjust","product_id":"6","delta":"1"}`,
+			mockImportCtrl: mockImportCtrl{
+				wantCall: true,
+				input: model.ImportProductsInput{
+					Rows: []model.ProductImportRow{
+						{Line: 1, Action: createRow.Action, Create: createRow.Create},
+						adjustRow,
+						{Line: 5, Action: model.ProductImportActionAdjust, ProductID: 6, Delta: 1},
+					},
+					Partial:    true,
+					ImportedBy: 7,
+				},
+				out: model.ProductImportResult{
+					Rows: []model.ProductImportRowResult{
+						{Line: 1, Status: model.ProductImportRowStatusApplied, ProductID: 11},
+						{Line: 3, Status: model.ProductImportRowStatusFailed, ProductID: 5, Err: products.ErrNotFound},
+						{Line: 5, Status: model.ProductImportRowStatusFailed, ProductID: 6, Err: errors.New("database error")},
+					},
+					Applied: 1,
+					Failed:  2,
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: gin.H{
+				"mode":    "partial",
+				"applied": 1,
+				"failed":  3,
+				"rows": []gin.H{
+					{"line": 1, "status": "APPLIED", "product_id": "11"},
+					{"line": 3, "status": "FAILED", "product_id": "5", "error": "product not found"},
+					{"line": 4, "status": "FAILED", "error": "invalid product id"},
+					{"line": 5, "status": "FAILED", "product_id": "6", "error": "internal server error"},
+				},
+			},
+		},
+		"invalid_json_line": {
+			givenURL:         "/authenticated/products/import",
+			givenContentType: "application/x-ndjson",
+			givenBody:        "{\"action\":\"create\"\n",
+			expectedStatus:   http.StatusBadRequest,
+			expectedBody:     gin.H{"error": "invalid json on line 1"},
+		},
+		"csv_without_action_column": {
+			givenURL:         "/authenticated/products/import",
+			givenContentType: "text/csv",
+			givenBody:        "name,price\nBanana,1.50\n",
+			expectedStatus:   http.StatusBadRequest,
+			expectedBody:     gin.H{"error": "csv header must have an action column"},
+		},
+		"empty_import": {
+			givenURL:         "/authenticated/products/import",
+			givenContentType: "text/csv",
+			mockImportCtrl: mockImportCtrl{
+				wantCall: true,
+				input:    model.ImportProductsInput{ImportedBy: 7},
+				err:      products.ErrEmptyImport,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "import has no rows"},
+		},
+		"invalid_mode": {
+			givenURL:         "/authenticated/products/import?mode=some",
+			givenContentType: "text/csv",
+			expectedStatus:   http.StatusBadRequest,
+			expectedBody:     gin.H{"error": "invalid mode"},
+		},
+		"unsupported_content_type": {
+			givenURL:         "/authenticated/products/import",
+			givenContentType: "application/json",
+			givenBody:        "[]",
+			expectedStatus:   http.StatusUnsupportedMediaType,
+			expectedBody:     gin.H{"error": "content type must be text/csv or application/x-ndjson"},
+		},
+		"internal_server_error": {
+			givenURL:         "/authenticated/products/import",
+			givenContentType: "text/csv",
+			givenBody:        "action,product_id,delta\nadjust,5,-2\n",
+			mockImportCtrl: mockImportCtrl{
+				wantCall: true,
+				input:    model.ImportProductsInput{Rows: []model.ProductImportRow{{Line: 2, Action: model.ProductImportActionAdjust, ProductID: 5, Delta: -2}}, ImportedBy: 7},
+				err:      errors.New("database error"),
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   gin.H{"error": "internal server error"},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Setup
+			mockCtrl := products.NewMockController(t)
+			handler := New(mockCtrl)
+
+			router := gin.New()
+			router.POST("/authenticated/products/import", func(c *gin.Context) {
+				c.Set("user_id", int64(7))
+			}, handler.Import)
+
+			if tc.mockImportCtrl.wantCall {
+				mockCtrl.On("Import", mock.Anything, tc.mockImportCtrl.input).Return(tc.mockImportCtrl.out, tc.mockImportCtrl.err)
+			}
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, tc.givenURL, strings.NewReader(tc.givenBody))
+			req.Header.Set("Content-Type", tc.givenContentType)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.JSONEq(t, testutil.ToJSONString(tc.expectedBody), w.Body.String())
+		})
+	}
+}
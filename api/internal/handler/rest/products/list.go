@@ -1,42 +1,122 @@
 package products
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+
+	"omg/api/internal/controller/products"
+	"omg/api/internal/model"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 )
 
 type getProductsResponse struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Price       string `json:"price"`
-	Stock       string `json:"stock"`
-	Status      string `json:"status"`
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Price          string `json:"price"`
+	Stock          string `json:"stock"`
+	AvailableStock string `json:"available_stock"`
+	Status         string `json:"status"`
+}
+
+type listProductsResponse struct {
+	Products   []getProductsResponse `json:"products"`
+	NextCursor string                `json:"next_cursor,omitempty"`
 }
 
+// List handles listing the products page by page.
+// Supported query params: status (comma separated), min_price, max_price, in_stock, name (prefix), cursor & limit.
 func (h *Handler) List(c *gin.Context) {
-	list, err := h.controller.List(c.Request.Context())
+	input, err := parseListProductsQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	list, err := h.controller.List(c.Request.Context(), input)
 	if err != nil {
 		switch {
+		case errors.Is(err, products.ErrInvalidProductStatus),
+			errors.Is(err, products.ErrInvalidPriceRange),
+			errors.Is(err, products.ErrInvalidCursor),
+			errors.Is(err, products.ErrInvalidLimit):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 		}
 		return
 	}
 
-	var response []getProductsResponse
-	for _, p := range list {
-		response = append(response, getProductsResponse{
-			ID:          strconv.FormatInt(p.ID, 10),
-			Name:        p.Name,
-			Description: p.Description,
-			Price:       strconv.FormatFloat(p.Price, 'f', -1, 64),
-			Stock:       strconv.FormatInt(p.Stock, 10),
-			Status:      p.Status.String(),
+	response := listProductsResponse{
+		Products:   []getProductsResponse{},
+		NextCursor: list.NextCursor,
+	}
+	for _, p := range list.Products {
+		response.Products = append(response.Products, getProductsResponse{
+			ID:             strconv.FormatInt(p.ID, 10),
+			Name:           p.Name,
+			Description:    p.Description,
+			Price:          p.Price.String(),
+			Stock:          strconv.FormatInt(p.Stock, 10),
+			AvailableStock: strconv.FormatInt(p.AvailableStock(), 10),
+			Status:         p.Status.String(),
 		})
 	}
 
 	c.JSON(http.StatusOK, response)
 }
+
+func parseListProductsQuery(c *gin.Context) (model.ListProductsInput, error) {
+	input := model.ListProductsInput{
+		NamePrefix: strings.TrimSpace(c.Query("name")),
+		Cursor:     c.Query("cursor"),
+	}
+
+	if v := c.Query("status"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			status := model.ProductStatus(strings.ToUpper(strings.TrimSpace(s)))
+			if !status.IsValid() {
+				return model.ListProductsInput{}, products.ErrInvalidProductStatus
+			}
+			input.Status = append(input.Status, status)
+		}
+	}
+
+	if v := c.Query("min_price"); v != "" {
+		price, err := decimal.NewFromString(v)
+		if err != nil {
+			return model.ListProductsInput{}, errors.New("invalid min_price")
+		}
+		input.MinPrice = decimal.NewNullDecimal(price)
+	}
+
+	if v := c.Query("max_price"); v != "" {
+		price, err := decimal.NewFromString(v)
+		if err != nil {
+			return model.ListProductsInput{}, errors.New("invalid max_price")
+		}
+		input.MaxPrice = decimal.NewNullDecimal(price)
+	}
+
+	if v := c.Query("in_stock"); v != "" {
+		inStock, err := strconv.ParseBool(v)
+		if err != nil {
+			return model.ListProductsInput{}, errors.New("invalid in_stock")
+		}
+		input.InStock = inStock
+	}
+
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return model.ListProductsInput{}, products.ErrInvalidLimit
+		}
+		input.Limit = limit
+	}
+
+	return input, nil
+}
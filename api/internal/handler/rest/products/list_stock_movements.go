@@ -0,0 +1,89 @@
+package products
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"omg/api/internal/controller/products"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+type stockMovementResponse struct {
+	ID      string `json:"id"`
+	Delta   string `json:"delta"`
+	Balance string `json:"balance"`
+	Reason  string `json:"reason"`
+	// OrderID & ActorID are left out when no order or user moved the stock
+	OrderID   string `json:"order_id,omitempty"`
+	ActorID   string `json:"actor_id,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type listStockMovementsResponse struct {
+	Movements  []stockMovementResponse `json:"movements"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+// ListStockMovements handles listing the stock ledger of a product page by page, newest first.
+// Each movement carries the stock on hand right after it as balance. Supported query params: cursor & limit.
+func (h *Handler) ListStockMovements(c *gin.Context) {
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || productID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid product ID"})
+		return
+	}
+
+	input := model.ListStockMovementsInput{
+		ProductID: productID,
+		Cursor:    c.Query("cursor"),
+	}
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": products.ErrInvalidLimit.Error()})
+			return
+		}
+		input.Limit = limit
+	}
+
+	list, err := h.controller.ListStockMovements(c.Request.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, products.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "product not found"})
+		case errors.Is(err, products.ErrInvalidCursor),
+			errors.Is(err, products.ErrInvalidLimit):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		}
+		return
+	}
+
+	response := listStockMovementsResponse{
+		Movements:  []stockMovementResponse{},
+		NextCursor: list.NextCursor,
+	}
+	for _, m := range list.Movements {
+		movement := stockMovementResponse{
+			ID:        strconv.FormatInt(m.ID, 10),
+			Delta:     strconv.FormatInt(m.Delta, 10),
+			Balance:   strconv.FormatInt(m.Balance, 10),
+			Reason:    m.Reason.String(),
+			CreatedAt: m.CreatedAt.Format(time.RFC3339),
+		}
+		if m.OrderID != 0 {
+			movement.OrderID = strconv.FormatInt(m.OrderID, 10)
+		}
+		if m.ActorID != 0 {
+			movement.ActorID = strconv.FormatInt(m.ActorID, 10)
+		}
+		response.Movements = append(response.Movements, movement)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
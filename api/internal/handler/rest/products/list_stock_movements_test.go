@@ -0,0 +1,131 @@
+package products
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/products"
+	"omg/api/internal/model"
+	"omg/api/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ListStockMovements(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	type mockListCtrl struct {
+		wantCall bool
+		input    model.ListStockMovementsInput
+		out      model.StockMovementList
+		err      error
+	}
+
+	tcs := map[string]struct {
+		givenURL       string
+		mockListCtrl   mockListCtrl
+		expectedStatus int
+		expectedBody   interface{}
+	}{
+		"success": {
+			givenURL: "/authenticated/products/123/movements?limit=2&cursor=abc",
+			mockListCtrl: mockListCtrl{
+				wantCall: true,
+				input:    model.ListStockMovementsInput{ProductID: 123, Cursor: "abc", Limit: 2},
+				out: model.StockMovementList{
+					Movements: []model.StockMovement{
+						{ID: 22, ProductID: 123, Delta: -2, Balance: 8, Reason: model.StockMovementReasonSale, OrderID: 789, ActorID: 7, CreatedAt: createdAt},
+						{ID: 21, ProductID: 123, Delta: 10, Balance: 10, Reason: model.StockMovementReasonInitial, CreatedAt: createdAt},
+					},
+					NextCursor: "next",
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: gin.H{
+				"movements": []gin.H{
+					{"id": "22", "delta": "-2", "balance": "8", "reason": "SALE", "order_id": "789", "actor_id": "7", "created_at": "2024-05-01T10:00:00Z"},
+					{"id": "21", "delta": "10", "balance": "10", "reason": "INITIAL", "created_at": "2024-05-01T10:00:00Z"},
+				},
+				"next_cursor": "next",
+			},
+		},
+		"empty": {
+			givenURL: "/authenticated/products/123/movements",
+			mockListCtrl: mockListCtrl{
+				wantCall: true,
+				input:    model.ListStockMovementsInput{ProductID: 123},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   gin.H{"movements": []gin.H{}},
+		},
+		"invalid_product_id": {
+			givenURL:       "/authenticated/products/abc/movements",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid product ID"},
+		},
+		"invalid_limit": {
+			givenURL:       "/authenticated/products/123/movements?limit=abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid limit"},
+		},
+		"invalid_cursor": {
+			givenURL: "/authenticated/products/123/movements?cursor=abc",
+			mockListCtrl: mockListCtrl{
+				wantCall: true,
+				input:    model.ListStockMovementsInput{ProductID: 123, Cursor: "abc"},
+				err:      products.ErrInvalidCursor,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid cursor"},
+		},
+		"product_not_found": {
+			givenURL: "/authenticated/products/123/movements",
+			mockListCtrl: mockListCtrl{
+				wantCall: true,
+				input:    model.ListStockMovementsInput{ProductID: 123},
+				err:      products.ErrNotFound,
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   gin.H{"error": "product not found"},
+		},
+		"internal_server_error": {
+			givenURL: "/authenticated/products/123/movements",
+			mockListCtrl: mockListCtrl{
+				wantCall: true,
+				input:    model.ListStockMovementsInput{ProductID: 123},
+				err:      errors.New("database error"),
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   gin.H{"error": "internal server error"},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Setup
+			mockCtrl := products.NewMockController(t)
+			handler := New(mockCtrl)
+
+			router := gin.New()
+			router.GET("/authenticated/products/:id/movements", handler.ListStockMovements)
+
+			if tc.mockListCtrl.wantCall {
+				mockCtrl.On("ListStockMovements", mock.Anything, tc.mockListCtrl.input).Return(tc.mockListCtrl.out, tc.mockListCtrl.err)
+			}
+
+			// Execute
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tc.givenURL, nil)
+			router.ServeHTTP(w, req)
+
+			// Assertions
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.JSONEq(t, testutil.ToJSONString(tc.expectedBody), w.Body.String())
+		})
+	}
+}
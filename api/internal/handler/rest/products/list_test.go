@@ -11,6 +11,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -20,11 +21,13 @@ func TestHandler_List(t *testing.T) {
 
 	type mockGetListCtrl struct {
 		wantCall bool
-		out      []model.Product
+		input    model.ListProductsInput
+		out      model.ProductList
 		err      error
 	}
 
 	type arg struct {
+		givenQuery      string
 		mockGetListCtrl mockGetListCtrl
 		expectedStatus  int
 		expectedBody    interface{}
@@ -34,53 +37,114 @@ func TestHandler_List(t *testing.T) {
 		"successful_retrieval": {
 			mockGetListCtrl: mockGetListCtrl{
 				wantCall: true,
-				out: []model.Product{
-					{
-						ID:          123,
-						Name:        "test product",
-						Description: "test description",
-						Price:       2000,
-						Stock:       100,
-						Status:      model.ProductStatusActive,
+				out: model.ProductList{
+					Products: []model.Product{
+						{
+							ID:          123,
+							Name:        "test product",
+							Description: "test description",
+							Price:       decimal.RequireFromString("2000"),
+							Stock:       100,
+							Reserved:    30,
+							Status:      model.ProductStatusActive,
+						},
 					},
+					NextCursor: "MTIzOjEyMw",
 				},
 				err: nil,
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody: []getProductByIDResponse{
-				{
-					ID:          "123",
-					Name:        "test product",
-					Description: "test description",
-					Price:       "2000",
-					Stock:       "100",
-					Status:      model.ProductStatusActive.String(),
+			expectedBody: listProductsResponse{
+				Products: []getProductsResponse{
+					{
+						ID:             "123",
+						Name:           "test product",
+						Description:    "test description",
+						Price:          "2000",
+						Stock:          "100",
+						AvailableStock: "70",
+						Status:         model.ProductStatusActive.String(),
+					},
 				},
+				NextCursor: "MTIzOjEyMw",
 			},
 		},
+		"successful_retrieval_with_filters": {
+			givenQuery: "?status=active&min_price=10&max_price=20.5&in_stock=true&name=tes&cursor=MTIzOjEyMw&limit=5",
+			mockGetListCtrl: mockGetListCtrl{
+				wantCall: true,
+				input: model.ListProductsInput{
+					Status:     []model.ProductStatus{model.ProductStatusActive},
+					MinPrice:   decimal.NewNullDecimal(decimal.RequireFromString("10")),
+					MaxPrice:   decimal.NewNullDecimal(decimal.RequireFromString("20.5")),
+					InStock:    true,
+					NamePrefix: "tes",
+					Cursor:     "MTIzOjEyMw",
+					Limit:      5,
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   gin.H{"products": []interface{}{}},
+		},
 		"empty_retrieval": {
 			mockGetListCtrl: mockGetListCtrl{
 				wantCall: true,
-				out:      nil,
-				err:      nil,
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   nil,
+			expectedBody:   gin.H{"products": []interface{}{}},
 		},
-		"internal_server_error": {
+		"invalid_status": {
+			givenQuery:     "?status=unknown",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid product status"},
+		},
+		"invalid_min_price": {
+			givenQuery:     "?min_price=abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid min_price"},
+		},
+		"invalid_max_price": {
+			givenQuery:     "?max_price=abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid max_price"},
+		},
+		"invalid_in_stock": {
+			givenQuery:     "?in_stock=maybe",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid in_stock"},
+		},
+		"invalid_limit": {
+			givenQuery:     "?limit=abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid limit"},
+		},
+		"invalid_cursor": {
+			givenQuery: "?cursor=abc",
 			mockGetListCtrl: mockGetListCtrl{
 				wantCall: true,
-				out: []model.Product{
-					{
-						ID:          123,
-						Name:        "test product",
-						Description: "test description",
-						Price:       2000,
-						Stock:       100,
-						Status:      model.ProductStatusActive,
-					},
+				input:    model.ListProductsInput{Cursor: "abc"},
+				err:      products.ErrInvalidCursor,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid cursor"},
+		},
+		"invalid_price_range": {
+			givenQuery: "?min_price=20&max_price=10",
+			mockGetListCtrl: mockGetListCtrl{
+				wantCall: true,
+				input: model.ListProductsInput{
+					MinPrice: decimal.NewNullDecimal(decimal.RequireFromString("20")),
+					MaxPrice: decimal.NewNullDecimal(decimal.RequireFromString("10")),
 				},
-				err: errors.New("database error"),
+				err: products.ErrInvalidPriceRange,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid price range"},
+		},
+		"internal_server_error": {
+			mockGetListCtrl: mockGetListCtrl{
+				wantCall: true,
+				err:      errors.New("database error"),
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   gin.H{"error": "internal server error"},
@@ -99,12 +163,12 @@ func TestHandler_List(t *testing.T) {
 
 			// Setup mock expectations
 			if tc.mockGetListCtrl.wantCall {
-				mockCtrl.On("List", mock.Anything).Return(tc.mockGetListCtrl.out, tc.mockGetListCtrl.err)
+				mockCtrl.On("List", mock.Anything, tc.mockGetListCtrl.input).Return(tc.mockGetListCtrl.out, tc.mockGetListCtrl.err)
 			}
 
 			// Create test request
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequest("GET", "/authenticated/products/list", nil)
+			req, _ := http.NewRequest("GET", "/authenticated/products/list"+tc.givenQuery, nil)
 			router.ServeHTTP(w, req)
 
 			// Assertions
@@ -0,0 +1,24 @@
+package products
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// priceScale is the number of decimal places a price is stored with
+const priceScale = 2
+
+// parsePrice parses the given price exactly, rejecting fractions of a cent which can't be stored
+func parsePrice(s string) (decimal.Decimal, error) {
+	price, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	if !price.Equal(price.Round(priceScale)) {
+		return decimal.Decimal{}, errors.New("product price must have at most 2 decimal places")
+	}
+
+	return price, nil
+}
@@ -7,7 +7,7 @@ import (
 
 	"omg/api/internal/controller/products"
 	"omg/api/internal/model"
-	"omg/api/pkg/floatutil"
+	"omg/api/pkg/decimalutil"
 
 	"github.com/gin-gonic/gin"
 	pkgerrors "github.com/pkg/errors"
@@ -44,6 +44,7 @@ func (h *Handler) UpdateProduct(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	input.UpdatedBy = c.GetInt64("user_id")
 
 	p, err := h.controller.Update(c.Request.Context(), input)
 	if err != nil {
@@ -52,6 +53,8 @@ func (h *Handler) UpdateProduct(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "product not found"})
 		case errors.Is(err, products.ErrProductDeleted):
 			c.JSON(http.StatusBadRequest, gin.H{"error": "product deleted"})
+		case errors.Is(err, products.ErrInsufficientStock):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 		}
@@ -62,7 +65,7 @@ func (h *Handler) UpdateProduct(c *gin.Context) {
 		ID:          strconv.FormatInt(p.ID, 10),
 		Name:        p.Name,
 		Description: p.Description,
-		Price:       floatutil.FormatFloat(p.Price),
+		Price:       decimalutil.FormatDecimal(p.Price),
 		Stock:       strconv.FormatInt(p.Stock, 10),
 		Status:      p.Status.String(),
 	})
@@ -102,7 +105,7 @@ func validateAndMapUpdateInput(req updateProductRequest) (model.UpdateProductInp
 		return model.UpdateProductInput{}, errors.New("invalid product id")
 	}
 
-	price, err := strconv.ParseFloat(req.Price, 64)
+	price, err := parsePrice(req.Price)
 	if err != nil {
 		return model.UpdateProductInput{}, pkgerrors.WithStack(err)
 	}
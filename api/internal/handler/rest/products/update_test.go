@@ -13,6 +13,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -50,7 +51,7 @@ func TestHandler_UpdateProduct(t *testing.T) {
 					ID:          123,
 					Name:        "Test product",
 					Description: "Test description",
-					Price:       2000,
+					Price:       decimal.RequireFromString("2000"),
 					Stock:       100,
 					Status:      model.ProductStatusActive,
 				},
@@ -58,7 +59,7 @@ func TestHandler_UpdateProduct(t *testing.T) {
 					ID:          123,
 					Name:        "Test product",
 					Description: "Test description",
-					Price:       2000,
+					Price:       decimal.RequireFromString("2000"),
 					Stock:       100,
 					Status:      model.ProductStatusActive,
 				},
@@ -157,7 +158,7 @@ func TestHandler_UpdateProduct(t *testing.T) {
 					ID:          123,
 					Name:        "Test product",
 					Description: "Test description",
-					Price:       2000,
+					Price:       decimal.RequireFromString("2000"),
 					Stock:       100,
 					Status:      model.ProductStatusActive,
 				},
@@ -181,7 +182,7 @@ func TestHandler_UpdateProduct(t *testing.T) {
 					ID:          123,
 					Name:        "Test product",
 					Description: "Test description",
-					Price:       2000,
+					Price:       decimal.RequireFromString("2000"),
 					Stock:       100,
 					Status:      model.ProductStatusActive,
 				},
@@ -190,6 +191,30 @@ func TestHandler_UpdateProduct(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			expectedBody:   gin.H{"error": "product deleted"},
 		},
+		"stock_below_reserved": {
+			request: updateProductRequest{
+				ID:          "123",
+				Name:        "Test product",
+				Description: "Test description",
+				Price:       "2000",
+				Stock:       "1",
+				Status:      "ACTIVE",
+			},
+			mockUpdateCtrl: mockUpdateCtrl{
+				wantCall: true,
+				inp: model.UpdateProductInput{
+					ID:          123,
+					Name:        "Test product",
+					Description: "Test description",
+					Price:       decimal.RequireFromString("2000"),
+					Stock:       1,
+					Status:      model.ProductStatusActive,
+				},
+				err: products.ErrInsufficientStock,
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   gin.H{"error": "stock adjustment takes the stock below the reserved stock"},
+		},
 		"internal_server_error": {
 			request: updateProductRequest{
 				ID:          "123",
@@ -205,7 +230,7 @@ func TestHandler_UpdateProduct(t *testing.T) {
 					ID:          123,
 					Name:        "Test product",
 					Description: "Test description",
-					Price:       2000,
+					Price:       decimal.RequireFromString("2000"),
 					Stock:       100,
 					Status:      model.ProductStatusActive,
 				},
@@ -224,11 +249,16 @@ func TestHandler_UpdateProduct(t *testing.T) {
 
 			// Create a test router
 			router := gin.New()
-			router.PUT("/authenticated/products/update", handler.UpdateProduct)
+			router.PUT("/authenticated/products/update", func(c *gin.Context) {
+				c.Set("user_id", int64(7))
+			}, handler.UpdateProduct)
 
 			// Setup mock expectations
 			if tc.mockUpdateCtrl.wantCall {
-				mockCtrl.On("Update", mock.Anything, tc.mockUpdateCtrl.inp).Return(tc.mockUpdateCtrl.out, tc.mockUpdateCtrl.err)
+				// The caller is recorded as who updated the product
+				inp := tc.mockUpdateCtrl.inp
+				inp.UpdatedBy = 7
+				mockCtrl.On("Update", mock.Anything, inp).Return(tc.mockUpdateCtrl.out, tc.mockUpdateCtrl.err)
 			}
 
 			// Create test request
@@ -0,0 +1,15 @@
+package system
+
+import (
+	"omg/api/internal/controller/system"
+)
+
+type Handler struct {
+	controller system.Controller
+}
+
+func New(controller system.Controller) Handler {
+	return Handler{
+		controller: controller,
+	}
+}
@@ -0,0 +1,13 @@
+package system
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Liveness handles the liveness probe, which only tells the process is able to serve requests.
+// It does not check the dependencies, so an outage of the database does not get every replica restarted.
+func (h Handler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": statusUp})
+}
@@ -0,0 +1,52 @@
+package system
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	statusUp   = "UP"
+	statusDown = "DOWN"
+)
+
+type dependencyResponse struct {
+	Status string `json:"status"`
+}
+
+type readinessResponse struct {
+	Status       string                        `json:"status"`
+	Draining     bool                          `json:"draining"`
+	Dependencies map[string]dependencyResponse `json:"dependencies"`
+}
+
+// Readiness handles the readiness probe, reporting on each dependency. It fails with 503 while a dependency is
+// unhealthy or once the server is draining, so no new traffic is routed to it.
+// The errors are only logged, as the probe is reachable without authentication.
+func (h Handler) Readiness(c *gin.Context) {
+	readiness := h.controller.CheckReadiness(c.Request.Context())
+
+	response := readinessResponse{
+		Status:       statusUp,
+		Draining:     readiness.Draining,
+		Dependencies: map[string]dependencyResponse{},
+	}
+	for _, check := range readiness.Checks {
+		status := statusUp
+		if check.Err != nil {
+			log.Printf("Readiness check of %s failed: %v", check.Dependency, check.Err)
+			status = statusDown
+		}
+		response.Dependencies[check.Dependency.String()] = dependencyResponse{Status: status}
+	}
+
+	if !readiness.IsReady() {
+		response.Status = statusDown
+		c.JSON(http.StatusServiceUnavailable, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
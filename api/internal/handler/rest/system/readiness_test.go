@@ -0,0 +1,110 @@
+package system
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"omg/api/internal/controller/system"
+	"omg/api/internal/model"
+	"omg/api/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Readiness(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type arg struct {
+		mockOut        model.Readiness
+		expectedStatus int
+		expectedBody   interface{}
+	}
+
+	tcs := map[string]arg{
+		"ready": {
+			mockOut: model.Readiness{Checks: []model.DependencyCheck{
+				{Dependency: model.DependencyDB},
+				{Dependency: model.DependencyWSHub},
+			}},
+			expectedStatus: http.StatusOK,
+			expectedBody: gin.H{
+				"status":   "UP",
+				"draining": false,
+				"dependencies": gin.H{
+					"db":     gin.H{"status": "UP"},
+					"ws_hub": gin.H{"status": "UP"},
+				},
+			},
+		},
+		"db_down": {
+			mockOut: model.Readiness{Checks: []model.DependencyCheck{
+				{Dependency: model.DependencyDB, Err: errors.New("dial tcp 10.0.0.1:5432: connection refused")},
+				{Dependency: model.DependencyWSHub},
+			}},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody: gin.H{
+				"status":   "DOWN",
+				"draining": false,
+				"dependencies": gin.H{
+					"db":     gin.H{"status": "DOWN"},
+					"ws_hub": gin.H{"status": "UP"},
+				},
+			},
+		},
+		"draining": {
+			mockOut: model.Readiness{Draining: true, Checks: []model.DependencyCheck{
+				{Dependency: model.DependencyDB},
+				{Dependency: model.DependencyWSHub},
+			}},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody: gin.H{
+				"status":   "DOWN",
+				"draining": true,
+				"dependencies": gin.H{
+					"db":     gin.H{"status": "UP"},
+					"ws_hub": gin.H{"status": "UP"},
+				},
+			},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Setup
+			mockCtrl := system.NewMockController(t)
+			handler := New(mockCtrl)
+
+			router := gin.New()
+			router.GET("/_/ready", handler.Readiness)
+
+			mockCtrl.On("CheckReadiness", mock.Anything).Return(tc.mockOut)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/_/ready", nil)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.JSONEq(t, testutil.ToJSONString(tc.expectedBody), w.Body.String())
+		})
+	}
+}
+
+func TestHandler_Liveness(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := New(system.NewMockController(t))
+
+	router := gin.New()
+	router.GET("/_/live", handler.Liveness)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/_/live", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"status":"UP"}`, w.Body.String())
+}
@@ -1,7 +1,14 @@
 package users
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"omg/api/internal/controller/users"
+	"omg/api/internal/model"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,19 +20,42 @@ type getUserResponse struct {
 	Status string `json:"status"`
 }
 
+type listUsersResponse struct {
+	Users      []getUserResponse `json:"users"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	HasMore    bool              `json:"has_more"`
+}
+
+// List handles listing the users page by page for the back-office.
+// Supported query params: search (email or name substring), status (comma separated), from & to (RFC3339), cursor & limit.
 func (h *Handler) List(c *gin.Context) {
-	list, err := h.controller.GetUsers(c.Request.Context())
+	input, err := parseListUsersQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	list, err := h.controller.GetUsers(c.Request.Context(), input)
 	if err != nil {
 		switch {
+		case errors.Is(err, users.ErrInvalidUserStatus),
+			errors.Is(err, users.ErrInvalidDateRange),
+			errors.Is(err, users.ErrInvalidCursor),
+			errors.Is(err, users.ErrInvalidLimit):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		default:
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 		}
 		return
 	}
 
-	var response []getUserResponse
-	for _, user := range list {
-		response = append(response, getUserResponse{
+	response := listUsersResponse{
+		Users:      []getUserResponse{},
+		NextCursor: list.NextCursor,
+		HasMore:    list.HasMore(),
+	}
+	for _, user := range list.Users {
+		response.Users = append(response.Users, getUserResponse{
 			ID:     user.ID,
 			Name:   user.Name,
 			Email:  user.Email,
@@ -35,3 +65,46 @@ func (h *Handler) List(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+func parseListUsersQuery(c *gin.Context) (model.ListUsersInput, error) {
+	input := model.ListUsersInput{
+		Search: strings.TrimSpace(c.Query("search")),
+		Cursor: c.Query("cursor"),
+	}
+
+	if v := c.Query("status"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			status := model.UserStatus(strings.ToUpper(strings.TrimSpace(s)))
+			if !status.IsValid() {
+				return model.ListUsersInput{}, users.ErrInvalidUserStatus
+			}
+			input.Status = append(input.Status, status)
+		}
+	}
+
+	if v := c.Query("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return model.ListUsersInput{}, errors.New("invalid from date format")
+		}
+		input.CreatedFrom = from
+	}
+
+	if v := c.Query("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return model.ListUsersInput{}, errors.New("invalid to date format")
+		}
+		input.CreatedTo = to
+	}
+
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return model.ListUsersInput{}, users.ErrInvalidLimit
+		}
+		input.Limit = limit
+	}
+
+	return input, nil
+}
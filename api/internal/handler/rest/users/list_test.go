@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"omg/api/internal/controller/users"
 	"omg/api/internal/model"
@@ -20,11 +21,13 @@ func TestHandler_List(t *testing.T) {
 
 	type mockGetUsersCtrl struct {
 		wantCall bool
-		out      []model.User
+		input    model.ListUsersInput
+		out      model.UserList
 		err      error
 	}
 
 	type arg struct {
+		givenQuery       string
 		mockGetUsersCtrl mockGetUsersCtrl
 		expectedStatus   int
 		expectedBody     interface{}
@@ -34,50 +37,102 @@ func TestHandler_List(t *testing.T) {
 		"successful_retrieval": {
 			mockGetUsersCtrl: mockGetUsersCtrl{
 				wantCall: true,
-				out: []model.User{
+				out: model.UserList{
+					Users: []model.User{
+						{
+							ID:     1,
+							Name:   "User 1",
+							Email:  "user1@example.com",
+							Status: model.UserStatusActive,
+						},
+						{
+							ID:     2,
+							Name:   "User 2",
+							Email:  "user2@example.com",
+							Status: model.UserStatusDeleted,
+						},
+					},
+					NextCursor: "MTIzOjI",
+				},
+				err: nil,
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: listUsersResponse{
+				Users: []getUserResponse{
 					{
 						ID:     1,
 						Name:   "User 1",
 						Email:  "user1@example.com",
-						Status: model.UserStatusActive,
+						Status: model.UserStatusActive.String(),
 					},
 					{
 						ID:     2,
 						Name:   "User 2",
 						Email:  "user2@example.com",
-						Status: model.UserStatusDeleted,
+						Status: model.UserStatusDeleted.String(),
 					},
 				},
-				err: nil,
+				NextCursor: "MTIzOjI",
+				HasMore:    true,
 			},
-			expectedStatus: http.StatusOK,
-			expectedBody: []getUserResponse{
-				{
-					ID:     1,
-					Name:   "User 1",
-					Email:  "user1@example.com",
-					Status: model.UserStatusActive.String(),
-				},
-				{
-					ID:     2,
-					Name:   "User 2",
-					Email:  "user2@example.com",
-					Status: model.UserStatusDeleted.String(),
+		},
+		"successful_retrieval_with_filters": {
+			givenQuery: "?search=smith&status=active,deleted&from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z&cursor=MTIzOjI&limit=10",
+			mockGetUsersCtrl: mockGetUsersCtrl{
+				wantCall: true,
+				input: model.ListUsersInput{
+					Search:      "smith",
+					Status:      []model.UserStatus{model.UserStatusActive, model.UserStatusDeleted},
+					CreatedFrom: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					CreatedTo:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+					Cursor:      "MTIzOjI",
+					Limit:       10,
 				},
 			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   gin.H{"users": []interface{}{}, "has_more": false},
 		},
 		"empty_list": {
 			mockGetUsersCtrl: mockGetUsersCtrl{
 				wantCall: true,
-				out:      []model.User{},
 				err:      nil,
 			},
 			expectedStatus: http.StatusOK,
+			expectedBody:   gin.H{"users": []interface{}{}, "has_more": false},
+		},
+		"invalid_status": {
+			givenQuery:     "?status=unknown",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid user status"},
+		},
+		"invalid_from": {
+			givenQuery:     "?from=yesterday",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid from date format"},
+		},
+		"invalid_to": {
+			givenQuery:     "?to=tomorrow",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid to date format"},
+		},
+		"invalid_limit": {
+			givenQuery:     "?limit=ten",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid limit"},
+		},
+		"invalid_cursor": {
+			givenQuery: "?cursor=abc",
+			mockGetUsersCtrl: mockGetUsersCtrl{
+				wantCall: true,
+				input:    model.ListUsersInput{Cursor: "abc"},
+				err:      users.ErrInvalidCursor,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid cursor"},
 		},
 		"internal_server_error": {
 			mockGetUsersCtrl: mockGetUsersCtrl{
 				wantCall: true,
-				out:      nil,
 				err:      errors.New("database error"),
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -97,12 +152,12 @@ func TestHandler_List(t *testing.T) {
 
 			// Setup mock expectations
 			if tc.mockGetUsersCtrl.wantCall {
-				mockCtrl.On("GetUsers", mock.Anything).Return(tc.mockGetUsersCtrl.out, tc.mockGetUsersCtrl.err)
+				mockCtrl.On("GetUsers", mock.Anything, tc.mockGetUsersCtrl.input).Return(tc.mockGetUsersCtrl.out, tc.mockGetUsersCtrl.err)
 			}
 
 			// Create test request
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequest("GET", "/authenticated/users", nil)
+			req, _ := http.NewRequest("GET", "/authenticated/users"+tc.givenQuery, nil)
 			router.ServeHTTP(w, req)
 
 			// Assertions
@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+
+	"omg/api/internal/controller/webhooks"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+type createRequest struct {
+	URL        string   `json:"url" binding:"required"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types" binding:"required"`
+}
+
+type createResponse struct {
+	endpointResponse
+	// The secret is only ever shown on creation, the partner needs it to verify the signatures
+	Secret string `json:"secret"`
+}
+
+// Create handles registering a webhook endpoint for a partner
+func (h *Handler) Create(c *gin.Context) {
+	var req createRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	input := model.CreateWebhookEndpointInput{
+		URL:    req.URL,
+		Secret: req.Secret,
+	}
+	for _, t := range req.EventTypes {
+		input.EventTypes = append(input.EventTypes, model.EventType(t))
+	}
+
+	endpoint, err := h.controller.CreateEndpoint(c.Request.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhooks.ErrInvalidURL),
+			errors.Is(err, webhooks.ErrInvalidSecret),
+			errors.Is(err, webhooks.ErrInvalidEventType),
+			errors.Is(err, webhooks.ErrNoEventTypes):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, createResponse{
+		endpointResponse: toEndpointResponse(endpoint),
+		Secret:           endpoint.Secret,
+	})
+}
@@ -0,0 +1,121 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/webhooks"
+	"omg/api/internal/model"
+	"omg/api/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Create(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	type mockCreateCtrl struct {
+		wantCall bool
+		input    model.CreateWebhookEndpointInput
+		out      model.WebhookEndpoint
+		err      error
+	}
+
+	type arg struct {
+		body           string
+		mockCreateCtrl mockCreateCtrl
+		expectedStatus int
+		expectedBody   interface{}
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			body: `{"url":"https://partner.example.com/hooks","event_types":["order.created"]}`,
+			mockCreateCtrl: mockCreateCtrl{
+				wantCall: true,
+				input: model.CreateWebhookEndpointInput{
+					URL:        "https://partner.example.com/hooks",
+					EventTypes: []model.EventType{model.EventTypeOrderCreated},
+				},
+				out: model.WebhookEndpoint{
+					ID:         1,
+					URL:        "https://partner.example.com/hooks",
+					Secret:     "generated",
+					EventTypes: []model.EventType{model.EventTypeOrderCreated},
+					Status:     model.WebhookEndpointStatusActive,
+					CreatedAt:  createdAt,
+				},
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: gin.H{
+				"id":          "1",
+				"url":         "https://partner.example.com/hooks",
+				"secret":      "generated",
+				"event_types": []string{"order.created"},
+				"status":      "ACTIVE",
+				"created_at":  "2024-05-01T10:00:00Z",
+			},
+		},
+		"missing_url": {
+			body:           `{"event_types":["order.created"]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "Key: 'createRequest.URL' Error:Field validation for 'URL' failed on the 'required' tag"},
+		},
+		"invalid_event_type": {
+			body: `{"url":"https://partner.example.com/hooks","event_types":["user.created"]}`,
+			mockCreateCtrl: mockCreateCtrl{
+				wantCall: true,
+				input: model.CreateWebhookEndpointInput{
+					URL:        "https://partner.example.com/hooks",
+					EventTypes: []model.EventType{"user.created"},
+				},
+				err: webhooks.ErrInvalidEventType,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": webhooks.ErrInvalidEventType.Error()},
+		},
+		"internal_server_error": {
+			body: `{"url":"https://partner.example.com/hooks","event_types":["order.created"]}`,
+			mockCreateCtrl: mockCreateCtrl{
+				wantCall: true,
+				input: model.CreateWebhookEndpointInput{
+					URL:        "https://partner.example.com/hooks",
+					EventTypes: []model.EventType{model.EventTypeOrderCreated},
+				},
+				err: errors.New("database error"),
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   gin.H{"error": "internal server error"},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Setup
+			mockCtrl := webhooks.NewMockController(t)
+			handler := New(mockCtrl)
+
+			router := gin.New()
+			router.POST("/webhooks/create", handler.Create)
+
+			if tc.mockCreateCtrl.wantCall {
+				mockCtrl.On("CreateEndpoint", mock.Anything, tc.mockCreateCtrl.input).Return(tc.mockCreateCtrl.out, tc.mockCreateCtrl.err)
+			}
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/webhooks/create", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.JSONEq(t, testutil.ToJSONString(tc.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"omg/api/internal/controller/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Delete handles disabling a webhook endpoint
+func (h *Handler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook endpoint ID format"})
+		return
+	}
+
+	if err = h.controller.DeleteEndpoint(c.Request.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, webhooks.ErrEndpointNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "webhook endpoint not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, "Delete webhook endpoint successfully")
+}
@@ -0,0 +1,77 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"omg/api/internal/controller/webhooks"
+	"omg/api/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Delete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type arg struct {
+		id             string
+		wantCall       bool
+		mockErr        error
+		expectedStatus int
+		expectedBody   interface{}
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			id:             "123",
+			wantCall:       true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "Delete webhook endpoint successfully",
+		},
+		"invalid_id_format": {
+			id:             "abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid webhook endpoint ID format"},
+		},
+		"not_found": {
+			id:             "123",
+			wantCall:       true,
+			mockErr:        webhooks.ErrEndpointNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   gin.H{"error": "webhook endpoint not found"},
+		},
+		"internal_server_error": {
+			id:             "123",
+			wantCall:       true,
+			mockErr:        errors.New("database error"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   gin.H{"error": "internal server error"},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Setup
+			mockCtrl := webhooks.NewMockController(t)
+			handler := New(mockCtrl)
+
+			router := gin.New()
+			router.POST("/webhooks/delete/:id", handler.Delete)
+
+			if tc.wantCall {
+				mockCtrl.On("DeleteEndpoint", mock.Anything, int64(123)).Return(tc.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/webhooks/delete/"+tc.id, nil)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.JSONEq(t, testutil.ToJSONString(tc.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package webhooks
+
+import (
+	"omg/api/internal/controller/webhooks"
+)
+
+type Handler struct {
+	controller webhooks.Controller
+}
+
+func New(controller webhooks.Controller) Handler {
+	return Handler{
+		controller: controller,
+	}
+}
@@ -0,0 +1,27 @@
+package webhooks
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type listResponse struct {
+	Endpoints []endpointResponse `json:"endpoints"`
+}
+
+// List handles listing the webhook endpoints, without their secrets
+func (h *Handler) List(c *gin.Context) {
+	endpoints, err := h.controller.ListEndpoints(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	response := listResponse{Endpoints: []endpointResponse{}}
+	for _, e := range endpoints {
+		response.Endpoints = append(response.Endpoints, toEndpointResponse(e))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,87 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"omg/api/internal/controller/webhooks"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+type listDeliveriesResponse struct {
+	Deliveries []deliveryResponse `json:"deliveries"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+	HasMore    bool               `json:"has_more"`
+}
+
+// ListDeliveries handles listing the webhook deliveries page by page, e.g. the dead ones to redeliver.
+// Supported query params: endpoint_id, status (comma separated), cursor & limit.
+func (h *Handler) ListDeliveries(c *gin.Context) {
+	input, err := parseListDeliveriesQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	list, err := h.controller.ListDeliveries(c.Request.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhooks.ErrInvalidDeliveryStatus),
+			errors.Is(err, webhooks.ErrInvalidCursor),
+			errors.Is(err, webhooks.ErrInvalidLimit):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		}
+		return
+	}
+
+	response := listDeliveriesResponse{
+		Deliveries: []deliveryResponse{},
+		NextCursor: list.NextCursor,
+		HasMore:    list.HasMore(),
+	}
+	for _, d := range list.Deliveries {
+		response.Deliveries = append(response.Deliveries, toDeliveryResponse(d))
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func parseListDeliveriesQuery(c *gin.Context) (model.ListWebhookDeliveriesInput, error) {
+	input := model.ListWebhookDeliveriesInput{
+		Cursor: c.Query("cursor"),
+	}
+
+	if v := c.Query("endpoint_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return model.ListWebhookDeliveriesInput{}, errors.New("invalid webhook endpoint ID format")
+		}
+		input.EndpointID = id
+	}
+
+	if v := c.Query("status"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			status := model.WebhookDeliveryStatus(strings.ToUpper(strings.TrimSpace(s)))
+			if !status.IsValid() {
+				return model.ListWebhookDeliveriesInput{}, webhooks.ErrInvalidDeliveryStatus
+			}
+			input.Status = append(input.Status, status)
+		}
+	}
+
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return model.ListWebhookDeliveriesInput{}, webhooks.ErrInvalidLimit
+		}
+		input.Limit = limit
+	}
+
+	return input, nil
+}
@@ -0,0 +1,136 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/webhooks"
+	"omg/api/internal/model"
+	"omg/api/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ListDeliveries(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	type mockListCtrl struct {
+		wantCall bool
+		input    model.ListWebhookDeliveriesInput
+		out      model.WebhookDeliveryList
+		err      error
+	}
+
+	type arg struct {
+		query          string
+		mockListCtrl   mockListCtrl
+		expectedStatus int
+		expectedBody   interface{}
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			query: "?endpoint_id=9&status=dead&limit=1",
+			mockListCtrl: mockListCtrl{
+				wantCall: true,
+				input: model.ListWebhookDeliveriesInput{
+					EndpointID: 9,
+					Status:     []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusDead},
+					Limit:      1,
+				},
+				out: model.WebhookDeliveryList{
+					Deliveries: []model.WebhookDelivery{{
+						ID:             1,
+						EndpointID:     9,
+						EventID:        7,
+						EventType:      model.EventTypeOrderCreated,
+						Payload:        []byte(`{"id":"7"}`),
+						Status:         model.WebhookDeliveryStatusDead,
+						Attempts:       13,
+						LastError:      "unexpected status code 500",
+						LastStatusCode: 500,
+						NextAttemptAt:  at,
+						CreatedAt:      at,
+						UpdatedAt:      at,
+					}},
+					NextCursor: "next",
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: gin.H{
+				"deliveries": []gin.H{{
+					"id":               "1",
+					"endpoint_id":      "9",
+					"event_id":         "7",
+					"event_type":       "order.created",
+					"payload":          gin.H{"id": "7"},
+					"status":           "DEAD",
+					"attempts":         13,
+					"last_error":       "unexpected status code 500",
+					"last_status_code": 500,
+					"next_attempt_at":  "2024-05-01T10:00:00Z",
+					"created_at":       "2024-05-01T10:00:00Z",
+					"updated_at":       "2024-05-01T10:00:00Z",
+				}},
+				"next_cursor": "next",
+				"has_more":    true,
+			},
+		},
+		"invalid_status": {
+			query:          "?status=lost",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": webhooks.ErrInvalidDeliveryStatus.Error()},
+		},
+		"invalid_endpoint_id": {
+			query:          "?endpoint_id=abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid webhook endpoint ID format"},
+		},
+		"invalid_cursor": {
+			query: "?cursor=bad",
+			mockListCtrl: mockListCtrl{
+				wantCall: true,
+				input:    model.ListWebhookDeliveriesInput{Cursor: "bad"},
+				err:      webhooks.ErrInvalidCursor,
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": webhooks.ErrInvalidCursor.Error()},
+		},
+		"internal_server_error": {
+			mockListCtrl: mockListCtrl{
+				wantCall: true,
+				err:      errors.New("database error"),
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   gin.H{"error": "internal server error"},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Setup
+			mockCtrl := webhooks.NewMockController(t)
+			handler := New(mockCtrl)
+
+			router := gin.New()
+			router.GET("/webhooks/deliveries/list", handler.ListDeliveries)
+
+			if tc.mockListCtrl.wantCall {
+				mockCtrl.On("ListDeliveries", mock.Anything, tc.mockListCtrl.input).Return(tc.mockListCtrl.out, tc.mockListCtrl.err)
+			}
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/webhooks/deliveries/list"+tc.query, nil)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.JSONEq(t, testutil.ToJSONString(tc.expectedBody), w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/webhooks"
+	"omg/api/internal/model"
+	"omg/api/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_List(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	type arg struct {
+		mockOut        []model.WebhookEndpoint
+		mockErr        error
+		expectedStatus int
+		expectedBody   interface{}
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			mockOut: []model.WebhookEndpoint{{
+				ID:         1,
+				URL:        "https://partner.example.com/hooks",
+				Secret:     "not-shown",
+				EventTypes: []model.EventType{model.EventTypeOrderStatusChanged},
+				Status:     model.WebhookEndpointStatusActive,
+				CreatedAt:  createdAt,
+			}},
+			expectedStatus: http.StatusOK,
+			expectedBody: gin.H{"endpoints": []gin.H{{
+				"id":          "1",
+				"url":         "https://partner.example.com/hooks",
+				"event_types": []string{"order.status_changed"},
+				"status":      "ACTIVE",
+				"created_at":  "2024-05-01T10:00:00Z",
+			}}},
+		},
+		"empty": {
+			expectedStatus: http.StatusOK,
+			expectedBody:   gin.H{"endpoints": []gin.H{}},
+		},
+		"internal_server_error": {
+			mockErr:        errors.New("database error"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   gin.H{"error": "internal server error"},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Setup
+			mockCtrl := webhooks.NewMockController(t)
+			handler := New(mockCtrl)
+
+			router := gin.New()
+			router.GET("/webhooks/list", handler.List)
+
+			mockCtrl.On("ListEndpoints", mock.Anything).Return(tc.mockOut, tc.mockErr)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/webhooks/list", nil)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.JSONEq(t, testutil.ToJSONString(tc.expectedBody), w.Body.String())
+		})
+	}
+}
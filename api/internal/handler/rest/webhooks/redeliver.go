@@ -0,0 +1,36 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"omg/api/internal/controller/webhooks"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Redeliver handles queueing a dead webhook delivery again
+func (h *Handler) Redeliver(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook delivery ID format"})
+		return
+	}
+
+	d, err := h.controller.Redeliver(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, webhooks.ErrDeliveryNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, webhooks.ErrDeliveryNotDead),
+			errors.Is(err, webhooks.ErrEndpointDisabled):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toDeliveryResponse(d))
+}
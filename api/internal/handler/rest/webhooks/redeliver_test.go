@@ -0,0 +1,110 @@
+package webhooks
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/webhooks"
+	"omg/api/internal/model"
+	"omg/api/pkg/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Redeliver(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	type arg struct {
+		id             string
+		wantCall       bool
+		mockOut        model.WebhookDelivery
+		mockErr        error
+		expectedStatus int
+		expectedBody   interface{}
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			id:       "1",
+			wantCall: true,
+			mockOut: model.WebhookDelivery{
+				ID:            1,
+				EndpointID:    9,
+				EventID:       7,
+				EventType:     model.EventTypeOrderCreated,
+				Payload:       []byte(`{"id":"7"}`),
+				Status:        model.WebhookDeliveryStatusPending,
+				NextAttemptAt: at,
+				CreatedAt:     at,
+				UpdatedAt:     at,
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: gin.H{
+				"id":              "1",
+				"endpoint_id":     "9",
+				"event_id":        "7",
+				"event_type":      "order.created",
+				"payload":         gin.H{"id": "7"},
+				"status":          "PENDING",
+				"attempts":        0,
+				"next_attempt_at": "2024-05-01T10:00:00Z",
+				"created_at":      "2024-05-01T10:00:00Z",
+				"updated_at":      "2024-05-01T10:00:00Z",
+			},
+		},
+		"invalid_id_format": {
+			id:             "abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   gin.H{"error": "invalid webhook delivery ID format"},
+		},
+		"not_found": {
+			id:             "1",
+			wantCall:       true,
+			mockErr:        webhooks.ErrDeliveryNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   gin.H{"error": webhooks.ErrDeliveryNotFound.Error()},
+		},
+		"not_dead": {
+			id:             "1",
+			wantCall:       true,
+			mockErr:        webhooks.ErrDeliveryNotDead,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   gin.H{"error": webhooks.ErrDeliveryNotDead.Error()},
+		},
+		"internal_server_error": {
+			id:             "1",
+			wantCall:       true,
+			mockErr:        errors.New("database error"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   gin.H{"error": "internal server error"},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Setup
+			mockCtrl := webhooks.NewMockController(t)
+			handler := New(mockCtrl)
+
+			router := gin.New()
+			router.POST("/webhooks/deliveries/redeliver/:id", handler.Redeliver)
+
+			if tc.wantCall {
+				mockCtrl.On("Redeliver", mock.Anything, int64(1)).Return(tc.mockOut, tc.mockErr)
+			}
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/webhooks/deliveries/redeliver/"+tc.id, nil)
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			require.JSONEq(t, testutil.ToJSONString(tc.expectedBody), w.Body.String())
+		})
+	}
+}
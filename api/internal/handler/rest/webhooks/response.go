@@ -0,0 +1,64 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"omg/api/internal/model"
+)
+
+type endpointResponse struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func toEndpointResponse(e model.WebhookEndpoint) endpointResponse {
+	eventTypes := make([]string, len(e.EventTypes))
+	for idx, t := range e.EventTypes {
+		eventTypes[idx] = t.String()
+	}
+
+	return endpointResponse{
+		ID:         strconv.FormatInt(e.ID, 10),
+		URL:        e.URL,
+		EventTypes: eventTypes,
+		Status:     e.Status.String(),
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+type deliveryResponse struct {
+	ID             string          `json:"id"`
+	EndpointID     string          `json:"endpoint_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	LastError      string          `json:"last_error,omitempty"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+func toDeliveryResponse(d model.WebhookDelivery) deliveryResponse {
+	return deliveryResponse{
+		ID:             strconv.FormatInt(d.ID, 10),
+		EndpointID:     strconv.FormatInt(d.EndpointID, 10),
+		EventID:        strconv.FormatInt(d.EventID, 10),
+		EventType:      d.EventType.String(),
+		Payload:        d.Payload,
+		Status:         d.Status.String(),
+		Attempts:       d.Attempts,
+		LastError:      d.LastError,
+		LastStatusCode: d.LastStatusCode,
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
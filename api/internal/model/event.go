@@ -0,0 +1,139 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// EventType represents the type of the domain event
+type EventType string
+
+const (
+	// EventTypeOrderCreated means an order got created
+	EventTypeOrderCreated EventType = "order.created"
+	// EventTypeOrderStatusChanged means the status of an order got changed
+	EventTypeOrderStatusChanged EventType = "order.status_changed"
+	// EventTypeProductStockChanged means the stock of a product got changed
+	EventTypeProductStockChanged EventType = "product.stock_changed"
+)
+
+// String converts to string value
+func (e EventType) String() string {
+	return string(e)
+}
+
+// IsValid checks if event type is valid
+func (e EventType) IsValid() bool {
+	switch e {
+	case EventTypeOrderCreated, EventTypeOrderStatusChanged, EventTypeProductStockChanged:
+		return true
+	}
+	return false
+}
+
+// IsOrderEvent checks if the event is about the lifecycle of an order
+func (e EventType) IsOrderEvent() bool {
+	switch e {
+	case EventTypeOrderCreated, EventTypeOrderStatusChanged:
+		return true
+	}
+	return false
+}
+
+// EventStatus represents the publishing status of the event in the outbox
+type EventStatus string
+
+const (
+	// EventStatusPending means the event is waiting to be published
+	EventStatusPending EventStatus = "PENDING"
+	// EventStatusPublished means the event got published to all the sinks
+	EventStatusPublished EventStatus = "PUBLISHED"
+	// EventStatusFailed means publishing the event was given up after too many attempts
+	EventStatusFailed EventStatus = "FAILED"
+)
+
+// String converts to string value
+func (e EventStatus) String() string {
+	return string(e)
+}
+
+// Event represents a domain event recorded in the outbox, in the same tx as the change it describes
+type Event struct {
+	ID          int64
+	Type        EventType
+	AggregateID int64
+	// UserID is the owner of the aggregate, 0 when it has none
+	UserID    int64
+	Payload   json.RawMessage
+	Status    EventStatus
+	Attempts  int
+	LastError string
+	// PublishedSeq orders the published events as they got committed, 0 until published
+	PublishedSeq int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// PublishedEventFilter selects the published events to replay
+type PublishedEventFilter struct {
+	Types []EventType
+	// AggregateID & UserID are ignored when 0
+	AggregateID int64
+	UserID      int64
+}
+
+// OrderEventPayload is the payload of the order events
+type OrderEventPayload struct {
+	OrderID   int64           `json:"order_id,string"`
+	UserID    int64           `json:"user_id,string"`
+	Status    OrderStatus     `json:"status"`
+	TotalCost decimal.Decimal `json:"total_cost"`
+}
+
+// NewOrderEvent builds the event of the given type for the order
+func NewOrderEvent(eventType EventType, order Order) (Event, error) {
+	payload, err := json.Marshal(OrderEventPayload{
+		OrderID:   order.ID,
+		UserID:    order.UserID,
+		Status:    order.Status,
+		TotalCost: order.TotalCost,
+	})
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		Type:        eventType,
+		AggregateID: order.ID,
+		UserID:      order.UserID,
+		Payload:     payload,
+		Status:      EventStatusPending,
+	}, nil
+}
+
+// ProductStockEventPayload is the payload of the product stock events
+type ProductStockEventPayload struct {
+	ProductID int64 `json:"product_id,string"`
+	// Stock is the stock available to order, so reservations of pending orders are already taken out
+	Stock int64 `json:"stock,string"`
+}
+
+// NewProductStockEvent builds the stock changed event for the product
+func NewProductStockEvent(product Product) (Event, error) {
+	payload, err := json.Marshal(ProductStockEventPayload{
+		ProductID: product.ID,
+		Stock:     product.AvailableStock(),
+	})
+	if err != nil {
+		return Event{}, err
+	}
+
+	return Event{
+		Type:        EventTypeProductStockChanged,
+		AggregateID: product.ID,
+		Payload:     payload,
+		Status:      EventStatusPending,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// IdempotencyKeyRetention is how long a key is remembered, retries after that are handled as new requests
+const IdempotencyKeyRetention = 24 * time.Hour
+
+// IdempotencyKey represents a key a client sent along a request it may retry, with the response to replay
+type IdempotencyKey struct {
+	UserID      int64
+	Key         string
+	RequestHash string
+	Response    json.RawMessage
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
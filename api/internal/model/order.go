@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 // OrderStatus represents the status of the order
 type OrderStatus string
@@ -38,12 +42,47 @@ func (p OrderStatus) IsValid() bool {
 	return false
 }
 
+// orderStatusTransitions declares which statuses an order may move to from its current status.
+// Statuses without an entry are terminal.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:    {OrderStatusPaid, OrderStatusCancelled, OrderStatusFailed},
+	OrderStatusPaid:       {OrderStatusProcessing, OrderStatusCancelled, OrderStatusRefunded},
+	OrderStatusProcessing: {OrderStatusShipped, OrderStatusCancelled, OrderStatusRefunded},
+	OrderStatusShipped:    {OrderStatusDelivered},
+	OrderStatusDelivered:  {OrderStatusRefunded},
+}
+
+// CanTransitionTo checks if an order in this status is allowed to move to the next status
+func (p OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, s := range orderStatusTransitions[p] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// CanBeSetBy checks if a user of the role may move an order to this status. Customers may only cancel their
+// orders, the payment & fulfilment statuses are set by the back office.
+func (p OrderStatus) CanBeSetBy(role UserRole) bool {
+	return role.IsBackOffice() || p == OrderStatusCancelled
+}
+
+// ReleasesStock checks if moving an order into this status gives its items' stock back to the products
+func (p OrderStatus) ReleasesStock() bool {
+	switch p {
+	case OrderStatusCancelled, OrderStatusFailed, OrderStatusRefunded:
+		return true
+	}
+	return false
+}
+
 // Order represents the Order
 type Order struct {
 	ID         int64
 	UserID     int64
 	Status     OrderStatus
-	TotalCost  float64
+	TotalCost  decimal.Decimal
 	OrderItems []OrderItem
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
@@ -53,9 +92,21 @@ type Order struct {
 type CreateOrderInput struct {
 	UserID int64
 	Items  []CreateOrderItemInput
+	// IdempotencyKey optionally identifies the request so that its retries do not create the order again
+	IdempotencyKey string
+	// RequestedBy is the user the idempotency key belongs to, who may place the order for another user
+	RequestedBy int64
 }
 
 type CreateOrderItemInput struct {
 	ProductID int64
 	Quantity  int64
 }
+
+// ListOrdersInput represents the input when list the orders of a user
+type ListOrdersInput struct {
+	UserID      int64
+	Status      []OrderStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
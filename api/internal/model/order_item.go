@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 // OrderItem represents the order item
 type OrderItem struct {
@@ -8,7 +12,7 @@ type OrderItem struct {
 	OrderID   int64
 	ProductID int64
 	Quantity  int64
-	Price     float64
+	Price     decimal.Decimal
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
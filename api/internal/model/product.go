@@ -2,6 +2,8 @@ package model
 
 import (
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // ProductStatus represents the status of the product
@@ -34,18 +36,30 @@ type Product struct {
 	Name        string
 	Description string
 	Status      ProductStatus
-	Price       float64
+	Price       decimal.Decimal
 	Stock       int64
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// Reserved is the part of the stock held for pending orders
+	Reserved  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AvailableStock is the stock on hand which is not held for pending orders
+func (p Product) AvailableStock() int64 {
+	if p.Reserved >= p.Stock {
+		return 0
+	}
+	return p.Stock - p.Reserved
 }
 
 // CreateProductInput holds input params for creating the product
 type CreateProductInput struct {
 	Name  string
 	Desc  string
-	Price float64
+	Price decimal.Decimal
 	Stock int64
+	// CreatedBy is the user creating the product, recorded in the stock ledger
+	CreatedBy int64
 }
 
 // UpdateProductInput holds input params for updating the product
@@ -53,7 +67,26 @@ type UpdateProductInput struct {
 	ID          int64
 	Name        string
 	Description string
-	Price       float64
+	Price       decimal.Decimal
 	Stock       int64
 	Status      ProductStatus
+	// UpdatedBy is the user updating the product, recorded in the stock ledger
+	UpdatedBy int64
+}
+
+// ListProductsInput holds input params for listing the products
+type ListProductsInput struct {
+	Status     []ProductStatus
+	MinPrice   decimal.NullDecimal
+	MaxPrice   decimal.NullDecimal
+	InStock    bool
+	NamePrefix string
+	Cursor     string
+	Limit      int
+}
+
+// ProductList represents a page of products & the cursor to get the next page with
+type ProductList struct {
+	Products   []Product
+	NextCursor string
 }
@@ -0,0 +1,79 @@
+package model
+
+// ProductImportAction represents what a row of the product import does
+type ProductImportAction string
+
+const (
+	// ProductImportActionCreate means the row creates a new product
+	ProductImportActionCreate ProductImportAction = "CREATE"
+	// ProductImportActionAdjust means the row adjusts the stock on hand of an existing product
+	ProductImportActionAdjust ProductImportAction = "ADJUST"
+)
+
+// String converts to string value
+func (a ProductImportAction) String() string {
+	return string(a)
+}
+
+// IsValid checks if the product import action is valid
+func (a ProductImportAction) IsValid() bool {
+	switch a {
+	case ProductImportActionCreate, ProductImportActionAdjust:
+		return true
+	}
+	return false
+}
+
+// ProductImportRow is a single product create or stock adjustment of the import
+type ProductImportRow struct {
+	// Line is the position of the row in the imported document, used to report its errors
+	Line   int
+	Action ProductImportAction
+	// Create holds the product to create for the CREATE rows
+	Create CreateProductInput
+	// ProductID & Delta hold the stock adjustment for the ADJUST rows
+	ProductID int64
+	Delta     int64
+}
+
+// ImportProductsInput holds input params for importing the products in bulk
+type ImportProductsInput struct {
+	Rows []ProductImportRow
+	// Partial applies every row on its own instead of all or none of them
+	Partial bool
+	// ImportedBy is the user importing the products, recorded in the stock ledger
+	ImportedBy int64
+}
+
+// ProductImportRowStatus represents the outcome of a row of the product import
+type ProductImportRowStatus string
+
+const (
+	// ProductImportRowStatusApplied means the row is applied
+	ProductImportRowStatusApplied ProductImportRowStatus = "APPLIED"
+	// ProductImportRowStatusFailed means the row is invalid or failed to apply
+	ProductImportRowStatusFailed ProductImportRowStatus = "FAILED"
+	// ProductImportRowStatusSkipped means the row is not applied because another row of the import failed
+	ProductImportRowStatusSkipped ProductImportRowStatus = "SKIPPED"
+)
+
+// String converts to string value
+func (s ProductImportRowStatus) String() string {
+	return string(s)
+}
+
+// ProductImportRowResult is the outcome of a single row of the product import
+type ProductImportRowResult struct {
+	Line   int
+	Status ProductImportRowStatus
+	// ProductID is the product created or adjusted by the row
+	ProductID int64
+	Err       error
+}
+
+// ProductImportResult is the outcome of the product import, row by row
+type ProductImportResult struct {
+	Rows    []ProductImportRowResult
+	Applied int
+	Failed  int
+}
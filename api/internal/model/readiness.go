@@ -0,0 +1,43 @@
+package model
+
+// Dependency names a dependency the system needs to serve traffic
+type Dependency string
+
+const (
+	// DependencyDB is the Postgres database
+	DependencyDB Dependency = "db"
+	// DependencyWSHub is the WebSocket hub delivering the real-time events
+	DependencyWSHub Dependency = "ws_hub"
+)
+
+// String converts to string value
+func (d Dependency) String() string {
+	return string(d)
+}
+
+// DependencyCheck is the outcome of checking a single dependency
+type DependencyCheck struct {
+	Dependency Dependency
+	// Err is nil when the dependency is healthy
+	Err error
+}
+
+// Readiness reports whether the system is ready to serve traffic, per dependency
+type Readiness struct {
+	// Draining is set once the system is shutting down, failing the readiness so no new traffic is routed to it
+	Draining bool
+	Checks   []DependencyCheck
+}
+
+// IsReady checks if the system is not draining & all its dependencies are healthy
+func (r Readiness) IsReady() bool {
+	if r.Draining {
+		return false
+	}
+	for _, c := range r.Checks {
+		if c.Err != nil {
+			return false
+		}
+	}
+	return true
+}
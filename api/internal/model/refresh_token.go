@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// RefreshToken represents an issued refresh token.
+// Tokens rotated from the same login share the FamilyID.
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	FamilyID  int64
+	Revoked   bool
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
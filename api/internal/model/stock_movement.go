@@ -0,0 +1,53 @@
+package model
+
+import "time"
+
+// StockMovementReason represents why the stock on hand of a product moved
+type StockMovementReason string
+
+const (
+	// StockMovementReasonInitial means the product got created with stock
+	StockMovementReasonInitial StockMovementReason = "INITIAL"
+	// StockMovementReasonAdjustment means the stock got edited by hand
+	StockMovementReasonAdjustment StockMovementReason = "ADJUSTMENT"
+	// StockMovementReasonSale means a paid order took the stock
+	StockMovementReasonSale StockMovementReason = "SALE"
+	// StockMovementReasonRestock means a cancelled, failed or refunded order gave the stock back
+	StockMovementReasonRestock StockMovementReason = "RESTOCK"
+)
+
+// String converts to string value
+func (r StockMovementReason) String() string {
+	return string(r)
+}
+
+// StockMovement represents a change of the stock on hand of a product, as recorded in the ledger
+type StockMovement struct {
+	ID        int64
+	ProductID int64
+	// Delta is how much the stock moved, negative when it got taken
+	Delta int64
+	// Balance is the stock on hand right after the movement
+	Balance int64
+	Reason  StockMovementReason
+	// OrderID is the order which moved the stock, zero for the others
+	OrderID int64
+	// ActorID is the user who moved the stock, zero when the system did
+	ActorID int64
+	// Seq is the position of the movement in the ledger, following the order the movements were made in
+	Seq       int64
+	CreatedAt time.Time
+}
+
+// ListStockMovementsInput holds input params for listing the stock movements of a product
+type ListStockMovementsInput struct {
+	ProductID int64
+	Cursor    string
+	Limit     int
+}
+
+// StockMovementList represents a page of stock movements & the cursor to get the next page with
+type StockMovementList struct {
+	Movements  []StockMovement
+	NextCursor string
+}
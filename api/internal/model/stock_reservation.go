@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// StockReservationTTL is how long a pending order holds the stock of its items before the reservation expires
+const StockReservationTTL = 15 * time.Minute
+
+// StockReservationStatus represents the status of the stock reservation
+type StockReservationStatus string
+
+const (
+	// StockReservationStatusActive means the stock is held for the order
+	StockReservationStatusActive StockReservationStatus = "ACTIVE"
+	// StockReservationStatusConsumed means the order got paid & the stock was taken from the product
+	StockReservationStatusConsumed StockReservationStatus = "CONSUMED"
+	// StockReservationStatusReleased means the stock was given back, as the order got cancelled or the reservation expired
+	StockReservationStatusReleased StockReservationStatus = "RELEASED"
+)
+
+// String converts to string value
+func (s StockReservationStatus) String() string {
+	return string(s)
+}
+
+// StockReservation represents the stock of a product held for a pending order
+type StockReservation struct {
+	ID        int64
+	OrderID   int64
+	ProductID int64
+	Quantity  int64
+	Status    StockReservationStatus
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsExpired checks if the reservation is still held past its expiry
+func (r StockReservation) IsExpired(now time.Time) bool {
+	return r.Status == StockReservationStatusActive && !now.Before(r.ExpiresAt)
+}
@@ -26,6 +26,41 @@ func (u UserStatus) IsValid() bool {
 	return false
 }
 
+// UserRole represents the role of the user
+type UserRole string
+
+const (
+	// UserRoleCustomer means the user is a customer placing orders
+	UserRoleCustomer UserRole = "CUSTOMER"
+	// UserRoleStaff means the user is a staff member managing the catalogue & orders
+	UserRoleStaff UserRole = "STAFF"
+	// UserRoleAdmin means the user is an administrator
+	UserRoleAdmin UserRole = "ADMIN"
+)
+
+// String converts to string value
+func (u UserRole) String() string {
+	return string(u)
+}
+
+// IsValid checks if user role is valid
+func (u UserRole) IsValid() bool {
+	switch u {
+	case UserRoleCustomer, UserRoleStaff, UserRoleAdmin:
+		return true
+	}
+	return false
+}
+
+// IsBackOffice checks if the user of the role may act on the orders & users of anyone
+func (u UserRole) IsBackOffice() bool {
+	switch u {
+	case UserRoleStaff, UserRoleAdmin:
+		return true
+	}
+	return false
+}
+
 // User presents the user struct
 type User struct {
 	ID        int64
@@ -33,6 +68,7 @@ type User struct {
 	Email     string
 	Password  string
 	Status    UserStatus
+	Role      UserRole
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -52,3 +88,24 @@ type UpdateUserInput struct {
 	Password string
 	Status   UserStatus
 }
+
+// ListUsersInput holds input params for listing the users
+type ListUsersInput struct {
+	Search      string
+	Status      []UserStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Cursor      string
+	Limit       int
+}
+
+// UserList represents a page of users & the cursor to get the next page with
+type UserList struct {
+	Users      []User
+	NextCursor string
+}
+
+// HasMore tells if there are more users after this page
+func (l UserList) HasMore() bool {
+	return l.NextCursor != ""
+}
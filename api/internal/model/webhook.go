@@ -0,0 +1,135 @@
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// WebhookEndpointStatus represents the status of the webhook endpoint
+type WebhookEndpointStatus string
+
+const (
+	// WebhookEndpointStatusActive means the endpoint receives the events it subscribed to
+	WebhookEndpointStatusActive WebhookEndpointStatus = "ACTIVE"
+	// WebhookEndpointStatusDisabled means the endpoint does not receive events anymore
+	WebhookEndpointStatusDisabled WebhookEndpointStatus = "DISABLED"
+)
+
+// String converts to string value
+func (w WebhookEndpointStatus) String() string {
+	return string(w)
+}
+
+// WebhookEndpoint represents a partner URL the events get POSTed to, signed with its secret
+type WebhookEndpoint struct {
+	ID         int64
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	Status     WebhookEndpointStatus
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// IsSubscribed checks if the endpoint is active & wants the events of the given type
+func (w WebhookEndpoint) IsSubscribed(eventType EventType) bool {
+	return w.Status == WebhookEndpointStatusActive && slices.Contains(w.EventTypes, eventType)
+}
+
+// CreateWebhookEndpointInput holds input params for registering a webhook endpoint
+type CreateWebhookEndpointInput struct {
+	URL        string
+	Secret     string
+	EventTypes []EventType
+}
+
+// WebhookDeliveryStatus represents the status of the delivery of an event to a webhook endpoint
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending means the delivery is waiting for its next attempt
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliveryStatusDelivered means the endpoint acknowledged the delivery
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "DELIVERED"
+	// WebhookDeliveryStatusDead means the delivery was given up after too many attempts, until it is redelivered
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "DEAD"
+)
+
+// String converts to string value
+func (w WebhookDeliveryStatus) String() string {
+	return string(w)
+}
+
+// IsValid checks if webhook delivery status is valid
+func (w WebhookDeliveryStatus) IsValid() bool {
+	switch w {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+// WebhookDelivery represents the delivery of an event to a webhook endpoint & the outcome of its last attempt
+type WebhookDelivery struct {
+	ID             int64
+	EndpointID     int64
+	EventID        int64
+	EventType      EventType
+	Payload        json.RawMessage
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	LastError      string
+	LastStatusCode int
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// WebhookPayload is the body POSTed to the webhook endpoints
+type WebhookPayload struct {
+	ID        int64           `json:"id,string"`
+	Type      EventType       `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// NewWebhookDelivery builds the pending delivery of the event to the endpoint
+func NewWebhookDelivery(endpoint WebhookEndpoint, event Event) (WebhookDelivery, error) {
+	payload, err := json.Marshal(WebhookPayload{
+		ID:        event.ID,
+		Type:      event.Type,
+		CreatedAt: event.CreatedAt,
+		Data:      event.Payload,
+	})
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+
+	return WebhookDelivery{
+		EndpointID: endpoint.ID,
+		EventID:    event.ID,
+		EventType:  event.Type,
+		Payload:    payload,
+		Status:     WebhookDeliveryStatusPending,
+	}, nil
+}
+
+// ListWebhookDeliveriesInput holds input params for listing the webhook deliveries
+type ListWebhookDeliveriesInput struct {
+	EndpointID int64
+	Status     []WebhookDeliveryStatus
+	Cursor     string
+	Limit      int
+}
+
+// WebhookDeliveryList represents a page of webhook deliveries & the cursor to get the next page with
+type WebhookDeliveryList struct {
+	Deliveries []WebhookDelivery
+	NextCursor string
+}
+
+// HasMore tells if there are more deliveries after this page
+func (l WebhookDeliveryList) HasMore() bool {
+	return l.NextCursor != ""
+}
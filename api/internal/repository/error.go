@@ -4,4 +4,5 @@ import "errors"
 
 var (
 	errNestedTx = errors.New("db txn nested in db txn")
+	errNoTx     = errors.New("savepoint outside of a db txn")
 )
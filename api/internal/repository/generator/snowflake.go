@@ -16,6 +16,16 @@ var (
 	OrderIDSNF *snowflake.Generator
 	// OrderItemIDSNF the snowflake generator for Order Item table's ID in DB
 	OrderItemIDSNF *snowflake.Generator
+	// RefreshTokenIDSNF the snowflake generator for Refresh Token table's ID in DB
+	RefreshTokenIDSNF *snowflake.Generator
+	// WebhookEndpointIDSNF the snowflake generator for Webhook Endpoint table's ID in DB
+	WebhookEndpointIDSNF *snowflake.Generator
+	// WebhookDeliveryIDSNF the snowflake generator for Webhook Delivery table's ID in DB
+	WebhookDeliveryIDSNF *snowflake.Generator
+	// StockReservationIDSNF the snowflake generator for Stock Reservation table's ID in DB
+	StockReservationIDSNF *snowflake.Generator
+	// StockMovementIDSNF the snowflake generator for Stock Movement table's ID in DB
+	StockMovementIDSNF *snowflake.Generator
 )
 
 // InitSnowflakeGenerators initializes all the snowflake generators
@@ -50,5 +60,40 @@ func InitSnowflakeGenerators() error {
 		}
 	}
 
+	if RefreshTokenIDSNF == nil {
+		RefreshTokenIDSNF, err = snowflake.New()
+		if err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	if WebhookEndpointIDSNF == nil {
+		WebhookEndpointIDSNF, err = snowflake.New()
+		if err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	if WebhookDeliveryIDSNF == nil {
+		WebhookDeliveryIDSNF, err = snowflake.New()
+		if err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	if StockReservationIDSNF == nil {
+		StockReservationIDSNF, err = snowflake.New()
+		if err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
+	if StockMovementIDSNF == nil {
+		StockMovementIDSNF, err = snowflake.New()
+		if err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,17 @@
+package idempotency
+
+import (
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+)
+
+func toIdempotencyKey(o *orm.IdempotencyKey) model.IdempotencyKey {
+	return model.IdempotencyKey{
+		UserID:      o.UserID,
+		Key:         o.Key,
+		RequestHash: o.RequestHash,
+		Response:    o.Response,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}
+}
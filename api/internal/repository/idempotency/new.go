@@ -0,0 +1,28 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+)
+
+// Repository provides the specification of the functionality provided by this pkg
+type Repository interface {
+	// ReserveKey saves the key unless the user still has it, & returns the key as stored with whether it was saved
+	ReserveKey(context.Context, model.IdempotencyKey, time.Duration) (model.IdempotencyKey, bool, error)
+	// SaveResponse keeps the response of the key to replay it
+	SaveResponse(context.Context, model.IdempotencyKey) error
+	// PurgeExpiredKeys deletes the keys created before the given time
+	PurgeExpiredKeys(context.Context, time.Time) (int64, error)
+}
+
+// New returns an implementation instance satisfying Repository
+func New(dbConn pg.ContextExecutor) Repository {
+	return impl{dbConn: dbConn}
+}
+
+type impl struct {
+	dbConn pg.ContextExecutor
+}
@@ -0,0 +1,22 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// PurgeExpiredKeys deletes the keys created before the given time & returns how many were deleted
+func (i impl) PurgeExpiredKeys(ctx context.Context, before time.Time) (int64, error) {
+	rowsAff, err := orm.IdempotencyKeys(
+		orm.IdempotencyKeyWhere.CreatedAt.LT(before),
+	).DeleteAll(ctx, i.dbConn)
+	if err != nil {
+		return 0, pkgerrors.WithStack(err)
+	}
+
+	return rowsAff, nil
+}
@@ -0,0 +1,33 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_PurgeExpiredKeys(t *testing.T) {
+	testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+		// Given:
+		testutil.LoadTestSQLFile(t, dbConn, "testdata/idempotency_keys.sql")
+		repo := New(dbConn)
+
+		// When:
+		deleted, err := repo.PurgeExpiredKeys(context.Background(), time.Now().Add(-model.IdempotencyKeyRetention))
+
+		// Then: only the expired key is gone
+		require.NoError(t, err)
+		require.Equal(t, int64(1), deleted)
+
+		count, err := orm.IdempotencyKeys().Count(context.Background(), dbConn)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	})
+}
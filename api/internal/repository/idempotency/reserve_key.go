@@ -0,0 +1,46 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ReserveKey saves the key for the user, taking over the key when it is older than the retention.
+// When the user still has the key, nothing is saved & the stored key is returned instead.
+// Within a tx, a concurrent request with the same key waits for the tx which reserved it to end.
+func (i impl) ReserveKey(ctx context.Context, m model.IdempotencyKey, retention time.Duration) (model.IdempotencyKey, bool, error) {
+	var o orm.IdempotencyKey
+	err := queries.Raw(
+		`INSERT INTO idempotency_keys (user_id, key, request_hash)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, key) DO UPDATE
+			SET request_hash = EXCLUDED.request_hash, response = DEFAULT, created_at = now(), updated_at = now()
+			WHERE idempotency_keys.created_at < now() - make_interval(secs => $4)
+		RETURNING *`,
+		m.UserID, m.Key, m.RequestHash, retention.Seconds(),
+	).Bind(ctx, i.dbConn, &o)
+	if err == nil {
+		return toIdempotencyKey(&o), true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return model.IdempotencyKey{}, false, pkgerrors.WithStack(err)
+	}
+
+	existing, err := orm.FindIdempotencyKey(ctx, i.dbConn, m.UserID, m.Key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.IdempotencyKey{}, false, pkgerrors.WithStack(ErrKeyNotFound)
+		}
+		return model.IdempotencyKey{}, false, pkgerrors.WithStack(err)
+	}
+
+	return toIdempotencyKey(existing), false, nil
+}
@@ -0,0 +1,61 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ReserveKey(t *testing.T) {
+	type arg struct {
+		givenKey    model.IdempotencyKey
+		expReserved bool
+		expHash     string
+		expResponse string
+	}
+
+	tcs := map[string]arg{
+		"new_key": {
+			givenKey:    model.IdempotencyKey{UserID: 14753001, Key: "new-key", RequestHash: "hash-3"},
+			expReserved: true,
+			expHash:     "hash-3",
+			expResponse: `{}`,
+		},
+		"existing_key": {
+			givenKey:    model.IdempotencyKey{UserID: 14753001, Key: "fresh-key", RequestHash: "hash-3"},
+			expHash:     "hash-1",
+			expResponse: `{"ID": 14753201}`,
+		},
+		"expired_key_taken_over": {
+			givenKey:    model.IdempotencyKey{UserID: 14753001, Key: "expired-key", RequestHash: "hash-3"},
+			expReserved: true,
+			expHash:     "hash-3",
+			expResponse: `{}`,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/idempotency_keys.sql")
+				repo := New(dbConn)
+
+				// When:
+				key, reserved, err := repo.ReserveKey(context.Background(), tc.givenKey, model.IdempotencyKeyRetention)
+
+				// Then:
+				require.NoError(t, err)
+				require.Equal(t, tc.expReserved, reserved)
+				require.Equal(t, tc.givenKey.UserID, key.UserID)
+				require.Equal(t, tc.givenKey.Key, key.Key)
+				require.Equal(t, tc.expHash, key.RequestHash)
+				require.JSONEq(t, tc.expResponse, string(key.Response))
+			})
+		})
+	}
+}
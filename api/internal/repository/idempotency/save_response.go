@@ -0,0 +1,31 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// SaveResponse keeps the response of the key of the user to replay it
+func (i impl) SaveResponse(ctx context.Context, m model.IdempotencyKey) error {
+	rowsAff, err := orm.IdempotencyKeys(
+		orm.IdempotencyKeyWhere.UserID.EQ(m.UserID),
+		orm.IdempotencyKeyWhere.Key.EQ(m.Key),
+	).UpdateAll(ctx, i.dbConn, orm.M{
+		orm.IdempotencyKeyColumns.Response:  m.Response,
+		orm.IdempotencyKeyColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if rowsAff == 0 {
+		return pkgerrors.WithStack(ErrKeyNotFound)
+	}
+
+	return nil
+}
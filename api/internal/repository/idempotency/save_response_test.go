@@ -0,0 +1,58 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_SaveResponse(t *testing.T) {
+	type arg struct {
+		givenKey string
+		expErr   error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenKey: "fresh-key",
+		},
+		"not_found": {
+			givenKey: "unknown-key",
+			expErr:   ErrKeyNotFound,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/idempotency_keys.sql")
+				repo := New(dbConn)
+
+				// When:
+				err := repo.SaveResponse(context.Background(), model.IdempotencyKey{
+					UserID:   14753001,
+					Key:      tc.givenKey,
+					Response: []byte(`{"ID":1}`),
+				})
+
+				// Then:
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+
+					o, err := orm.FindIdempotencyKey(context.Background(), dbConn, 14753001, tc.givenKey)
+					require.NoError(t, err)
+					require.JSONEq(t, `{"ID":1}`, string(o.Response))
+				}
+			})
+		})
+	}
+}
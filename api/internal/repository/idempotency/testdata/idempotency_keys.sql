@@ -0,0 +1,8 @@
+INSERT INTO users(id, name, email, password, status)
+VALUES
+   (14753001,'Test User','test@example.com', 'fasfasdasdasd', 'ACTIVE');
+
+INSERT INTO idempotency_keys(user_id, key, request_hash, response, created_at)
+VALUES
+   (14753001, 'fresh-key', 'hash-1', '{"ID":14753201}', now() - interval '1 hour'),
+   (14753001, 'expired-key', 'hash-2', '{"ID":14753202}', now() - interval '2 days');
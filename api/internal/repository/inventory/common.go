@@ -11,6 +11,7 @@ func toProduct(o *orm.Product) model.Product {
 		Name:        o.Name,
 		Description: o.Description,
 		Stock:       o.Stock,
+		Reserved:    o.Reserved,
 		Price:       o.Price,
 		Status:      model.ProductStatus(o.Status),
 		CreatedAt:   o.CreatedAt,
@@ -24,6 +25,8 @@ func toOrder(o *orm.Order) model.Order {
 		UserID:    o.UserID,
 		TotalCost: o.TotalCost,
 		Status:    model.OrderStatus(o.Status),
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
 	}
 
 	if o.R != nil && o.R.OrderItems != nil && len(o.R.OrderItems) > 0 {
@@ -45,3 +48,30 @@ func toOrderItem(o *orm.OrderItem) model.OrderItem {
 		Price:     o.Price,
 	}
 }
+
+func toStockReservation(o *orm.StockReservation) model.StockReservation {
+	return model.StockReservation{
+		ID:        o.ID,
+		OrderID:   o.OrderID,
+		ProductID: o.ProductID,
+		Quantity:  o.Quantity,
+		Status:    model.StockReservationStatus(o.Status),
+		ExpiresAt: o.ExpiresAt,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+func toStockMovement(o *orm.StockMovement) model.StockMovement {
+	return model.StockMovement{
+		ID:        o.ID,
+		ProductID: o.ProductID,
+		Delta:     o.Delta,
+		Balance:   o.Balance,
+		Reason:    model.StockMovementReason(o.Reason),
+		OrderID:   o.OrderID,
+		ActorID:   o.ActorID,
+		Seq:       o.Seq,
+		CreatedAt: o.CreatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ConsumeProductStock atomically takes the given reserved quantity from the product stock in DB and returns the updated product.
+// It fails with ErrOutOfStock when the stock got lowered below the quantity since it was reserved.
+func (i impl) ConsumeProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error) {
+	var o orm.Product
+	err := queries.Raw(
+		`UPDATE products SET stock = stock - $1, reserved = GREATEST(reserved - $1, 0), updated_at = now() WHERE id = $2 AND stock >= $1 RETURNING *`,
+		quantity, id,
+	).Bind(ctx, i.dbConn, &o)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return model.Product{}, pkgerrors.WithStack(err)
+		}
+
+		// Nothing updated, either the product does not exist or it has not enough stock
+		exists, err := orm.ProductExists(ctx, i.dbConn, id)
+		if err != nil {
+			return model.Product{}, pkgerrors.WithStack(err)
+		}
+		if !exists {
+			return model.Product{}, ErrProductNotFound
+		}
+
+		return model.Product{}, ErrOutOfStock
+	}
+
+	return toProduct(&o), nil
+}
@@ -0,0 +1,99 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ConsumeProductStock(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath  string
+		givenCtx      context.Context
+		givenID       int64
+		givenQuantity int64
+		expStock      int64
+		expReserved   int64
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753030,
+			givenQuantity: 15,
+			expStock:      85,
+			expReserved:   5,
+		},
+		"success_whole_stock": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753031,
+			givenQuantity: 5,
+			expStock:      0,
+			expReserved:   0,
+		},
+		"out_of_stock": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753031,
+			givenQuantity: 6,
+			expErr:        ErrOutOfStock,
+		},
+		"not_found": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753039,
+			givenQuantity: 5,
+			expErr:        ErrProductNotFound,
+		},
+		"ctx_cancelled": {
+			givenCtx:      cancelledCtx,
+			givenID:       14753030,
+			givenQuantity: 5,
+			expErr:        context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				product, err := repo.ConsumeProductStock(tc.givenCtx, tc.givenID, tc.givenQuantity)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.givenID, product.ID)
+					require.Equal(t, tc.expStock, product.Stock)
+					require.Equal(t, tc.expReserved, product.Reserved)
+
+					product, err = repo.GetProductByID(context.Background(), tc.givenID)
+					require.NoError(t, err)
+					require.Equal(t, tc.expStock, product.Stock)
+					require.Equal(t, tc.expReserved, product.Reserved)
+				}
+			})
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,7 +35,7 @@ func Test_impl_CreateOrderItem(t *testing.T) {
 				OrderID:   14753010,
 				ProductID: 14753010,
 				Quantity:  10,
-				Price:     2000,
+				Price:     decimal.RequireFromString("2000"),
 			},
 		},
 		"ctx_cancelled": {
@@ -44,7 +45,7 @@ func Test_impl_CreateOrderItem(t *testing.T) {
 				OrderID:   14753010,
 				ProductID: 14753010,
 				Quantity:  10,
-				Price:     2000,
+				Price:     decimal.RequireFromString("2000"),
 			},
 			expErr: context.Canceled,
 		},
@@ -55,7 +56,7 @@ func Test_impl_CreateOrderItem(t *testing.T) {
 				OrderID:   14753020,
 				ProductID: 14753010,
 				Quantity:  10,
-				Price:     2000,
+				Price:     decimal.RequireFromString("2000"),
 			},
 			expErr: errors.New("foreign key constraint"),
 		},
@@ -11,6 +11,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,7 +34,7 @@ func Test_impl_CreateOrder(t *testing.T) {
 			givenOrder: model.Order{
 				UserID:    14753001,
 				Status:    model.OrderStatusPending,
-				TotalCost: 10,
+				TotalCost: decimal.RequireFromString("10"),
 			},
 		},
 		"ctx_cancelled": {
@@ -42,7 +43,7 @@ func Test_impl_CreateOrder(t *testing.T) {
 			givenOrder: model.Order{
 				UserID:    14753001,
 				Status:    model.OrderStatusPending,
-				TotalCost: 10,
+				TotalCost: decimal.RequireFromString("10"),
 			},
 			expErr: context.Canceled,
 		},
@@ -53,7 +54,7 @@ func Test_impl_CreateOrder(t *testing.T) {
 				ID:        14753010,
 				UserID:    14753011,
 				Status:    model.OrderStatusPending,
-				TotalCost: 10,
+				TotalCost: decimal.RequireFromString("10"),
 			},
 			expErr: errors.New("foreign key constraint"),
 		},
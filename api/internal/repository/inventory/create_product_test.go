@@ -11,6 +11,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,7 +33,7 @@ func Test_impl_CreateProduct(t *testing.T) {
 			givenProduct: model.Product{
 				Name:        "TestProduct",
 				Description: "TestProduct",
-				Price:       2500,
+				Price:       decimal.RequireFromString("2500"),
 				Stock:       5,
 				Status:      model.ProductStatusActive,
 			},
@@ -42,7 +43,7 @@ func Test_impl_CreateProduct(t *testing.T) {
 			givenProduct: model.Product{
 				Name:        "TestProduct",
 				Description: "TestProduct",
-				Price:       2500,
+				Price:       decimal.RequireFromString("2500"),
 				Stock:       5,
 				Status:      model.ProductStatusActive,
 			},
@@ -54,7 +55,7 @@ func Test_impl_CreateProduct(t *testing.T) {
 			givenProduct: model.Product{
 				Name:        "",
 				Description: "",
-				Price:       2500,
+				Price:       decimal.RequireFromString("2500"),
 				Stock:       5,
 				Status:      model.ProductStatusActive,
 			},
@@ -0,0 +1,36 @@
+package inventory
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// CreateStockMovement appends the stock movement to the ledger in DB
+func (i impl) CreateStockMovement(ctx context.Context, m model.StockMovement) (model.StockMovement, error) {
+	id, err := generator.StockMovementIDSNF.Generate()
+	if err != nil {
+		return m, pkgerrors.WithStack(err)
+	}
+
+	o := orm.StockMovement{
+		ID:        id,
+		ProductID: m.ProductID,
+		Delta:     m.Delta,
+		Balance:   m.Balance,
+		Reason:    m.Reason.String(),
+		OrderID:   m.OrderID,
+		ActorID:   m.ActorID,
+	}
+
+	if err = o.Insert(ctx, i.dbConn, boil.Infer()); err != nil {
+		return m, pkgerrors.WithStack(err)
+	}
+
+	return toStockMovement(&o), nil
+}
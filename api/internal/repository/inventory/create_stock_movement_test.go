@@ -0,0 +1,99 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_CreateStockMovement(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath  string
+		givenCtx      context.Context
+		givenMovement model.StockMovement
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/stock_movements.sql",
+			givenCtx:     context.Background(),
+			givenMovement: model.StockMovement{
+				ProductID: 14753050,
+				Delta:     -2,
+				Balance:   10,
+				Reason:    model.StockMovementReasonSale,
+				OrderID:   14753071,
+				ActorID:   14753001,
+			},
+		},
+		"ctx_cancelled": {
+			testDataPath: "testdata/stock_movements.sql",
+			givenCtx:     cancelledCtx,
+			givenMovement: model.StockMovement{
+				ProductID: 14753050,
+				Delta:     -2,
+				Balance:   10,
+				Reason:    model.StockMovementReasonSale,
+			},
+			expErr: context.Canceled,
+		},
+		"product_not_found": {
+			testDataPath: "testdata/stock_movements.sql",
+			givenCtx:     context.Background(),
+			givenMovement: model.StockMovement{
+				ProductID: 14753059,
+				Delta:     -2,
+				Balance:   10,
+				Reason:    model.StockMovementReasonSale,
+			},
+			expErr: errors.New("foreign key constraint"),
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				created, err := repo.CreateStockMovement(tc.givenCtx, tc.givenMovement)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					if desc == "product_not_found" {
+						require.Contains(t, err.Error(), tc.expErr.Error())
+					} else {
+						require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+					}
+				} else {
+					require.NoError(t, err)
+					require.NotEmpty(t, created.ID)
+					require.NotEmpty(t, created.Seq)
+					require.NotEmpty(t, created.CreatedAt)
+					testutil.Compare(t, tc.givenMovement, created, model.StockMovement{}, "ID", "Seq", "CreatedAt")
+
+					// The ledger is append-only
+					_, err = dbConn.ExecContext(context.Background(), `UPDATE stock_movements SET delta = 1 WHERE id = $1`, created.ID)
+					require.ErrorContains(t, err, "append-only")
+				}
+			})
+		})
+	}
+}
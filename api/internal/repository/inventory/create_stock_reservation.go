@@ -0,0 +1,35 @@
+package inventory
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// CreateStockReservation saves the stock reservation of an order item in DB
+func (i impl) CreateStockReservation(ctx context.Context, m model.StockReservation) (model.StockReservation, error) {
+	id, err := generator.StockReservationIDSNF.Generate()
+	if err != nil {
+		return m, pkgerrors.WithStack(err)
+	}
+
+	o := orm.StockReservation{
+		ID:        id,
+		OrderID:   m.OrderID,
+		ProductID: m.ProductID,
+		Quantity:  m.Quantity,
+		Status:    m.Status.String(),
+		ExpiresAt: m.ExpiresAt,
+	}
+
+	if err = o.Insert(ctx, i.dbConn, boil.Infer()); err != nil {
+		return m, pkgerrors.WithStack(err)
+	}
+
+	return toStockReservation(&o), nil
+}
@@ -0,0 +1,98 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_CreateStockReservation(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	expiresAt := time.Date(2024, 1, 1, 0, 15, 0, 0, time.UTC)
+
+	type arg struct {
+		testDataPath     string
+		givenCtx         context.Context
+		givenReservation model.StockReservation
+		expErr           error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenReservation: model.StockReservation{
+				OrderID:   14753031,
+				ProductID: 14753031,
+				Quantity:  2,
+				Status:    model.StockReservationStatusActive,
+				ExpiresAt: expiresAt,
+			},
+		},
+		"ctx_cancelled": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     cancelledCtx,
+			givenReservation: model.StockReservation{
+				OrderID:   14753031,
+				ProductID: 14753031,
+				Quantity:  2,
+				Status:    model.StockReservationStatusActive,
+				ExpiresAt: expiresAt,
+			},
+			expErr: context.Canceled,
+		},
+		"order_not_found": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenReservation: model.StockReservation{
+				OrderID:   14753039,
+				ProductID: 14753031,
+				Quantity:  2,
+				Status:    model.StockReservationStatusActive,
+				ExpiresAt: expiresAt,
+			},
+			expErr: errors.New("foreign key constraint"),
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				created, err := repo.CreateStockReservation(tc.givenCtx, tc.givenReservation)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					if desc == "order_not_found" {
+						require.Contains(t, err.Error(), tc.expErr.Error())
+					} else {
+						require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+					}
+				} else {
+					require.NoError(t, err)
+					require.NotEmpty(t, created.ID)
+					require.True(t, tc.givenReservation.ExpiresAt.Equal(created.ExpiresAt))
+					testutil.Compare(t, tc.givenReservation, created, model.StockReservation{}, "ID", "ExpiresAt", "CreatedAt", "UpdatedAt")
+				}
+			})
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 var (
 	ErrProductNotFound   = errors.New("product not found")
+	ErrOutOfStock        = errors.New("product out of stock")
 	ErrOrderNotFound     = errors.New("order not found")
 	ErrOrderItemNotFound = errors.New("order item not found")
 )
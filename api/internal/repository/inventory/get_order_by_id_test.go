@@ -10,6 +10,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,14 +36,14 @@ func Test_impl_GetOrderByID(t *testing.T) {
 				ID:        14753010,
 				UserID:    14753001,
 				Status:    model.OrderStatusPending,
-				TotalCost: 20,
+				TotalCost: decimal.RequireFromString("20"),
 				OrderItems: []model.OrderItem{
 					{
 						ID:        14753001,
 						OrderID:   14753010,
 						ProductID: 14753010,
 						Quantity:  20,
-						Price:     2000,
+						Price:     decimal.RequireFromString("2000"),
 					},
 				},
 			},
@@ -55,7 +56,7 @@ func Test_impl_GetOrderByID(t *testing.T) {
 				ID:        14753011,
 				UserID:    14753001,
 				Status:    model.OrderStatusPending,
-				TotalCost: 10,
+				TotalCost: decimal.RequireFromString("10"),
 			},
 		},
 		"ctx_cancelled": {
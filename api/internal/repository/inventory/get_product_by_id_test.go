@@ -10,6 +10,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,7 +37,7 @@ func Test_impl_GetProductByID(t *testing.T) {
 				Name:        "Test Product",
 				Description: "test",
 				Status:      model.ProductStatusActive,
-				Price:       2000,
+				Price:       decimal.RequireFromString("2000"),
 				Stock:       100,
 			},
 		},
@@ -0,0 +1,31 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetProductByIDWithLock retrieve product data by product ID and locks the product row until the surrounding tx ends.
+// It must be called within a DB tx.
+func (i impl) GetProductByIDWithLock(ctx context.Context, id int64) (model.Product, error) {
+	o, err := orm.Products(
+		orm.ProductWhere.ID.EQ(id),
+		qm.For("UPDATE"),
+	).One(ctx, i.dbConn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Product{}, ErrProductNotFound
+		}
+
+		return model.Product{}, pkgerrors.WithStack(err)
+	}
+
+	return toProduct(o), nil
+}
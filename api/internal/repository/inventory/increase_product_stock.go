@@ -0,0 +1,30 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// IncreaseProductStock atomically adds the given quantity to the product stock in DB and returns the updated product
+func (i impl) IncreaseProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error) {
+	var o orm.Product
+	err := queries.Raw(
+		`UPDATE products SET stock = stock + $1, updated_at = now() WHERE id = $2 RETURNING *`,
+		quantity, id,
+	).Bind(ctx, i.dbConn, &o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Product{}, ErrProductNotFound
+		}
+		return model.Product{}, pkgerrors.WithStack(err)
+	}
+
+	return toProduct(&o), nil
+}
@@ -0,0 +1,80 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_IncreaseProductStock(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath  string
+		givenCtx      context.Context
+		givenID       int64
+		givenQuantity int64
+		expStock      int64
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath:  "testdata/success_get_data.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753010,
+			givenQuantity: 20,
+			expStock:      120,
+		},
+		"ctx_cancelled": {
+			givenCtx:      cancelledCtx,
+			givenID:       14753010,
+			givenQuantity: 20,
+			expErr:        context.Canceled,
+		},
+		"not_found": {
+			testDataPath:  "testdata/success_get_data.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753012,
+			givenQuantity: 20,
+			expErr:        ErrProductNotFound,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				product, err := repo.IncreaseProductStock(tc.givenCtx, tc.givenID, tc.givenQuantity)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.givenID, product.ID)
+					require.Equal(t, tc.expStock, product.Stock)
+
+					product, err = repo.GetProductByID(context.Background(), tc.givenID)
+					require.NoError(t, err)
+					require.Equal(t, tc.expStock, product.Stock)
+				}
+			})
+		})
+	}
+}
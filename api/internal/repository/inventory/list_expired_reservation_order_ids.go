@@ -0,0 +1,34 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ListExpiredReservationOrderIDs gets the IDs of the orders holding stock reservations which expired before the given time, oldest expiry first.
+// The orders the sweeper is backing off from are left out until their next sweep, see PostponeReservationsSweep.
+func (i impl) ListExpiredReservationOrderIDs(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	var rows []struct {
+		OrderID int64 `boil:"order_id"`
+	}
+	err := queries.Raw(
+		`SELECT order_id FROM stock_reservations WHERE status = $1 AND expires_at <= $2 AND next_sweep_at <= now()
+		GROUP BY order_id ORDER BY MIN(expires_at) LIMIT $3`,
+		model.StockReservationStatusActive.String(), before, limit,
+	).Bind(ctx, i.dbConn, &rows)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	ids := make([]int64, len(rows))
+	for idx, r := range rows {
+		ids[idx] = r.OrderID
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,89 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListExpiredReservationOrderIDs(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath string
+		givenCtx     context.Context
+		givenBefore  time.Time
+		givenLimit   int
+		expIDs       []int64
+		expErr       error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenBefore:  time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			givenLimit:   10,
+			expIDs:       []int64{14753030},
+		},
+		"oldest_expiry_first": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenBefore:  time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			givenLimit:   10,
+			expIDs:       []int64{14753030, 14753031},
+		},
+		"limit": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenBefore:  time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			givenLimit:   1,
+			expIDs:       []int64{14753030},
+		},
+		"none_expired": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenBefore:  time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			givenLimit:   10,
+			expIDs:       []int64{},
+		},
+		"ctx_cancelled": {
+			givenCtx:    cancelledCtx,
+			givenBefore: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			givenLimit:  10,
+			expErr:      context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				ids, err := repo.ListExpiredReservationOrderIDs(tc.givenCtx, tc.givenBefore, tc.givenLimit)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.expIDs, ids)
+				}
+			})
+		})
+	}
+}
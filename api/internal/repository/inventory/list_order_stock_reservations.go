@@ -0,0 +1,29 @@
+package inventory
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ListOrderStockReservations gets the stock reservations of the order from DB, whatever their status
+func (i impl) ListOrderStockReservations(ctx context.Context, orderID int64) ([]model.StockReservation, error) {
+	slice, err := orm.StockReservations(
+		orm.StockReservationWhere.OrderID.EQ(orderID),
+		qm.OrderBy(orm.StockReservationColumns.ID),
+	).All(ctx, i.dbConn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var result []model.StockReservation
+	for _, o := range slice {
+		result = append(result, toStockReservation(o))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListOrderStockReservations(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath    string
+		givenCtx        context.Context
+		givenOrderID    int64
+		expReservations []model.StockReservation
+		expErr          error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenOrderID: 14753030,
+			expReservations: []model.StockReservation{
+				{ID: 14753040, OrderID: 14753030, ProductID: 14753030, Quantity: 15, Status: model.StockReservationStatusActive},
+				{ID: 14753041, OrderID: 14753030, ProductID: 14753031, Quantity: 5, Status: model.StockReservationStatusActive},
+			},
+		},
+		"no_reservations": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenOrderID: 14753039,
+		},
+		"ctx_cancelled": {
+			givenCtx:     cancelledCtx,
+			givenOrderID: 14753030,
+			expErr:       context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				reservations, err := repo.ListOrderStockReservations(tc.givenCtx, tc.givenOrderID)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Len(t, reservations, len(tc.expReservations))
+					for idx, exp := range tc.expReservations {
+						testutil.Compare(t, exp, reservations[idx], model.StockReservation{}, "ExpiresAt", "CreatedAt", "UpdatedAt")
+					}
+				}
+			})
+		})
+	}
+}
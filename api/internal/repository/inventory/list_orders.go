@@ -0,0 +1,60 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// OrdersFilter holds filters for getting orders list
+type OrdersFilter struct {
+	UserID      int64
+	Status      []model.OrderStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+// ListOrders gets a list of orders with their items from DB
+func (i impl) ListOrders(ctx context.Context, filter OrdersFilter) ([]model.Order, error) {
+	qms := []qm.QueryMod{
+		qm.Load(orm.OrderRels.OrderItems),
+		qm.OrderBy(orm.OrderColumns.CreatedAt + " DESC"),
+	}
+
+	if filter.UserID > 0 {
+		qms = append(qms, orm.OrderWhere.UserID.EQ(filter.UserID))
+	}
+
+	if len(filter.Status) > 0 {
+		status := make([]string, len(filter.Status))
+		for idx, s := range filter.Status {
+			status[idx] = s.String()
+		}
+		qms = append(qms, orm.OrderWhere.Status.IN(status))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		qms = append(qms, orm.OrderWhere.CreatedAt.GTE(filter.CreatedFrom))
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		qms = append(qms, orm.OrderWhere.CreatedAt.LTE(filter.CreatedTo))
+	}
+
+	slice, err := orm.Orders(qms...).All(ctx, i.dbConn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var result []model.Order
+	for _, o := range slice {
+		result = append(result, toOrder(o))
+	}
+
+	return result, nil
+}
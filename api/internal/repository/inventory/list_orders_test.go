@@ -0,0 +1,110 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListOrders(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	pendingOrder := model.Order{
+		ID:        14753010,
+		UserID:    14753001,
+		Status:    model.OrderStatusPending,
+		TotalCost: decimal.RequireFromString("20"),
+		OrderItems: []model.OrderItem{
+			{
+				ID:        14753001,
+				OrderID:   14753010,
+				ProductID: 14753010,
+				Quantity:  20,
+				Price:     decimal.RequireFromString("2000"),
+			},
+		},
+	}
+	paidOrder := model.Order{
+		ID:        14753011,
+		UserID:    14753001,
+		Status:    model.OrderStatusPaid,
+		TotalCost: decimal.RequireFromString("10"),
+	}
+
+	type arg struct {
+		testDataPath string
+		givenCtx     context.Context
+		givenFilter  OrdersFilter
+		expOrders    []model.Order
+		expErr       error
+	}
+
+	tcs := map[string]arg{
+		"success_by_user": {
+			testDataPath: "testdata/list_orders.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  OrdersFilter{UserID: 14753001},
+			expOrders:    []model.Order{paidOrder, pendingOrder},
+		},
+		"success_by_status": {
+			testDataPath: "testdata/list_orders.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  OrdersFilter{UserID: 14753001, Status: []model.OrderStatus{model.OrderStatusPending}},
+			expOrders:    []model.Order{pendingOrder},
+		},
+		"success_by_date_range": {
+			testDataPath: "testdata/list_orders.sql",
+			givenCtx:     context.Background(),
+			givenFilter: OrdersFilter{
+				UserID:      14753001,
+				CreatedFrom: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				CreatedTo:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expOrders: []model.Order{paidOrder},
+		},
+		"empty": {
+			testDataPath: "testdata/list_orders.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  OrdersFilter{UserID: 14753001, Status: []model.OrderStatus{model.OrderStatusShipped}},
+		},
+		"ctx_cancelled": {
+			givenCtx: cancelledCtx,
+			expErr:   context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				orders, err := repo.ListOrders(tc.givenCtx, tc.givenFilter)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					testutil.Compare(t, tc.expOrders, orders, model.Order{}, "CreatedAt", "UpdatedAt")
+				}
+			})
+		})
+	}
+}
@@ -2,26 +2,76 @@ package inventory
 
 import (
 	"context"
+	"strings"
 
 	"omg/api/internal/model"
 	"omg/api/internal/repository/orm"
+	"omg/api/pkg/pagination"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 // ProductsFilter holds filters for getting products list
 type ProductsFilter struct {
-	Status   []model.ProductStatus
-	WithLock bool
+	Status     []model.ProductStatus
+	MinPrice   decimal.NullDecimal
+	MaxPrice   decimal.NullDecimal
+	InStock    bool
+	NamePrefix string
+	After      *pagination.Cursor
+	Limit      int
 }
 
-// ListProducts gets a list of products from DB
-func (i impl) ListProducts(ctx context.Context) ([]model.Product, error) {
-	slice, err := orm.Products(
+// likeEscaper escapes the wildcards of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListProducts gets a list of non-deleted products from DB, newest first.
+// Pagination is keyset based on (created_at, id), so pages stay fast & stable while products are added.
+func (i impl) ListProducts(ctx context.Context, filter ProductsFilter) ([]model.Product, error) {
+	qms := []qm.QueryMod{
 		orm.ProductWhere.Status.NEQ(model.ProductStatusDeleted.String()),
-		qm.OrderBy(orm.ProductColumns.CreatedAt+" DESC"),
-	).All(ctx, i.dbConn)
+		qm.OrderBy(orm.ProductColumns.CreatedAt + " DESC, " + orm.ProductColumns.ID + " DESC"),
+	}
+
+	if len(filter.Status) > 0 {
+		status := make([]string, len(filter.Status))
+		for idx, s := range filter.Status {
+			status[idx] = s.String()
+		}
+		qms = append(qms, orm.ProductWhere.Status.IN(status))
+	}
+
+	if filter.MinPrice.Valid {
+		qms = append(qms, orm.ProductWhere.Price.GTE(filter.MinPrice.Decimal))
+	}
+
+	if filter.MaxPrice.Valid {
+		qms = append(qms, orm.ProductWhere.Price.LTE(filter.MaxPrice.Decimal))
+	}
+
+	if filter.InStock {
+		// Only what is left once the reservations of pending orders are held counts as in stock
+		qms = append(qms, qm.Where(orm.ProductColumns.Stock+" > "+orm.ProductColumns.Reserved))
+	}
+
+	if filter.NamePrefix != "" {
+		qms = append(qms, qm.Where(orm.ProductColumns.Name+" ILIKE ?", likeEscaper.Replace(filter.NamePrefix)+"%"))
+	}
+
+	if filter.After != nil {
+		qms = append(qms, qm.Where(
+			"("+orm.ProductColumns.CreatedAt+", "+orm.ProductColumns.ID+") < (?, ?)",
+			filter.After.CreatedAt, filter.After.ID,
+		))
+	}
+
+	if filter.Limit > 0 {
+		qms = append(qms, qm.Limit(filter.Limit))
+	}
+
+	slice, err := orm.Products(qms...).All(ctx, i.dbConn)
 	if err != nil {
 		return nil, pkgerrors.WithStack(err)
 	}
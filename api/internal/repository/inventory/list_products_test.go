@@ -3,13 +3,16 @@ package inventory
 import (
 	"context"
 	"testing"
+	"time"
 
 	"omg/api/internal/model"
 	"omg/api/internal/repository/generator"
 	"omg/api/pkg/db/pg"
+	"omg/api/pkg/pagination"
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,11 +20,18 @@ func Test_impl_ListProducts(t *testing.T) {
 	cancelledCtx, c := context.WithCancel(context.Background())
 	c()
 
+	var (
+		appleJuice = model.Product{ID: 14753020, Name: "Apple Juice", Description: "test", Status: model.ProductStatusActive, Price: decimal.RequireFromString("10.5"), Stock: 5}
+		applePie   = model.Product{ID: 14753021, Name: "Apple Pie", Description: "test", Status: model.ProductStatusActive, Price: decimal.RequireFromString("25"), Stock: 0}
+		banana     = model.Product{ID: 14753022, Name: "Banana", Description: "test", Status: model.ProductStatusActive, Price: decimal.RequireFromString("3.2"), Stock: 50}
+		cherry     = model.Product{ID: 14753025, Name: "Cherry", Description: "test", Status: model.ProductStatusActive, Price: decimal.RequireFromString("8"), Stock: 1, Reserved: 1}
+	)
+
 	type arg struct {
 		testDataPath string
 		givenCtx     context.Context
+		givenFilter  ProductsFilter
 		expProducts  []model.Product
-		mockIDErr    error
 		expErr       error
 	}
 
@@ -35,11 +45,61 @@ func Test_impl_ListProducts(t *testing.T) {
 					Name:        "Test Product",
 					Description: "test",
 					Status:      model.ProductStatusActive,
-					Price:       2000,
+					Price:       decimal.RequireFromString("2000"),
 					Stock:       100,
 				},
 			},
 		},
+		"newest_first_without_deleted": {
+			testDataPath: "testdata/list_products.sql",
+			givenCtx:     context.Background(),
+			expProducts:  []model.Product{banana, cherry, applePie, appleJuice},
+		},
+		"limit": {
+			testDataPath: "testdata/list_products.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  ProductsFilter{Limit: 2},
+			expProducts:  []model.Product{banana, cherry},
+		},
+		"after_cursor": {
+			testDataPath: "testdata/list_products.sql",
+			givenCtx:     context.Background(),
+			givenFilter: ProductsFilter{
+				After: &pagination.Cursor{CreatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), ID: 14753025},
+			},
+			expProducts: []model.Product{applePie, appleJuice},
+		},
+		"name_prefix": {
+			testDataPath: "testdata/list_products.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  ProductsFilter{NamePrefix: "apple"},
+			expProducts:  []model.Product{applePie, appleJuice},
+		},
+		"name_prefix_wildcard_is_literal": {
+			testDataPath: "testdata/list_products.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  ProductsFilter{NamePrefix: "%"},
+		},
+		"price_range": {
+			testDataPath: "testdata/list_products.sql",
+			givenCtx:     context.Background(),
+			givenFilter: ProductsFilter{
+				MinPrice: decimal.NewNullDecimal(decimal.RequireFromString("5")),
+				MaxPrice: decimal.NewNullDecimal(decimal.RequireFromString("20")),
+			},
+			expProducts: []model.Product{cherry, appleJuice},
+		},
+		"in_stock": {
+			testDataPath: "testdata/list_products.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  ProductsFilter{InStock: true},
+			expProducts:  []model.Product{banana, appleJuice},
+		},
+		"status": {
+			testDataPath: "testdata/list_products.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  ProductsFilter{Status: []model.ProductStatus{model.ProductStatusDeleted}},
+		},
 		"ctx_cancelled": {
 			givenCtx: cancelledCtx,
 			expErr:   context.Canceled,
@@ -57,16 +117,12 @@ func Test_impl_ListProducts(t *testing.T) {
 				require.Nil(t, generator.InitSnowflakeGenerators())
 
 				// When:
-				products, err := repo.ListProducts(tc.givenCtx)
+				products, err := repo.ListProducts(tc.givenCtx, tc.givenFilter)
 
 				// Then:
 				if tc.expErr != nil {
 					require.Error(t, err)
-					if desc == "duplicate_email" {
-						require.Contains(t, err.Error(), tc.expErr.Error())
-					} else {
-						require.Equal(t, tc.expErr, pkgerrors.Cause(err))
-					}
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
 				} else {
 					require.NoError(t, err)
 					testutil.Compare(t, tc.expProducts, products, model.Product{}, "CreatedAt", "UpdatedAt")
@@ -0,0 +1,48 @@
+package inventory
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/pagination"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// StockMovementsFilter holds filters for getting the stock movements of a product
+type StockMovementsFilter struct {
+	ProductID int64
+	After     *pagination.SeqCursor
+	Limit     int
+}
+
+// ListStockMovements gets the stock movements of a product from DB, newest first.
+// Pagination is keyset based on the seq, as created_at is stamped before the insert & may not follow the ledger order.
+func (i impl) ListStockMovements(ctx context.Context, filter StockMovementsFilter) ([]model.StockMovement, error) {
+	qms := []qm.QueryMod{
+		orm.StockMovementWhere.ProductID.EQ(filter.ProductID),
+		qm.OrderBy(orm.StockMovementColumns.Seq + " DESC"),
+	}
+
+	if filter.After != nil {
+		qms = append(qms, orm.StockMovementWhere.Seq.LT(filter.After.Seq))
+	}
+
+	if filter.Limit > 0 {
+		qms = append(qms, qm.Limit(filter.Limit))
+	}
+
+	slice, err := orm.StockMovements(qms...).All(ctx, i.dbConn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var result []model.StockMovement
+	for _, o := range slice {
+		result = append(result, toStockMovement(o))
+	}
+
+	return result, nil
+}
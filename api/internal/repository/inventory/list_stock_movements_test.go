@@ -0,0 +1,101 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/pagination"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListStockMovements(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	var (
+		initial    = model.StockMovement{ID: 14753060, ProductID: 14753050, Delta: 10, Balance: 10, Reason: model.StockMovementReasonInitial, ActorID: 14753001, Seq: 1}
+		sale       = model.StockMovement{ID: 14753061, ProductID: 14753050, Delta: -3, Balance: 7, Reason: model.StockMovementReasonSale, OrderID: 14753070, ActorID: 14753001, Seq: 2}
+		adjustment = model.StockMovement{ID: 14753062, ProductID: 14753050, Delta: 5, Balance: 12, Reason: model.StockMovementReasonAdjustment, ActorID: 14753001, Seq: 3}
+	)
+
+	type arg struct {
+		testDataPath string
+		givenCtx     context.Context
+		givenFilter  StockMovementsFilter
+		expMovements []model.StockMovement
+		expErr       error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/stock_movements.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  StockMovementsFilter{ProductID: 14753050},
+			expMovements: []model.StockMovement{adjustment, sale, initial},
+		},
+		"limit": {
+			testDataPath: "testdata/stock_movements.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  StockMovementsFilter{ProductID: 14753050, Limit: 2},
+			expMovements: []model.StockMovement{adjustment, sale},
+		},
+		"ordered_by_seq_not_created_at": {
+			testDataPath: "testdata/stock_movements.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  StockMovementsFilter{ProductID: 14753050, Limit: 1},
+			expMovements: []model.StockMovement{adjustment},
+		},
+		"after_cursor": {
+			testDataPath: "testdata/stock_movements.sql",
+			givenCtx:     context.Background(),
+			givenFilter: StockMovementsFilter{
+				ProductID: 14753050,
+				After:     &pagination.SeqCursor{Seq: 2},
+			},
+			expMovements: []model.StockMovement{initial},
+		},
+		"no_movements": {
+			testDataPath: "testdata/stock_movements.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  StockMovementsFilter{ProductID: 14753059},
+		},
+		"ctx_cancelled": {
+			givenCtx:    cancelledCtx,
+			givenFilter: StockMovementsFilter{ProductID: 14753050},
+			expErr:      context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				movements, err := repo.ListStockMovements(tc.givenCtx, tc.givenFilter)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Len(t, movements, len(tc.expMovements))
+					for idx, exp := range tc.expMovements {
+						testutil.Compare(t, exp, movements[idx], model.StockMovement{}, "CreatedAt")
+					}
+				}
+			})
+		})
+	}
+}
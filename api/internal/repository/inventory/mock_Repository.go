@@ -7,6 +7,8 @@ import (
 	model "omg/api/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockRepository is an autogenerated mock type for the Repository type
@@ -14,6 +16,34 @@ type MockRepository struct {
 	mock.Mock
 }
 
+// ConsumeProductStock provides a mock function with given fields: ctx, id, quantity
+func (_m *MockRepository) ConsumeProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error) {
+	ret := _m.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeProductStock")
+	}
+
+	var r0 model.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Product, error)); ok {
+		return rf(ctx, id, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Product); ok {
+		r0 = rf(ctx, id, quantity)
+	} else {
+		r0 = ret.Get(0).(model.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateOrder provides a mock function with given fields: _a0, _a1
 func (_m *MockRepository) CreateOrder(_a0 context.Context, _a1 model.Order) (model.Order, error) {
 	ret := _m.Called(_a0, _a1)
@@ -98,6 +128,62 @@ func (_m *MockRepository) CreateProduct(_a0 context.Context, _a1 model.Product)
 	return r0, r1
 }
 
+// CreateStockMovement provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) CreateStockMovement(_a0 context.Context, _a1 model.StockMovement) (model.StockMovement, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStockMovement")
+	}
+
+	var r0 model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockMovement) (model.StockMovement, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockMovement) model.StockMovement); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.StockMovement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.StockMovement) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateStockReservation provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) CreateStockReservation(_a0 context.Context, _a1 model.StockReservation) (model.StockReservation, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStockReservation")
+	}
+
+	var r0 model.StockReservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockReservation) (model.StockReservation, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockReservation) model.StockReservation); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.StockReservation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.StockReservation) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOrderByID provides a mock function with given fields: _a0, _a1
 func (_m *MockRepository) GetOrderByID(_a0 context.Context, _a1 int64) (model.Order, error) {
 	ret := _m.Called(_a0, _a1)
@@ -126,6 +212,34 @@ func (_m *MockRepository) GetOrderByID(_a0 context.Context, _a1 int64) (model.Or
 	return r0, r1
 }
 
+// GetOrderByIDWithLock provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) GetOrderByIDWithLock(_a0 context.Context, _a1 int64) (model.Order, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByIDWithLock")
+	}
+
+	var r0 model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Order, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Order); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Order)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductByID provides a mock function with given fields: _a0, _a1
 func (_m *MockRepository) GetProductByID(_a0 context.Context, _a1 int64) (model.Product, error) {
 	ret := _m.Called(_a0, _a1)
@@ -154,6 +268,34 @@ func (_m *MockRepository) GetProductByID(_a0 context.Context, _a1 int64) (model.
 	return r0, r1
 }
 
+// GetProductByIDWithLock provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) GetProductByIDWithLock(_a0 context.Context, _a1 int64) (model.Product, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByIDWithLock")
+	}
+
+	var r0 model.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Product, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Product); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductByName provides a mock function with given fields: _a0, _a1
 func (_m *MockRepository) GetProductByName(_a0 context.Context, _a1 string) (model.Product, error) {
 	ret := _m.Called(_a0, _a1)
@@ -182,9 +324,127 @@ func (_m *MockRepository) GetProductByName(_a0 context.Context, _a1 string) (mod
 	return r0, r1
 }
 
-// ListProducts provides a mock function with given fields: _a0
-func (_m *MockRepository) ListProducts(_a0 context.Context) ([]model.Product, error) {
-	ret := _m.Called(_a0)
+// IncreaseProductStock provides a mock function with given fields: ctx, id, quantity
+func (_m *MockRepository) IncreaseProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error) {
+	ret := _m.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncreaseProductStock")
+	}
+
+	var r0 model.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Product, error)); ok {
+		return rf(ctx, id, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Product); ok {
+		r0 = rf(ctx, id, quantity)
+	} else {
+		r0 = ret.Get(0).(model.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpiredReservationOrderIDs provides a mock function with given fields: ctx, before, limit
+func (_m *MockRepository) ListExpiredReservationOrderIDs(ctx context.Context, before time.Time, limit int) ([]int64, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpiredReservationOrderIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]int64, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []int64); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrderStockReservations provides a mock function with given fields: ctx, orderID
+func (_m *MockRepository) ListOrderStockReservations(ctx context.Context, orderID int64) ([]model.StockReservation, error) {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrderStockReservations")
+	}
+
+	var r0 []model.StockReservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.StockReservation, error)); ok {
+		return rf(ctx, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.StockReservation); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.StockReservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrders provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) ListOrders(_a0 context.Context, _a1 OrdersFilter) ([]model.Order, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []model.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, OrdersFilter) ([]model.Order, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, OrdersFilter) []model.Order); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, OrdersFilter) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProducts provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) ListProducts(_a0 context.Context, _a1 ProductsFilter) ([]model.Product, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListProducts")
@@ -192,19 +452,123 @@ func (_m *MockRepository) ListProducts(_a0 context.Context) ([]model.Product, er
 
 	var r0 []model.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Product, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, ProductsFilter) ([]model.Product, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []model.Product); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, ProductsFilter) []model.Product); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Product)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, ProductsFilter) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStockMovements provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) ListStockMovements(_a0 context.Context, _a1 StockMovementsFilter) ([]model.StockMovement, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStockMovements")
+	}
+
+	var r0 []model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, StockMovementsFilter) ([]model.StockMovement, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, StockMovementsFilter) []model.StockMovement); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, StockMovementsFilter) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostponeReservationsSweep provides a mock function with given fields: ctx, orderID
+func (_m *MockRepository) PostponeReservationsSweep(ctx context.Context, orderID int64) error {
+	ret := _m.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostponeReservationsSweep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseProductStock provides a mock function with given fields: ctx, id, quantity
+func (_m *MockRepository) ReleaseProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error) {
+	ret := _m.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseProductStock")
+	}
+
+	var r0 model.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Product, error)); ok {
+		return rf(ctx, id, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Product); ok {
+		r0 = rf(ctx, id, quantity)
+	} else {
+		r0 = ret.Get(0).(model.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReserveProductStock provides a mock function with given fields: ctx, id, quantity
+func (_m *MockRepository) ReserveProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error) {
+	ret := _m.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveProductStock")
+	}
+
+	var r0 model.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Product, error)); ok {
+		return rf(ctx, id, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Product); ok {
+		r0 = rf(ctx, id, quantity)
+	} else {
+		r0 = ret.Get(0).(model.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, quantity)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -296,6 +660,34 @@ func (_m *MockRepository) UpdateProduct(_a0 context.Context, _a1 model.Product)
 	return r0, r1
 }
 
+// UpdateStockReservationStatus provides a mock function with given fields: ctx, orderID, status
+func (_m *MockRepository) UpdateStockReservationStatus(ctx context.Context, orderID int64, status model.StockReservationStatus) (int64, error) {
+	ret := _m.Called(ctx, orderID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStockReservationStatus")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.StockReservationStatus) (int64, error)); ok {
+		return rf(ctx, orderID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.StockReservationStatus) int64); ok {
+		r0 = rf(ctx, orderID, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.StockReservationStatus) error); ok {
+		r1 = rf(ctx, orderID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRepository(t interface {
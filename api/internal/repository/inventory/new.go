@@ -2,6 +2,7 @@ package inventory
 
 import (
 	"context"
+	"time"
 
 	"omg/api/internal/model"
 	"omg/api/pkg/db/pg"
@@ -9,17 +10,33 @@ import (
 
 // Repository provides the specification of the functionality provided by this pkg
 type Repository interface {
-	ListProducts(context.Context) ([]model.Product, error)
+	ListProducts(context.Context, ProductsFilter) ([]model.Product, error)
 	CreateProduct(context.Context, model.Product) (model.Product, error)
 	UpdateProduct(context.Context, model.Product) (model.Product, error)
 	GetProductByName(context.Context, string) (model.Product, error)
 	GetProductByID(context.Context, int64) (model.Product, error)
+	GetProductByIDWithLock(context.Context, int64) (model.Product, error)
+	IncreaseProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error)
+	ReserveProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error)
+	ReleaseProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error)
+	ConsumeProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error)
 
 	CreateOrder(context.Context, model.Order) (model.Order, error)
 	CreateOrderItem(context.Context, model.OrderItem) (model.OrderItem, error)
 	UpdateOrder(context.Context, model.Order) (model.Order, error)
 	UpdateOrderItem(context.Context, model.OrderItem) (model.OrderItem, error)
 	GetOrderByID(context.Context, int64) (model.Order, error)
+	GetOrderByIDWithLock(context.Context, int64) (model.Order, error)
+	ListOrders(context.Context, OrdersFilter) ([]model.Order, error)
+
+	CreateStockReservation(context.Context, model.StockReservation) (model.StockReservation, error)
+	ListOrderStockReservations(ctx context.Context, orderID int64) ([]model.StockReservation, error)
+	UpdateStockReservationStatus(ctx context.Context, orderID int64, status model.StockReservationStatus) (int64, error)
+	ListExpiredReservationOrderIDs(ctx context.Context, before time.Time, limit int) ([]int64, error)
+	PostponeReservationsSweep(ctx context.Context, orderID int64) error
+
+	CreateStockMovement(context.Context, model.StockMovement) (model.StockMovement, error)
+	ListStockMovements(context.Context, StockMovementsFilter) ([]model.StockMovement, error)
 }
 
 // New returns an implementation instance satisfying Repository
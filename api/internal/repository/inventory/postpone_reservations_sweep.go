@@ -0,0 +1,23 @@
+package inventory
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// PostponeReservationsSweep backs the sweeper off from the active reservations of the order it failed to expire.
+// The next sweep waits 1 minute, doubling with each failed attempt up to 1 hour.
+func (i impl) PostponeReservationsSweep(ctx context.Context, orderID int64) error {
+	_, err := i.dbConn.ExecContext(ctx,
+		`UPDATE stock_reservations
+		SET sweep_attempts = sweep_attempts + 1,
+			next_sweep_at = now() + LEAST(interval '1 minute' * power(2, LEAST(sweep_attempts, 6)), interval '1 hour'),
+			updated_at = now()
+		WHERE order_id = $1 AND status = $2`,
+		orderID, model.StockReservationStatusActive.String(),
+	)
+	return pkgerrors.WithStack(err)
+}
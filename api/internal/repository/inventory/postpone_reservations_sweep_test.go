@@ -0,0 +1,36 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_PostponeReservationsSweep(t *testing.T) {
+	testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+		// Given:
+		testutil.LoadTestSQLFile(t, dbConn, "testdata/stock_reservations.sql")
+		repo := New(dbConn)
+
+		// When:
+		require.NoError(t, repo.PostponeReservationsSweep(context.Background(), 14753030))
+		require.NoError(t, repo.PostponeReservationsSweep(context.Background(), 14753030))
+
+		// Then: the backoff doubles
+		o, err := orm.FindStockReservation(context.Background(), dbConn, 14753040)
+		require.NoError(t, err)
+		require.Equal(t, 2, o.SweepAttempts)
+		require.WithinDuration(t, time.Now().Add(2*time.Minute), o.NextSweepAt, 10*time.Second)
+
+		// Then: the later expiries are swept meanwhile
+		ids, err := repo.ListExpiredReservationOrderIDs(context.Background(), time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), 1)
+		require.NoError(t, err)
+		require.Equal(t, []int64{14753031}, ids)
+	})
+}
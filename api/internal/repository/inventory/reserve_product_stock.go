@@ -0,0 +1,41 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ReserveProductStock atomically holds the given quantity of the product available stock in DB and returns the updated product.
+// The available stock is checked & reserved in a single statement, so concurrent orders can never oversell the product.
+func (i impl) ReserveProductStock(ctx context.Context, id int64, quantity int64) (model.Product, error) {
+	var o orm.Product
+	err := queries.Raw(
+		`UPDATE products SET reserved = reserved + $1, updated_at = now() WHERE id = $2 AND stock - reserved >= $1 RETURNING *`,
+		quantity, id,
+	).Bind(ctx, i.dbConn, &o)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return model.Product{}, pkgerrors.WithStack(err)
+		}
+
+		// Nothing updated, either the product does not exist or it has not enough stock available
+		exists, err := orm.ProductExists(ctx, i.dbConn, id)
+		if err != nil {
+			return model.Product{}, pkgerrors.WithStack(err)
+		}
+		if !exists {
+			return model.Product{}, ErrProductNotFound
+		}
+
+		return model.Product{}, ErrOutOfStock
+	}
+
+	return toProduct(&o), nil
+}
@@ -0,0 +1,166 @@
+package inventory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ReserveProductStock(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath  string
+		givenCtx      context.Context
+		givenID       int64
+		givenQuantity int64
+		expReserved   int64
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753030,
+			givenQuantity: 20,
+			expReserved:   40,
+		},
+		"success_whole_available_stock": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753030,
+			givenQuantity: 80,
+			expReserved:   100,
+		},
+		"out_of_stock": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753030,
+			givenQuantity: 81,
+			expErr:        ErrOutOfStock,
+		},
+		"out_of_stock_all_reserved": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753031,
+			givenQuantity: 1,
+			expErr:        ErrOutOfStock,
+		},
+		"not_found": {
+			testDataPath:  "testdata/stock_reservations.sql",
+			givenCtx:      context.Background(),
+			givenID:       14753039,
+			givenQuantity: 20,
+			expErr:        ErrProductNotFound,
+		},
+		"ctx_cancelled": {
+			givenCtx:      cancelledCtx,
+			givenID:       14753030,
+			givenQuantity: 20,
+			expErr:        context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				product, err := repo.ReserveProductStock(tc.givenCtx, tc.givenID, tc.givenQuantity)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.givenID, product.ID)
+					require.Equal(t, int64(100), product.Stock)
+					require.Equal(t, tc.expReserved, product.Reserved)
+
+					product, err = repo.GetProductByID(context.Background(), tc.givenID)
+					require.NoError(t, err)
+					require.Equal(t, tc.expReserved, product.Reserved)
+				}
+			})
+		})
+	}
+}
+
+func Test_impl_ReserveProductStock_Concurrent(t *testing.T) {
+	const (
+		productID = 14753090
+		stock     = 10
+		buyers    = 50
+	)
+
+	testutil.WithDB(t, func(dbConn pg.BeginnerExecutor) {
+		// Given:
+		ctx := context.Background()
+		_, err := dbConn.ExecContext(ctx,
+			`INSERT INTO products(id, name, description, status, price, stock) VALUES ($1, 'Concurrent Product', 'test', 'ACTIVE', 10, $2)`,
+			productID, stock)
+		require.NoError(t, err)
+		defer func() {
+			_, err := dbConn.ExecContext(ctx, `DELETE FROM products WHERE id = $1`, productID)
+			require.NoError(t, err)
+		}()
+
+		var (
+			wg         sync.WaitGroup
+			mu         sync.Mutex
+			succeeded  int
+			outOfStock int
+		)
+
+		// When:
+		for i := 0; i < buyers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				// Each buyer runs in its own transaction, the same as order creation does
+				err := pg.Tx(ctx, dbConn, func(tx pg.ContextExecutor) error {
+					_, err := impl{dbConn: tx}.ReserveProductStock(ctx, productID, 1)
+					return err
+				})
+
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case err == nil:
+					succeeded++
+				case pkgerrors.Cause(err) == ErrOutOfStock:
+					outOfStock++
+				default:
+					t.Errorf("unexpected error: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		// Then:
+		require.Equal(t, stock, succeeded)
+		require.Equal(t, buyers-stock, outOfStock)
+
+		product, err := New(dbConn).GetProductByID(ctx, productID)
+		require.NoError(t, err)
+		require.Equal(t, int64(stock), product.Reserved)
+		require.Equal(t, int64(0), product.AvailableStock())
+	})
+}
@@ -0,0 +1,18 @@
+INSERT INTO users(id, name, email, password, status)
+VALUES
+    (14753001,'Test User','test@example.com', 'password123', 'ACTIVE'),
+    (14753002,'Test User2','test2@example.com', 'password@123', 'ACTIVE');
+
+INSERT INTO products(id, name, description, status, price, stock)
+VALUES
+    (14753010, 'Test Product', 'test', 'ACTIVE', 2000, 100);
+
+INSERT INTO orders(id, user_id, status, total_cost, created_at)
+VALUES
+    (14753010, 14753001, 'PENDING', 20, '2024-01-10 00:00:00+00'),
+    (14753011, 14753001, 'PAID', 10, '2024-02-10 00:00:00+00'),
+    (14753012, 14753002, 'PENDING', 30, '2024-01-15 00:00:00+00');
+
+INSERT INTO order_items(id, order_id, product_id, quantity, price)
+VALUES
+    (14753001, 14753010, 14753010, 20, 2000);
@@ -0,0 +1,7 @@
+INSERT INTO products(id, name, description, status, price, stock, reserved, created_at)
+VALUES
+    (14753020, 'Apple Juice', 'test', 'ACTIVE', 10.50, 5, 0, '2024-01-01 00:00:00+00'),
+    (14753021, 'Apple Pie', 'test', 'ACTIVE', 25, 0, 0, '2024-01-02 00:00:00+00'),
+    (14753022, 'Banana', 'test', 'ACTIVE', 3.20, 50, 0, '2024-01-03 00:00:00+00'),
+    (14753024, 'Apple Tart', 'test', 'DELETED', 12, 3, 0, '2024-01-02 00:00:00+00'),
+    (14753025, 'Cherry', 'test', 'ACTIVE', 8, 1, 1, '2024-01-02 00:00:00+00');
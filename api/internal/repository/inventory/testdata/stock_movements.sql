@@ -0,0 +1,15 @@
+INSERT INTO users(id, name, email, password, status)
+VALUES
+    (14753001,'Test User','test@example.com', 'password123', 'ACTIVE');
+
+INSERT INTO products(id, name, description, status, price, stock)
+VALUES
+    (14753050, 'Ledger Product', 'test', 'ACTIVE', 10, 12),
+    (14753051, 'Other Product', 'test', 'ACTIVE', 10, 3);
+
+INSERT INTO stock_movements(id, product_id, delta, balance, reason, order_id, actor_id, seq, created_at)
+VALUES
+    (14753060, 14753050, 10, 10, 'INITIAL', 0, 14753001, 1, '2024-01-01 00:00:00+00'),
+    (14753061, 14753050, -3, 7, 'SALE', 14753070, 14753001, 2, '2024-01-03 00:00:00+00'),
+    (14753062, 14753050, 5, 12, 'ADJUSTMENT', 0, 14753001, 3, '2024-01-02 00:00:00+00'),
+    (14753063, 14753051, 3, 3, 'INITIAL', 0, 14753001, 4, '2024-01-01 00:00:00+00');
@@ -0,0 +1,21 @@
+INSERT INTO users(id, name, email, password, status)
+VALUES
+    (14753001,'Test User','test@example.com', 'password123', 'ACTIVE');
+
+INSERT INTO products(id, name, description, status, price, stock, reserved)
+VALUES
+    (14753030, 'Reserved Product', 'test', 'ACTIVE', 2000, 100, 20),
+    (14753031, 'Sold Out Product', 'test', 'ACTIVE', 10, 5, 5);
+
+INSERT INTO orders(id, user_id, status, total_cost)
+VALUES
+    (14753030, 14753001, 'PENDING', 30050),
+    (14753031, 14753001, 'PENDING', 10000),
+    (14753032, 14753001, 'PAID', 6000);
+
+INSERT INTO stock_reservations(id, order_id, product_id, quantity, status, expires_at)
+VALUES
+    (14753040, 14753030, 14753030, 15, 'ACTIVE', '2024-01-01 00:10:00+00'),
+    (14753041, 14753030, 14753031, 5, 'ACTIVE', '2024-01-01 00:10:00+00'),
+    (14753042, 14753031, 14753030, 5, 'ACTIVE', '2099-01-01 00:00:00+00'),
+    (14753043, 14753032, 14753030, 3, 'CONSUMED', '2024-01-01 00:05:00+00');
@@ -10,6 +10,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,7 +35,7 @@ func Test_impl_UpdateOrderItem(t *testing.T) {
 				OrderID:   14753010,
 				ProductID: 14753010,
 				Quantity:  30,
-				Price:     60000,
+				Price:     decimal.RequireFromString("60000"),
 			},
 		},
 		"ctx_cancelled": {
@@ -44,7 +45,7 @@ func Test_impl_UpdateOrderItem(t *testing.T) {
 				OrderID:   14753010,
 				ProductID: 14753010,
 				Quantity:  30,
-				Price:     60000,
+				Price:     decimal.RequireFromString("60000"),
 			},
 			expErr: context.Canceled,
 		},
@@ -56,7 +57,7 @@ func Test_impl_UpdateOrderItem(t *testing.T) {
 				OrderID:   14753010,
 				ProductID: 14753010,
 				Quantity:  30,
-				Price:     60000,
+				Price:     decimal.RequireFromString("60000"),
 			},
 			expErr: ErrOrderItemNotFound,
 		},
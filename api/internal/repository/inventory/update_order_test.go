@@ -10,6 +10,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,7 +34,7 @@ func Test_impl_UpdateOrder(t *testing.T) {
 				ID:        14753010,
 				UserID:    14753001,
 				Status:    model.OrderStatusPending,
-				TotalCost: 30,
+				TotalCost: decimal.RequireFromString("30"),
 			},
 		},
 		"ctx_cancelled": {
@@ -42,7 +43,7 @@ func Test_impl_UpdateOrder(t *testing.T) {
 				ID:        14753010,
 				UserID:    14753001,
 				Status:    model.OrderStatusPending,
-				TotalCost: 30,
+				TotalCost: decimal.RequireFromString("30"),
 			},
 			expErr: context.Canceled,
 		},
@@ -53,7 +54,7 @@ func Test_impl_UpdateOrder(t *testing.T) {
 				ID:        14753012,
 				UserID:    14753001,
 				Status:    model.OrderStatusPending,
-				TotalCost: 30,
+				TotalCost: decimal.RequireFromString("30"),
 			},
 			expErr: ErrOrderNotFound,
 		},
@@ -38,6 +38,7 @@ func (i impl) UpdateProduct(ctx context.Context, p model.Product) (model.Product
 		return model.Product{}, pkgerrors.WithStack(err)
 	}
 
+	p.Reserved = o.Reserved
 	p.UpdatedAt = o.UpdatedAt
 
 	return p, nil
@@ -10,6 +10,7 @@ import (
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,7 +35,7 @@ func Test_impl_UpdateProduct(t *testing.T) {
 				Name:        "Test Product",
 				Description: "test",
 				Status:      model.ProductStatusActive,
-				Price:       3000,
+				Price:       decimal.RequireFromString("3000"),
 				Stock:       200,
 			},
 		},
@@ -45,7 +46,7 @@ func Test_impl_UpdateProduct(t *testing.T) {
 				Name:        "Test Product",
 				Description: "test",
 				Status:      model.ProductStatusActive,
-				Price:       3000,
+				Price:       decimal.RequireFromString("3000"),
 				Stock:       200,
 			},
 			expErr: context.Canceled,
@@ -58,7 +59,7 @@ func Test_impl_UpdateProduct(t *testing.T) {
 				Name:        "Test Product",
 				Description: "test",
 				Status:      model.ProductStatusActive,
-				Price:       3000,
+				Price:       decimal.RequireFromString("3000"),
 				Stock:       200,
 			},
 			expErr: ErrProductNotFound,
@@ -0,0 +1,27 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// UpdateStockReservationStatus moves the active stock reservations of the order to the given status in DB & returns how many moved
+func (i impl) UpdateStockReservationStatus(ctx context.Context, orderID int64, status model.StockReservationStatus) (int64, error) {
+	updated, err := orm.StockReservations(
+		orm.StockReservationWhere.OrderID.EQ(orderID),
+		orm.StockReservationWhere.Status.EQ(model.StockReservationStatusActive.String()),
+	).UpdateAll(ctx, i.dbConn, orm.M{
+		orm.StockReservationColumns.Status:    status.String(),
+		orm.StockReservationColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return 0, pkgerrors.WithStack(err)
+	}
+
+	return updated, nil
+}
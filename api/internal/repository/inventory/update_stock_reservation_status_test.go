@@ -0,0 +1,81 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_UpdateStockReservationStatus(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath string
+		givenCtx     context.Context
+		givenOrderID int64
+		givenStatus  model.StockReservationStatus
+		expUpdated   int64
+		expErr       error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenOrderID: 14753030,
+			givenStatus:  model.StockReservationStatusReleased,
+			expUpdated:   2,
+		},
+		"no_active_reservations": {
+			testDataPath: "testdata/stock_reservations.sql",
+			givenCtx:     context.Background(),
+			givenOrderID: 14753032,
+			givenStatus:  model.StockReservationStatusReleased,
+			expUpdated:   0,
+		},
+		"ctx_cancelled": {
+			givenCtx:     cancelledCtx,
+			givenOrderID: 14753030,
+			givenStatus:  model.StockReservationStatusReleased,
+			expErr:       context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				updated, err := repo.UpdateStockReservationStatus(tc.givenCtx, tc.givenOrderID, tc.givenStatus)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.expUpdated, updated)
+
+					reservations, err := repo.ListOrderStockReservations(context.Background(), tc.givenOrderID)
+					require.NoError(t, err)
+					for _, r := range reservations {
+						require.NotEqual(t, model.StockReservationStatusActive, r.Status)
+					}
+				}
+			})
+		})
+	}
+}
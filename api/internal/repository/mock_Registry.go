@@ -7,13 +7,19 @@ import (
 
 	backoff "github.com/cenkalti/backoff/v4"
 
+	idempotency "omg/api/internal/repository/idempotency"
+
 	inventory "omg/api/internal/repository/inventory"
 
 	mock "github.com/stretchr/testify/mock"
 
+	outbox "omg/api/internal/repository/outbox"
+
 	system "omg/api/internal/repository/system"
 
 	user "omg/api/internal/repository/user"
+
+	webhook "omg/api/internal/repository/webhook"
 )
 
 // MockRegistry is an autogenerated mock type for the Registry type
@@ -21,6 +27,29 @@ type MockRegistry struct {
 	mock.Mock
 }
 
+// AfterCommit provides a mock function with given fields: f
+func (_m *MockRegistry) AfterCommit(f func()) {
+	_m.Called(f)
+}
+
+// DoInSavepoint provides a mock function with given fields: ctx, spFunc
+func (_m *MockRegistry) DoInSavepoint(ctx context.Context, spFunc func(context.Context) error) error {
+	ret := _m.Called(ctx, spFunc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DoInSavepoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, spFunc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DoInTx provides a mock function with given fields: ctx, txFunc, overrideBackoffPolicy
 func (_m *MockRegistry) DoInTx(ctx context.Context, txFunc func(context.Context, Registry) error, overrideBackoffPolicy backoff.BackOff) error {
 	ret := _m.Called(ctx, txFunc, overrideBackoffPolicy)
@@ -39,6 +68,26 @@ func (_m *MockRegistry) DoInTx(ctx context.Context, txFunc func(context.Context,
 	return r0
 }
 
+// Idempotency provides a mock function with given fields:
+func (_m *MockRegistry) Idempotency() idempotency.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Idempotency")
+	}
+
+	var r0 idempotency.Repository
+	if rf, ok := ret.Get(0).(func() idempotency.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(idempotency.Repository)
+		}
+	}
+
+	return r0
+}
+
 // Inventory provides a mock function with given fields:
 func (_m *MockRegistry) Inventory() inventory.Repository {
 	ret := _m.Called()
@@ -59,6 +108,44 @@ func (_m *MockRegistry) Inventory() inventory.Repository {
 	return r0
 }
 
+// Notify provides a mock function with given fields: ctx, channel, payload
+func (_m *MockRegistry) Notify(ctx context.Context, channel string, payload string) error {
+	ret := _m.Called(ctx, channel, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, channel, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Outbox provides a mock function with given fields:
+func (_m *MockRegistry) Outbox() outbox.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Outbox")
+	}
+
+	var r0 outbox.Repository
+	if rf, ok := ret.Get(0).(func() outbox.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(outbox.Repository)
+		}
+	}
+
+	return r0
+}
+
 // System provides a mock function with given fields:
 func (_m *MockRegistry) System() system.Repository {
 	ret := _m.Called()
@@ -99,6 +186,26 @@ func (_m *MockRegistry) User() user.Repository {
 	return r0
 }
 
+// Webhook provides a mock function with given fields:
+func (_m *MockRegistry) Webhook() webhook.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Webhook")
+	}
+
+	var r0 webhook.Repository
+	if rf, ok := ret.Get(0).(func() webhook.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(webhook.Repository)
+		}
+	}
+
+	return r0
+}
+
 // NewMockRegistry creates a new instance of MockRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRegistry(t interface {
@@ -4,13 +4,27 @@
 package orm
 
 var TableNames = struct {
-	OrderItems string
-	Orders     string
-	Products   string
-	Users      string
+	IdempotencyKeys   string
+	OrderItems        string
+	Orders            string
+	OutboxEvents      string
+	Products          string
+	RefreshTokens     string
+	StockMovements    string
+	StockReservations string
+	Users             string
+	WebhookDeliveries string
+	WebhookEndpoints  string
 }{
-	OrderItems: "order_items",
-	Orders:     "orders",
-	Products:   "products",
-	Users:      "users",
+	IdempotencyKeys:   "idempotency_keys",
+	OrderItems:        "order_items",
+	Orders:            "orders",
+	OutboxEvents:      "outbox_events",
+	Products:          "products",
+	RefreshTokens:     "refresh_tokens",
+	StockMovements:    "stock_movements",
+	StockReservations: "stock_reservations",
+	Users:             "users",
+	WebhookDeliveries: "webhook_deliveries",
+	WebhookEndpoints:  "webhook_endpoints",
 }
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,12 +24,12 @@ import (
 
 // Order is an object representing the database table.
 type Order struct {
-	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID    int64     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Status    string    `boil:"status" json:"status" toml:"status" yaml:"status"`
-	TotalCost float64   `boil:"total_cost" json:"total_cost" toml:"total_cost" yaml:"total_cost"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID        int64           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    int64           `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Status    string          `boil:"status" json:"status" toml:"status" yaml:"status"`
+	TotalCost decimal.Decimal `boil:"total_cost" json:"total_cost" toml:"total_cost" yaml:"total_cost"`
+	CreatedAt time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *orderR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L orderL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -68,62 +69,38 @@ var OrderTableColumns = struct {
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 var OrderWhere = struct {
 	ID        whereHelperint64
 	UserID    whereHelperint64
 	Status    whereHelperstring
-	TotalCost whereHelperfloat64
+	TotalCost whereHelperdecimal_Decimal
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
 }{
 	ID:        whereHelperint64{field: "\"orders\".\"id\""},
 	UserID:    whereHelperint64{field: "\"orders\".\"user_id\""},
 	Status:    whereHelperstring{field: "\"orders\".\"status\""},
-	TotalCost: whereHelperfloat64{field: "\"orders\".\"total_cost\""},
+	TotalCost: whereHelperdecimal_Decimal{field: "\"orders\".\"total_cost\""},
 	CreatedAt: whereHelpertime_Time{field: "\"orders\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"orders\".\"updated_at\""},
 }
 
 // OrderRels is where relationship names are stored.
 var OrderRels = struct {
-	User       string
-	OrderItems string
+	User              string
+	OrderItems        string
+	StockReservations string
 }{
-	User:       "User",
-	OrderItems: "OrderItems",
+	User:              "User",
+	OrderItems:        "OrderItems",
+	StockReservations: "StockReservations",
 }
 
 // orderR is where relationships are stored.
 type orderR struct {
-	User       *User          `boil:"User" json:"User" toml:"User" yaml:"User"`
-	OrderItems OrderItemSlice `boil:"OrderItems" json:"OrderItems" toml:"OrderItems" yaml:"OrderItems"`
+	User              *User                 `boil:"User" json:"User" toml:"User" yaml:"User"`
+	OrderItems        OrderItemSlice        `boil:"OrderItems" json:"OrderItems" toml:"OrderItems" yaml:"OrderItems"`
+	StockReservations StockReservationSlice `boil:"StockReservations" json:"StockReservations" toml:"StockReservations" yaml:"StockReservations"`
 }
 
 // NewStruct creates a new relationship struct
@@ -145,6 +122,13 @@ func (r *orderR) GetOrderItems() OrderItemSlice {
 	return r.OrderItems
 }
 
+func (r *orderR) GetStockReservations() StockReservationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.StockReservations
+}
+
 // orderL is where Load methods for each relationship are stored.
 type orderL struct{}
 
@@ -272,6 +256,20 @@ func (o *Order) OrderItems(mods ...qm.QueryMod) orderItemQuery {
 	return OrderItems(queryMods...)
 }
 
+// StockReservations retrieves all the stock_reservation's StockReservations with an executor.
+func (o *Order) StockReservations(mods ...qm.QueryMod) stockReservationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"stock_reservations\".\"order_id\"=?", o.ID),
+	)
+
+	return StockReservations(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (orderL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
@@ -490,6 +488,112 @@ func (orderL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
+// LoadStockReservations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (orderL) LoadStockReservations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOrder interface{}, mods queries.Applicator) error {
+	var slice []*Order
+	var object *Order
+
+	if singular {
+		var ok bool
+		object, ok = maybeOrder.(*Order)
+		if !ok {
+			object = new(Order)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOrder))
+			}
+		}
+	} else {
+		s, ok := maybeOrder.(*[]*Order)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOrder)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOrder))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &orderR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &orderR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`stock_reservations`),
+		qm.WhereIn(`stock_reservations.order_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load stock_reservations")
+	}
+
+	var resultSlice []*StockReservation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice stock_reservations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on stock_reservations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for stock_reservations")
+	}
+
+	if singular {
+		object.R.StockReservations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &stockReservationR{}
+			}
+			foreign.R.Order = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrderID {
+				local.R.StockReservations = append(local.R.StockReservations, foreign)
+				if foreign.R == nil {
+					foreign.R = &stockReservationR{}
+				}
+				foreign.R.Order = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetUser of the order to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.Orders.
@@ -590,6 +694,59 @@ func (o *Order) AddOrderItems(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
+// AddStockReservations adds the given related objects to the existing relationships
+// of the order, optionally inserting them as new records.
+// Appends related to o.R.StockReservations.
+// Sets related.R.Order appropriately.
+func (o *Order) AddStockReservations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StockReservation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrderID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"stock_reservations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"order_id"}),
+				strmangle.WhereClause("\"", "\"", 2, stockReservationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrderID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &orderR{
+			StockReservations: related,
+		}
+	} else {
+		o.R.StockReservations = append(o.R.StockReservations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &stockReservationR{
+				Order: o,
+			}
+		} else {
+			rel.R.Order = o
+		}
+	}
+	return nil
+}
+
 // Orders retrieves all the records using an executor.
 func Orders(mods ...qm.QueryMod) orderQuery {
 	mods = append(mods, qm.From("\"orders\""))
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,14 +24,15 @@ import (
 
 // Product is an object representing the database table.
 type Product struct {
-	ID          int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name        string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Description string    `boil:"description" json:"description" toml:"description" yaml:"description"`
-	Status      string    `boil:"status" json:"status" toml:"status" yaml:"status"`
-	Price       float64   `boil:"price" json:"price" toml:"price" yaml:"price"`
-	Stock       int64     `boil:"stock" json:"stock" toml:"stock" yaml:"stock"`
-	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID          int64           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string          `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description string          `boil:"description" json:"description" toml:"description" yaml:"description"`
+	Status      string          `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Price       decimal.Decimal `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Stock       int64           `boil:"stock" json:"stock" toml:"stock" yaml:"stock"`
+	Reserved    int64           `boil:"reserved" json:"reserved" toml:"reserved" yaml:"reserved"`
+	CreatedAt   time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *productR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L productL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -43,6 +45,7 @@ var ProductColumns = struct {
 	Status      string
 	Price       string
 	Stock       string
+	Reserved    string
 	CreatedAt   string
 	UpdatedAt   string
 }{
@@ -52,6 +55,7 @@ var ProductColumns = struct {
 	Status:      "status",
 	Price:       "price",
 	Stock:       "stock",
+	Reserved:    "reserved",
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
 }
@@ -63,6 +67,7 @@ var ProductTableColumns = struct {
 	Status      string
 	Price       string
 	Stock       string
+	Reserved    string
 	CreatedAt   string
 	UpdatedAt   string
 }{
@@ -72,6 +77,7 @@ var ProductTableColumns = struct {
 	Status:      "products.status",
 	Price:       "products.price",
 	Stock:       "products.stock",
+	Reserved:    "products.reserved",
 	CreatedAt:   "products.created_at",
 	UpdatedAt:   "products.updated_at",
 }
@@ -83,8 +89,9 @@ var ProductWhere = struct {
 	Name        whereHelperstring
 	Description whereHelperstring
 	Status      whereHelperstring
-	Price       whereHelperfloat64
+	Price       whereHelperdecimal_Decimal
 	Stock       whereHelperint64
+	Reserved    whereHelperint64
 	CreatedAt   whereHelpertime_Time
 	UpdatedAt   whereHelpertime_Time
 }{
@@ -92,22 +99,29 @@ var ProductWhere = struct {
 	Name:        whereHelperstring{field: "\"products\".\"name\""},
 	Description: whereHelperstring{field: "\"products\".\"description\""},
 	Status:      whereHelperstring{field: "\"products\".\"status\""},
-	Price:       whereHelperfloat64{field: "\"products\".\"price\""},
+	Price:       whereHelperdecimal_Decimal{field: "\"products\".\"price\""},
 	Stock:       whereHelperint64{field: "\"products\".\"stock\""},
+	Reserved:    whereHelperint64{field: "\"products\".\"reserved\""},
 	CreatedAt:   whereHelpertime_Time{field: "\"products\".\"created_at\""},
 	UpdatedAt:   whereHelpertime_Time{field: "\"products\".\"updated_at\""},
 }
 
 // ProductRels is where relationship names are stored.
 var ProductRels = struct {
-	OrderItems string
+	OrderItems        string
+	StockMovements    string
+	StockReservations string
 }{
-	OrderItems: "OrderItems",
+	OrderItems:        "OrderItems",
+	StockMovements:    "StockMovements",
+	StockReservations: "StockReservations",
 }
 
 // productR is where relationships are stored.
 type productR struct {
-	OrderItems OrderItemSlice `boil:"OrderItems" json:"OrderItems" toml:"OrderItems" yaml:"OrderItems"`
+	OrderItems        OrderItemSlice        `boil:"OrderItems" json:"OrderItems" toml:"OrderItems" yaml:"OrderItems"`
+	StockMovements    StockMovementSlice    `boil:"StockMovements" json:"StockMovements" toml:"StockMovements" yaml:"StockMovements"`
+	StockReservations StockReservationSlice `boil:"StockReservations" json:"StockReservations" toml:"StockReservations" yaml:"StockReservations"`
 }
 
 // NewStruct creates a new relationship struct
@@ -122,13 +136,27 @@ func (r *productR) GetOrderItems() OrderItemSlice {
 	return r.OrderItems
 }
 
+func (r *productR) GetStockMovements() StockMovementSlice {
+	if r == nil {
+		return nil
+	}
+	return r.StockMovements
+}
+
+func (r *productR) GetStockReservations() StockReservationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.StockReservations
+}
+
 // productL is where Load methods for each relationship are stored.
 type productL struct{}
 
 var (
-	productAllColumns            = []string{"id", "name", "description", "status", "price", "stock", "created_at", "updated_at"}
+	productAllColumns            = []string{"id", "name", "description", "status", "price", "stock", "reserved", "created_at", "updated_at"}
 	productColumnsWithoutDefault = []string{"id", "name", "description", "status", "price", "stock"}
-	productColumnsWithDefault    = []string{"created_at", "updated_at"}
+	productColumnsWithDefault    = []string{"reserved", "created_at", "updated_at"}
 	productPrimaryKeyColumns     = []string{"id"}
 	productGeneratedColumns      = []string{}
 )
@@ -238,6 +266,34 @@ func (o *Product) OrderItems(mods ...qm.QueryMod) orderItemQuery {
 	return OrderItems(queryMods...)
 }
 
+// StockMovements retrieves all the stock_movement's StockMovements with an executor.
+func (o *Product) StockMovements(mods ...qm.QueryMod) stockMovementQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"stock_movements\".\"product_id\"=?", o.ID),
+	)
+
+	return StockMovements(queryMods...)
+}
+
+// StockReservations retrieves all the stock_reservation's StockReservations with an executor.
+func (o *Product) StockReservations(mods ...qm.QueryMod) stockReservationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"stock_reservations\".\"product_id\"=?", o.ID),
+	)
+
+	return StockReservations(queryMods...)
+}
+
 // LoadOrderItems allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
@@ -344,6 +400,218 @@ func (productL) LoadOrderItems(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
+// LoadStockMovements allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadStockMovements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`stock_movements`),
+		qm.WhereIn(`stock_movements.product_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load stock_movements")
+	}
+
+	var resultSlice []*StockMovement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice stock_movements")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on stock_movements")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for stock_movements")
+	}
+
+	if singular {
+		object.R.StockMovements = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &stockMovementR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.StockMovements = append(local.R.StockMovements, foreign)
+				if foreign.R == nil {
+					foreign.R = &stockMovementR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStockReservations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadStockReservations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`stock_reservations`),
+		qm.WhereIn(`stock_reservations.product_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load stock_reservations")
+	}
+
+	var resultSlice []*StockReservation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice stock_reservations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on stock_reservations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for stock_reservations")
+	}
+
+	if singular {
+		object.R.StockReservations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &stockReservationR{}
+			}
+			foreign.R.Product = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ProductID {
+				local.R.StockReservations = append(local.R.StockReservations, foreign)
+				if foreign.R == nil {
+					foreign.R = &stockReservationR{}
+				}
+				foreign.R.Product = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // AddOrderItems adds the given related objects to the existing relationships
 // of the product, optionally inserting them as new records.
 // Appends related to o.R.OrderItems.
@@ -397,6 +665,112 @@ func (o *Product) AddOrderItems(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddStockMovements adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.StockMovements.
+// Sets related.R.Product appropriately.
+func (o *Product) AddStockMovements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StockMovement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"stock_movements\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, stockMovementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			StockMovements: related,
+		}
+	} else {
+		o.R.StockMovements = append(o.R.StockMovements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &stockMovementR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
+// AddStockReservations adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.StockReservations.
+// Sets related.R.Product appropriately.
+func (o *Product) AddStockReservations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StockReservation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ProductID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"stock_reservations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, stockReservationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ProductID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			StockReservations: related,
+		}
+	} else {
+		o.R.StockReservations = append(o.R.StockReservations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &stockReservationR{
+				Product: o,
+			}
+		} else {
+			rel.R.Product = o
+		}
+	}
+	return nil
+}
+
 // Products retrieves all the records using an executor.
 func Products(mods ...qm.QueryMod) productQuery {
 	mods = append(mods, qm.From("\"products\""))
@@ -0,0 +1,1116 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// StockReservation is an object representing the database table.
+type StockReservation struct {
+	ID            int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OrderID       int64     `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	ProductID     int64     `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	Quantity      int64     `boil:"quantity" json:"quantity" toml:"quantity" yaml:"quantity"`
+	Status        string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ExpiresAt     time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	SweepAttempts int       `boil:"sweep_attempts" json:"sweep_attempts" toml:"sweep_attempts" yaml:"sweep_attempts"`
+	NextSweepAt   time.Time `boil:"next_sweep_at" json:"next_sweep_at" toml:"next_sweep_at" yaml:"next_sweep_at"`
+
+	R *stockReservationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L stockReservationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var StockReservationColumns = struct {
+	ID            string
+	OrderID       string
+	ProductID     string
+	Quantity      string
+	Status        string
+	ExpiresAt     string
+	CreatedAt     string
+	UpdatedAt     string
+	SweepAttempts string
+	NextSweepAt   string
+}{
+	ID:            "id",
+	OrderID:       "order_id",
+	ProductID:     "product_id",
+	Quantity:      "quantity",
+	Status:        "status",
+	ExpiresAt:     "expires_at",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	SweepAttempts: "sweep_attempts",
+	NextSweepAt:   "next_sweep_at",
+}
+
+var StockReservationTableColumns = struct {
+	ID            string
+	OrderID       string
+	ProductID     string
+	Quantity      string
+	Status        string
+	ExpiresAt     string
+	CreatedAt     string
+	UpdatedAt     string
+	SweepAttempts string
+	NextSweepAt   string
+}{
+	ID:            "stock_reservations.id",
+	OrderID:       "stock_reservations.order_id",
+	ProductID:     "stock_reservations.product_id",
+	Quantity:      "stock_reservations.quantity",
+	Status:        "stock_reservations.status",
+	ExpiresAt:     "stock_reservations.expires_at",
+	CreatedAt:     "stock_reservations.created_at",
+	UpdatedAt:     "stock_reservations.updated_at",
+	SweepAttempts: "stock_reservations.sweep_attempts",
+	NextSweepAt:   "stock_reservations.next_sweep_at",
+}
+
+// Generated where
+
+var StockReservationWhere = struct {
+	ID            whereHelperint64
+	OrderID       whereHelperint64
+	ProductID     whereHelperint64
+	Quantity      whereHelperint64
+	Status        whereHelperstring
+	ExpiresAt     whereHelpertime_Time
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	SweepAttempts whereHelperint
+	NextSweepAt   whereHelpertime_Time
+}{
+	ID:            whereHelperint64{field: "\"stock_reservations\".\"id\""},
+	OrderID:       whereHelperint64{field: "\"stock_reservations\".\"order_id\""},
+	ProductID:     whereHelperint64{field: "\"stock_reservations\".\"product_id\""},
+	Quantity:      whereHelperint64{field: "\"stock_reservations\".\"quantity\""},
+	Status:        whereHelperstring{field: "\"stock_reservations\".\"status\""},
+	ExpiresAt:     whereHelpertime_Time{field: "\"stock_reservations\".\"expires_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"stock_reservations\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"stock_reservations\".\"updated_at\""},
+	SweepAttempts: whereHelperint{field: "\"stock_reservations\".\"sweep_attempts\""},
+	NextSweepAt:   whereHelpertime_Time{field: "\"stock_reservations\".\"next_sweep_at\""},
+}
+
+// StockReservationRels is where relationship names are stored.
+var StockReservationRels = struct {
+	Order   string
+	Product string
+}{
+	Order:   "Order",
+	Product: "Product",
+}
+
+// stockReservationR is where relationships are stored.
+type stockReservationR struct {
+	Order   *Order   `boil:"Order" json:"Order" toml:"Order" yaml:"Order"`
+	Product *Product `boil:"Product" json:"Product" toml:"Product" yaml:"Product"`
+}
+
+// NewStruct creates a new relationship struct
+func (*stockReservationR) NewStruct() *stockReservationR {
+	return &stockReservationR{}
+}
+
+func (r *stockReservationR) GetOrder() *Order {
+	if r == nil {
+		return nil
+	}
+	return r.Order
+}
+
+func (r *stockReservationR) GetProduct() *Product {
+	if r == nil {
+		return nil
+	}
+	return r.Product
+}
+
+// stockReservationL is where Load methods for each relationship are stored.
+type stockReservationL struct{}
+
+var (
+	stockReservationAllColumns            = []string{"id", "order_id", "product_id", "quantity", "status", "expires_at", "created_at", "updated_at", "sweep_attempts", "next_sweep_at"}
+	stockReservationColumnsWithoutDefault = []string{"id", "order_id", "product_id", "quantity", "expires_at"}
+	stockReservationColumnsWithDefault    = []string{"status", "created_at", "updated_at", "sweep_attempts", "next_sweep_at"}
+	stockReservationPrimaryKeyColumns     = []string{"id"}
+	stockReservationGeneratedColumns      = []string{}
+)
+
+type (
+	// StockReservationSlice is an alias for a slice of pointers to StockReservation.
+	// This should almost always be used instead of []StockReservation.
+	StockReservationSlice []*StockReservation
+
+	stockReservationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	stockReservationType                 = reflect.TypeOf(&StockReservation{})
+	stockReservationMapping              = queries.MakeStructMapping(stockReservationType)
+	stockReservationPrimaryKeyMapping, _ = queries.BindMapping(stockReservationType, stockReservationMapping, stockReservationPrimaryKeyColumns)
+	stockReservationInsertCacheMut       sync.RWMutex
+	stockReservationInsertCache          = make(map[string]insertCache)
+	stockReservationUpdateCacheMut       sync.RWMutex
+	stockReservationUpdateCache          = make(map[string]updateCache)
+	stockReservationUpsertCacheMut       sync.RWMutex
+	stockReservationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single stockReservation record from the query.
+func (q stockReservationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*StockReservation, error) {
+	o := &StockReservation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for stock_reservations")
+	}
+
+	return o, nil
+}
+
+// All returns all StockReservation records from the query.
+func (q stockReservationQuery) All(ctx context.Context, exec boil.ContextExecutor) (StockReservationSlice, error) {
+	var o []*StockReservation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to StockReservation slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all StockReservation records in the query.
+func (q stockReservationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count stock_reservations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q stockReservationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if stock_reservations exists")
+	}
+
+	return count > 0, nil
+}
+
+// Order pointed to by the foreign key.
+func (o *StockReservation) Order(mods ...qm.QueryMod) orderQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OrderID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Orders(queryMods...)
+}
+
+// Product pointed to by the foreign key.
+func (o *StockReservation) Product(mods ...qm.QueryMod) productQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Products(queryMods...)
+}
+
+// LoadOrder allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (stockReservationL) LoadOrder(ctx context.Context, e boil.ContextExecutor, singular bool, maybeStockReservation interface{}, mods queries.Applicator) error {
+	var slice []*StockReservation
+	var object *StockReservation
+
+	if singular {
+		var ok bool
+		object, ok = maybeStockReservation.(*StockReservation)
+		if !ok {
+			object = new(StockReservation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeStockReservation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeStockReservation))
+			}
+		}
+	} else {
+		s, ok := maybeStockReservation.(*[]*StockReservation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeStockReservation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeStockReservation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &stockReservationR{}
+		}
+		args[object.OrderID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &stockReservationR{}
+			}
+
+			args[obj.OrderID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`orders`),
+		qm.WhereIn(`orders.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Order")
+	}
+
+	var resultSlice []*Order
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Order")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for orders")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orders")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Order = foreign
+		if foreign.R == nil {
+			foreign.R = &orderR{}
+		}
+		foreign.R.StockReservations = append(foreign.R.StockReservations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OrderID == foreign.ID {
+				local.R.Order = foreign
+				if foreign.R == nil {
+					foreign.R = &orderR{}
+				}
+				foreign.R.StockReservations = append(foreign.R.StockReservations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (stockReservationL) LoadProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybeStockReservation interface{}, mods queries.Applicator) error {
+	var slice []*StockReservation
+	var object *StockReservation
+
+	if singular {
+		var ok bool
+		object, ok = maybeStockReservation.(*StockReservation)
+		if !ok {
+			object = new(StockReservation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeStockReservation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeStockReservation))
+			}
+		}
+	} else {
+		s, ok := maybeStockReservation.(*[]*StockReservation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeStockReservation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeStockReservation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &stockReservationR{}
+		}
+		args[object.ProductID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &stockReservationR{}
+			}
+
+			args[obj.ProductID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Product = foreign
+		if foreign.R == nil {
+			foreign.R = &productR{}
+		}
+		foreign.R.StockReservations = append(foreign.R.StockReservations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ProductID == foreign.ID {
+				local.R.Product = foreign
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.StockReservations = append(foreign.R.StockReservations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOrder of the stockReservation to the related item.
+// Sets o.R.Order to related.
+// Adds o to related.R.StockReservations.
+func (o *StockReservation) SetOrder(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Order) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"stock_reservations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"order_id"}),
+		strmangle.WhereClause("\"", "\"", 2, stockReservationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OrderID = related.ID
+	if o.R == nil {
+		o.R = &stockReservationR{
+			Order: related,
+		}
+	} else {
+		o.R.Order = related
+	}
+
+	if related.R == nil {
+		related.R = &orderR{
+			StockReservations: StockReservationSlice{o},
+		}
+	} else {
+		related.R.StockReservations = append(related.R.StockReservations, o)
+	}
+
+	return nil
+}
+
+// SetProduct of the stockReservation to the related item.
+// Sets o.R.Product to related.
+// Adds o to related.R.StockReservations.
+func (o *StockReservation) SetProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Product) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"stock_reservations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, stockReservationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ProductID = related.ID
+	if o.R == nil {
+		o.R = &stockReservationR{
+			Product: related,
+		}
+	} else {
+		o.R.Product = related
+	}
+
+	if related.R == nil {
+		related.R = &productR{
+			StockReservations: StockReservationSlice{o},
+		}
+	} else {
+		related.R.StockReservations = append(related.R.StockReservations, o)
+	}
+
+	return nil
+}
+
+// StockReservations retrieves all the records using an executor.
+func StockReservations(mods ...qm.QueryMod) stockReservationQuery {
+	mods = append(mods, qm.From("\"stock_reservations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"stock_reservations\".*"})
+	}
+
+	return stockReservationQuery{q}
+}
+
+// FindStockReservation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindStockReservation(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*StockReservation, error) {
+	stockReservationObj := &StockReservation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"stock_reservations\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, stockReservationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from stock_reservations")
+	}
+
+	return stockReservationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *StockReservation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no stock_reservations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(stockReservationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	stockReservationInsertCacheMut.RLock()
+	cache, cached := stockReservationInsertCache[key]
+	stockReservationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			stockReservationAllColumns,
+			stockReservationColumnsWithDefault,
+			stockReservationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(stockReservationType, stockReservationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(stockReservationType, stockReservationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"stock_reservations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"stock_reservations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into stock_reservations")
+	}
+
+	if !cached {
+		stockReservationInsertCacheMut.Lock()
+		stockReservationInsertCache[key] = cache
+		stockReservationInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the StockReservation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *StockReservation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	stockReservationUpdateCacheMut.RLock()
+	cache, cached := stockReservationUpdateCache[key]
+	stockReservationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			stockReservationAllColumns,
+			stockReservationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update stock_reservations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"stock_reservations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, stockReservationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(stockReservationType, stockReservationMapping, append(wl, stockReservationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update stock_reservations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for stock_reservations")
+	}
+
+	if !cached {
+		stockReservationUpdateCacheMut.Lock()
+		stockReservationUpdateCache[key] = cache
+		stockReservationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q stockReservationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for stock_reservations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for stock_reservations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o StockReservationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), stockReservationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"stock_reservations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, stockReservationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in stockReservation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all stockReservation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *StockReservation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no stock_reservations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(stockReservationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	stockReservationUpsertCacheMut.RLock()
+	cache, cached := stockReservationUpsertCache[key]
+	stockReservationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			stockReservationAllColumns,
+			stockReservationColumnsWithDefault,
+			stockReservationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			stockReservationAllColumns,
+			stockReservationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert stock_reservations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(stockReservationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(stockReservationPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert stock_reservations, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(stockReservationPrimaryKeyColumns))
+			copy(conflict, stockReservationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"stock_reservations\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(stockReservationType, stockReservationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(stockReservationType, stockReservationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert stock_reservations")
+	}
+
+	if !cached {
+		stockReservationUpsertCacheMut.Lock()
+		stockReservationUpsertCache[key] = cache
+		stockReservationUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single StockReservation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *StockReservation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no StockReservation provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), stockReservationPrimaryKeyMapping)
+	sql := "DELETE FROM \"stock_reservations\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from stock_reservations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for stock_reservations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q stockReservationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no stockReservationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from stock_reservations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for stock_reservations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o StockReservationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), stockReservationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"stock_reservations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, stockReservationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from stockReservation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for stock_reservations")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *StockReservation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindStockReservation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StockReservationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := StockReservationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), stockReservationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"stock_reservations\".* FROM \"stock_reservations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, stockReservationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in StockReservationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// StockReservationExists checks if the StockReservation row exists.
+func StockReservationExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"stock_reservations\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if stock_reservations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the StockReservation row exists.
+func (o *StockReservation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return StockReservationExists(ctx, exec, o.ID)
+}
@@ -28,6 +28,7 @@ type User struct {
 	Email     string    `boil:"email" json:"email" toml:"email" yaml:"email"`
 	Password  string    `boil:"password" json:"password" toml:"password" yaml:"password"`
 	Status    string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Role      string    `boil:"role" json:"role" toml:"role" yaml:"role"`
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
@@ -41,6 +42,7 @@ var UserColumns = struct {
 	Email     string
 	Password  string
 	Status    string
+	Role      string
 	CreatedAt string
 	UpdatedAt string
 }{
@@ -49,6 +51,7 @@ var UserColumns = struct {
 	Email:     "email",
 	Password:  "password",
 	Status:    "status",
+	Role:      "role",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
 }
@@ -59,6 +62,7 @@ var UserTableColumns = struct {
 	Email     string
 	Password  string
 	Status    string
+	Role      string
 	CreatedAt string
 	UpdatedAt string
 }{
@@ -67,6 +71,7 @@ var UserTableColumns = struct {
 	Email:     "users.email",
 	Password:  "users.password",
 	Status:    "users.status",
+	Role:      "users.role",
 	CreatedAt: "users.created_at",
 	UpdatedAt: "users.updated_at",
 }
@@ -79,6 +84,7 @@ var UserWhere = struct {
 	Email     whereHelperstring
 	Password  whereHelperstring
 	Status    whereHelperstring
+	Role      whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
 }{
@@ -87,20 +93,27 @@ var UserWhere = struct {
 	Email:     whereHelperstring{field: "\"users\".\"email\""},
 	Password:  whereHelperstring{field: "\"users\".\"password\""},
 	Status:    whereHelperstring{field: "\"users\".\"status\""},
+	Role:      whereHelperstring{field: "\"users\".\"role\""},
 	CreatedAt: whereHelpertime_Time{field: "\"users\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"users\".\"updated_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Orders string
+	IdempotencyKeys string
+	Orders          string
+	RefreshTokens   string
 }{
-	Orders: "Orders",
+	IdempotencyKeys: "IdempotencyKeys",
+	Orders:          "Orders",
+	RefreshTokens:   "RefreshTokens",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Orders OrderSlice `boil:"Orders" json:"Orders" toml:"Orders" yaml:"Orders"`
+	IdempotencyKeys IdempotencyKeySlice `boil:"IdempotencyKeys" json:"IdempotencyKeys" toml:"IdempotencyKeys" yaml:"IdempotencyKeys"`
+	Orders          OrderSlice          `boil:"Orders" json:"Orders" toml:"Orders" yaml:"Orders"`
+	RefreshTokens   RefreshTokenSlice   `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
 }
 
 // NewStruct creates a new relationship struct
@@ -108,6 +121,13 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetIdempotencyKeys() IdempotencyKeySlice {
+	if r == nil {
+		return nil
+	}
+	return r.IdempotencyKeys
+}
+
 func (r *userR) GetOrders() OrderSlice {
 	if r == nil {
 		return nil
@@ -115,13 +135,20 @@ func (r *userR) GetOrders() OrderSlice {
 	return r.Orders
 }
 
+func (r *userR) GetRefreshTokens() RefreshTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RefreshTokens
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "name", "email", "password", "status", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "name", "email", "password", "status", "role", "created_at", "updated_at"}
 	userColumnsWithoutDefault = []string{"id", "name", "email", "password", "status"}
-	userColumnsWithDefault    = []string{"created_at", "updated_at"}
+	userColumnsWithDefault    = []string{"role", "created_at", "updated_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -217,6 +244,20 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// IdempotencyKeys retrieves all the idempotency_key's IdempotencyKeys with an executor.
+func (o *User) IdempotencyKeys(mods ...qm.QueryMod) idempotencyKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"idempotency_keys\".\"user_id\"=?", o.ID),
+	)
+
+	return IdempotencyKeys(queryMods...)
+}
+
 // Orders retrieves all the order's Orders with an executor.
 func (o *User) Orders(mods ...qm.QueryMod) orderQuery {
 	var queryMods []qm.QueryMod
@@ -231,6 +272,126 @@ func (o *User) Orders(mods ...qm.QueryMod) orderQuery {
 	return Orders(queryMods...)
 }
 
+// RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
+func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return RefreshTokens(queryMods...)
+}
+
+// LoadIdempotencyKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadIdempotencyKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`idempotency_keys`),
+		qm.WhereIn(`idempotency_keys.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load idempotency_keys")
+	}
+
+	var resultSlice []*IdempotencyKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice idempotency_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on idempotency_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for idempotency_keys")
+	}
+
+	if singular {
+		object.R.IdempotencyKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &idempotencyKeyR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.IdempotencyKeys = append(local.R.IdempotencyKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &idempotencyKeyR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadOrders allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadOrders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -337,6 +498,165 @@ func (userL) LoadOrders(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddIdempotencyKeys adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.IdempotencyKeys.
+// Sets related.R.User appropriately.
+func (o *User) AddIdempotencyKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*IdempotencyKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"idempotency_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, idempotencyKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Key}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			IdempotencyKeys: related,
+		}
+	} else {
+		o.R.IdempotencyKeys = append(o.R.IdempotencyKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &idempotencyKeyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddOrders adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.Orders.
@@ -390,6 +710,59 @@ func (o *User) AddOrders(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RefreshTokens: related,
+		}
+	} else {
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -0,0 +1,919 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebhookEndpoint is an object representing the database table.
+type WebhookEndpoint struct {
+	ID         int64           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	URL        string          `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Secret     string          `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	EventTypes json.RawMessage `boil:"event_types" json:"event_types" toml:"event_types" yaml:"event_types"`
+	Status     string          `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt  time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time       `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *webhookEndpointR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookEndpointL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookEndpointColumns = struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes string
+	Status     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	URL:        "url",
+	Secret:     "secret",
+	EventTypes: "event_types",
+	Status:     "status",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var WebhookEndpointTableColumns = struct {
+	ID         string
+	URL        string
+	Secret     string
+	EventTypes string
+	Status     string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "webhook_endpoints.id",
+	URL:        "webhook_endpoints.url",
+	Secret:     "webhook_endpoints.secret",
+	EventTypes: "webhook_endpoints.event_types",
+	Status:     "webhook_endpoints.status",
+	CreatedAt:  "webhook_endpoints.created_at",
+	UpdatedAt:  "webhook_endpoints.updated_at",
+}
+
+// Generated where
+
+var WebhookEndpointWhere = struct {
+	ID         whereHelperint64
+	URL        whereHelperstring
+	Secret     whereHelperstring
+	EventTypes whereHelperjson_RawMessage
+	Status     whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint64{field: "\"webhook_endpoints\".\"id\""},
+	URL:        whereHelperstring{field: "\"webhook_endpoints\".\"url\""},
+	Secret:     whereHelperstring{field: "\"webhook_endpoints\".\"secret\""},
+	EventTypes: whereHelperjson_RawMessage{field: "\"webhook_endpoints\".\"event_types\""},
+	Status:     whereHelperstring{field: "\"webhook_endpoints\".\"status\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"webhook_endpoints\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"webhook_endpoints\".\"updated_at\""},
+}
+
+// WebhookEndpointRels is where relationship names are stored.
+var WebhookEndpointRels = struct {
+	EndpointWebhookDeliveries string
+}{
+	EndpointWebhookDeliveries: "EndpointWebhookDeliveries",
+}
+
+// webhookEndpointR is where relationships are stored.
+type webhookEndpointR struct {
+	EndpointWebhookDeliveries WebhookDeliverySlice `boil:"EndpointWebhookDeliveries" json:"EndpointWebhookDeliveries" toml:"EndpointWebhookDeliveries" yaml:"EndpointWebhookDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookEndpointR) NewStruct() *webhookEndpointR {
+	return &webhookEndpointR{}
+}
+
+func (r *webhookEndpointR) GetEndpointWebhookDeliveries() WebhookDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.EndpointWebhookDeliveries
+}
+
+// webhookEndpointL is where Load methods for each relationship are stored.
+type webhookEndpointL struct{}
+
+var (
+	webhookEndpointAllColumns            = []string{"id", "url", "secret", "event_types", "status", "created_at", "updated_at"}
+	webhookEndpointColumnsWithoutDefault = []string{"id", "url", "secret", "event_types"}
+	webhookEndpointColumnsWithDefault    = []string{"status", "created_at", "updated_at"}
+	webhookEndpointPrimaryKeyColumns     = []string{"id"}
+	webhookEndpointGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookEndpointSlice is an alias for a slice of pointers to WebhookEndpoint.
+	// This should almost always be used instead of []WebhookEndpoint.
+	WebhookEndpointSlice []*WebhookEndpoint
+
+	webhookEndpointQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookEndpointType                 = reflect.TypeOf(&WebhookEndpoint{})
+	webhookEndpointMapping              = queries.MakeStructMapping(webhookEndpointType)
+	webhookEndpointPrimaryKeyMapping, _ = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, webhookEndpointPrimaryKeyColumns)
+	webhookEndpointInsertCacheMut       sync.RWMutex
+	webhookEndpointInsertCache          = make(map[string]insertCache)
+	webhookEndpointUpdateCacheMut       sync.RWMutex
+	webhookEndpointUpdateCache          = make(map[string]updateCache)
+	webhookEndpointUpsertCacheMut       sync.RWMutex
+	webhookEndpointUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single webhookEndpoint record from the query.
+func (q webhookEndpointQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebhookEndpoint, error) {
+	o := &WebhookEndpoint{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for webhook_endpoints")
+	}
+
+	return o, nil
+}
+
+// All returns all WebhookEndpoint records from the query.
+func (q webhookEndpointQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebhookEndpointSlice, error) {
+	var o []*WebhookEndpoint
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to WebhookEndpoint slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebhookEndpoint records in the query.
+func (q webhookEndpointQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count webhook_endpoints rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookEndpointQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if webhook_endpoints exists")
+	}
+
+	return count > 0, nil
+}
+
+// EndpointWebhookDeliveries retrieves all the webhook_delivery's WebhookDeliveries with an executor via endpoint_id column.
+func (o *WebhookEndpoint) EndpointWebhookDeliveries(mods ...qm.QueryMod) webhookDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_deliveries\".\"endpoint_id\"=?", o.ID),
+	)
+
+	return WebhookDeliveries(queryMods...)
+}
+
+// LoadEndpointWebhookDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (webhookEndpointL) LoadEndpointWebhookDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebhookEndpoint interface{}, mods queries.Applicator) error {
+	var slice []*WebhookEndpoint
+	var object *WebhookEndpoint
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebhookEndpoint.(*WebhookEndpoint)
+		if !ok {
+			object = new(WebhookEndpoint)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebhookEndpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebhookEndpoint))
+			}
+		}
+	} else {
+		s, ok := maybeWebhookEndpoint.(*[]*WebhookEndpoint)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebhookEndpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebhookEndpoint))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webhookEndpointR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webhookEndpointR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_deliveries`),
+		qm.WhereIn(`webhook_deliveries.endpoint_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_deliveries")
+	}
+
+	var resultSlice []*WebhookDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_deliveries")
+	}
+
+	if singular {
+		object.R.EndpointWebhookDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookDeliveryR{}
+			}
+			foreign.R.Endpoint = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.EndpointID {
+				local.R.EndpointWebhookDeliveries = append(local.R.EndpointWebhookDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookDeliveryR{}
+				}
+				foreign.R.Endpoint = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddEndpointWebhookDeliveries adds the given related objects to the existing relationships
+// of the webhook_endpoint, optionally inserting them as new records.
+// Appends related to o.R.EndpointWebhookDeliveries.
+// Sets related.R.Endpoint appropriately.
+func (o *WebhookEndpoint) AddEndpointWebhookDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebhookDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.EndpointID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"endpoint_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.EndpointID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &webhookEndpointR{
+			EndpointWebhookDeliveries: related,
+		}
+	} else {
+		o.R.EndpointWebhookDeliveries = append(o.R.EndpointWebhookDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookDeliveryR{
+				Endpoint: o,
+			}
+		} else {
+			rel.R.Endpoint = o
+		}
+	}
+	return nil
+}
+
+// WebhookEndpoints retrieves all the records using an executor.
+func WebhookEndpoints(mods ...qm.QueryMod) webhookEndpointQuery {
+	mods = append(mods, qm.From("\"webhook_endpoints\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_endpoints\".*"})
+	}
+
+	return webhookEndpointQuery{q}
+}
+
+// FindWebhookEndpoint retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookEndpoint(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*WebhookEndpoint, error) {
+	webhookEndpointObj := &WebhookEndpoint{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_endpoints\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webhookEndpointObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from webhook_endpoints")
+	}
+
+	return webhookEndpointObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookEndpoint) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no webhook_endpoints provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEndpointColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookEndpointInsertCacheMut.RLock()
+	cache, cached := webhookEndpointInsertCache[key]
+	webhookEndpointInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointColumnsWithDefault,
+			webhookEndpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_endpoints\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_endpoints\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointInsertCacheMut.Lock()
+		webhookEndpointInsertCache[key] = cache
+		webhookEndpointInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the WebhookEndpoint.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookEndpoint) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	webhookEndpointUpdateCacheMut.RLock()
+	cache, cached := webhookEndpointUpdateCache[key]
+	webhookEndpointUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update webhook_endpoints, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webhookEndpointPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, append(wl, webhookEndpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update webhook_endpoints row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointUpdateCacheMut.Lock()
+		webhookEndpointUpdateCache[key] = cache
+		webhookEndpointUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookEndpointQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookEndpointSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webhookEndpointPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in webhookEndpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all webhookEndpoint")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookEndpoint) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no webhook_endpoints provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEndpointColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookEndpointUpsertCacheMut.RLock()
+	cache, cached := webhookEndpointUpsertCache[key]
+	webhookEndpointUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointColumnsWithDefault,
+			webhookEndpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert webhook_endpoints, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webhookEndpointAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webhookEndpointPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert webhook_endpoints, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webhookEndpointPrimaryKeyColumns))
+			copy(conflict, webhookEndpointPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webhook_endpoints\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointUpsertCacheMut.Lock()
+		webhookEndpointUpsertCache[key] = cache
+		webhookEndpointUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single WebhookEndpoint record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookEndpoint) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no WebhookEndpoint provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookEndpointPrimaryKeyMapping)
+	sql := "DELETE FROM \"webhook_endpoints\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookEndpointQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no webhookEndpointQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookEndpointSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webhook_endpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEndpointPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from webhookEndpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookEndpoint) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebhookEndpoint(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookEndpointSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookEndpointSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_endpoints\".* FROM \"webhook_endpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEndpointPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in WebhookEndpointSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookEndpointExists checks if the WebhookEndpoint row exists.
+func WebhookEndpointExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_endpoints\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if webhook_endpoints exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebhookEndpoint row exists.
+func (o *WebhookEndpoint) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebhookEndpointExists(ctx, exec, o.ID)
+}
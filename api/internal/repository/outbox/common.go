@@ -0,0 +1,22 @@
+package outbox
+
+import (
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+)
+
+func toEvent(o *orm.OutboxEvent) model.Event {
+	return model.Event{
+		ID:           o.ID,
+		Type:         model.EventType(o.Type),
+		AggregateID:  o.AggregateID,
+		UserID:       o.UserID,
+		Payload:      o.Payload,
+		Status:       model.EventStatus(o.Status),
+		Attempts:     o.Attempts,
+		LastError:    o.LastError,
+		PublishedSeq: o.PublishedSeq,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+}
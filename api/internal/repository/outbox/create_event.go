@@ -0,0 +1,29 @@
+package outbox
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// CreateEvent saves the event in the outbox. The ID is taken from the DB sequence when the row is inserted, so it
+// follows the insertion order, not the commit order: a tx holding a lower ID may commit after one holding a higher ID.
+func (i impl) CreateEvent(ctx context.Context, m model.Event) (model.Event, error) {
+	o := orm.OutboxEvent{
+		Type:        m.Type.String(),
+		AggregateID: m.AggregateID,
+		UserID:      m.UserID,
+		Payload:     m.Payload,
+		Status:      model.EventStatusPending.String(),
+	}
+
+	if err := o.Insert(ctx, i.dbConn, boil.Infer()); err != nil {
+		return model.Event{}, pkgerrors.WithStack(err)
+	}
+
+	return toEvent(&o), nil
+}
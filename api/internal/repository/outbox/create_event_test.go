@@ -0,0 +1,73 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_CreateEvent(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		givenCtx   context.Context
+		givenEvent model.Event
+		expErr     error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenCtx: context.Background(),
+			givenEvent: model.Event{
+				Type:        model.EventTypeOrderCreated,
+				AggregateID: 14753010,
+				UserID:      14753020,
+				Payload:     json.RawMessage(`{"order_id": "14753010"}`),
+			},
+		},
+		"ctx_cancelled": {
+			givenCtx: cancelledCtx,
+			givenEvent: model.Event{
+				Type:        model.EventTypeOrderCreated,
+				AggregateID: 14753010,
+				Payload:     json.RawMessage(`{"order_id": "14753010"}`),
+			},
+			expErr: context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				repo := New(dbConn)
+
+				// When:
+				event, err := repo.CreateEvent(tc.givenCtx, tc.givenEvent)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.NotZero(t, event.ID)
+					require.Equal(t, tc.givenEvent.Type, event.Type)
+					require.Equal(t, tc.givenEvent.AggregateID, event.AggregateID)
+					require.Equal(t, tc.givenEvent.UserID, event.UserID)
+					require.JSONEq(t, string(tc.givenEvent.Payload), string(event.Payload))
+					require.Equal(t, model.EventStatusPending, event.Status)
+					require.Zero(t, event.Attempts)
+					require.Zero(t, event.PublishedSeq)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package outbox
+
+import "errors"
+
+var (
+	ErrEventNotFound = errors.New("event not found")
+)
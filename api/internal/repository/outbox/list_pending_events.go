@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ListPendingEvents gets up to limit pending events, oldest first, and locks them until the surrounding tx ends.
+// Events already locked by another dispatcher are skipped, so several instances can dispatch at the same time.
+// It must be called within a DB tx.
+func (i impl) ListPendingEvents(ctx context.Context, limit int) ([]model.Event, error) {
+	slice, err := orm.OutboxEvents(
+		orm.OutboxEventWhere.Status.EQ(model.EventStatusPending.String()),
+		qm.OrderBy(orm.OutboxEventColumns.ID),
+		qm.Limit(limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(ctx, i.dbConn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var result []model.Event
+	for _, o := range slice {
+		result = append(result, toEvent(o))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListPendingEvents(t *testing.T) {
+	type arg struct {
+		testDataPath string
+		givenLimit   int
+		expIDs       []int64
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/events.sql",
+			givenLimit:   10,
+			expIDs:       []int64{14753001, 14753003},
+		},
+		"limited": {
+			testDataPath: "testdata/events.sql",
+			givenLimit:   1,
+			expIDs:       []int64{14753001},
+		},
+		"empty": {
+			givenLimit: 10,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+
+				// When:
+				events, err := repo.ListPendingEvents(context.Background(), tc.givenLimit)
+
+				// Then:
+				require.NoError(t, err)
+				var ids []int64
+				for _, e := range events {
+					ids = append(ids, e.ID)
+				}
+				require.Equal(t, tc.expIDs, ids)
+			})
+		})
+	}
+}
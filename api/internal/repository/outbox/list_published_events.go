@@ -0,0 +1,48 @@
+package outbox
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ListPublishedEvents gets up to limit published events matching the filter from the given seq onwards (included),
+// in publishing order. Only the events still retained are returned, see PurgePublishedEvents.
+func (i impl) ListPublishedEvents(ctx context.Context, filter model.PublishedEventFilter, fromSeq int64, limit int) ([]model.Event, error) {
+	types := make([]string, len(filter.Types))
+	for idx, t := range filter.Types {
+		types[idx] = t.String()
+	}
+
+	qms := []qm.QueryMod{
+		orm.OutboxEventWhere.Status.EQ(model.EventStatusPublished.String()),
+		orm.OutboxEventWhere.PublishedSeq.GTE(fromSeq),
+		orm.OutboxEventWhere.Type.IN(types),
+	}
+	if filter.AggregateID != 0 {
+		qms = append(qms, orm.OutboxEventWhere.AggregateID.EQ(filter.AggregateID))
+	}
+	if filter.UserID != 0 {
+		qms = append(qms, orm.OutboxEventWhere.UserID.EQ(filter.UserID))
+	}
+	qms = append(qms,
+		qm.OrderBy(orm.OutboxEventColumns.PublishedSeq),
+		qm.Limit(limit),
+	)
+
+	slice, err := orm.OutboxEvents(qms...).All(ctx, i.dbConn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var result []model.Event
+	for _, o := range slice {
+		result = append(result, toEvent(o))
+	}
+
+	return result, nil
+}
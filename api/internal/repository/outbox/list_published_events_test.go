@@ -0,0 +1,92 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListPublishedEvents(t *testing.T) {
+	orderEvents := []model.EventType{model.EventTypeOrderCreated, model.EventTypeOrderStatusChanged}
+
+	type arg struct {
+		givenFilter  model.PublishedEventFilter
+		givenFromSeq int64
+		givenLimit   int
+		expIDs       []int64
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenFilter:  model.PublishedEventFilter{Types: orderEvents},
+			givenFromSeq: 5000,
+			givenLimit:   10,
+			expIDs:       []int64{14753002, 14753006, 14753005},
+		},
+		"from_included": {
+			givenFilter:  model.PublishedEventFilter{Types: orderEvents},
+			givenFromSeq: 5002,
+			givenLimit:   10,
+			expIDs:       []int64{14753006, 14753005},
+		},
+		"limit": {
+			givenFilter:  model.PublishedEventFilter{Types: orderEvents},
+			givenFromSeq: 5000,
+			givenLimit:   1,
+			expIDs:       []int64{14753002},
+		},
+		"of_user": {
+			givenFilter:  model.PublishedEventFilter{Types: orderEvents, UserID: 14753020},
+			givenFromSeq: 5000,
+			givenLimit:   10,
+			expIDs:       []int64{14753002, 14753006},
+		},
+		"of_order": {
+			givenFilter:  model.PublishedEventFilter{Types: orderEvents, AggregateID: 14753011},
+			givenFromSeq: 5000,
+			givenLimit:   10,
+			expIDs:       []int64{14753005},
+		},
+		"of_order_of_other_user": {
+			givenFilter:  model.PublishedEventFilter{Types: orderEvents, AggregateID: 14753011, UserID: 14753020},
+			givenFromSeq: 5000,
+			givenLimit:   10,
+		},
+		"of_type": {
+			givenFilter:  model.PublishedEventFilter{Types: []model.EventType{model.EventTypeProductStockChanged}},
+			givenFromSeq: 5000,
+			givenLimit:   10,
+			expIDs:       []int64{14753007},
+		},
+		"none_after": {
+			givenFilter:  model.PublishedEventFilter{Types: orderEvents},
+			givenFromSeq: 5004,
+			givenLimit:   10,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/events.sql")
+				repo := New(dbConn)
+
+				// When:
+				events, err := repo.ListPublishedEvents(context.Background(), tc.givenFilter, tc.givenFromSeq, tc.givenLimit)
+
+				// Then:
+				require.NoError(t, err)
+				var ids []int64
+				for _, e := range events {
+					ids = append(ids, e.ID)
+				}
+				require.Equal(t, tc.expIDs, ids)
+			})
+		})
+	}
+}
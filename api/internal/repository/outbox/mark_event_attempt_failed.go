@@ -0,0 +1,37 @@
+package outbox
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// MarkEventAttemptFailed counts a failed publishing attempt of the event & keeps its error.
+// Once maxAttempts is reached the event is marked as failed & not dispatched anymore.
+func (i impl) MarkEventAttemptFailed(ctx context.Context, id int64, lastErr string, maxAttempts int) error {
+	rs, err := i.dbConn.ExecContext(ctx,
+		`UPDATE outbox_events
+		SET attempts = attempts + 1,
+			last_error = $1,
+			status = CASE WHEN attempts + 1 >= $2 THEN $3 ELSE status END,
+			updated_at = now()
+		WHERE id = $4`,
+		lastErr, maxAttempts, model.EventStatusFailed.String(), id,
+	)
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	rowsAff, err := rs.RowsAffected()
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if rowsAff == 0 {
+		return pkgerrors.WithStack(ErrEventNotFound)
+	}
+
+	return nil
+}
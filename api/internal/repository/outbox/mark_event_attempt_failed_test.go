@@ -0,0 +1,65 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_MarkEventAttemptFailed(t *testing.T) {
+	type arg struct {
+		givenID     int64
+		expAttempts int
+		expStatus   model.EventStatus
+		expErr      error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenID:     14753001,
+			expAttempts: 1,
+			expStatus:   model.EventStatusPending,
+		},
+		"max_attempts_reached": {
+			givenID:     14753003,
+			expAttempts: 10,
+			expStatus:   model.EventStatusFailed,
+		},
+		"not_found": {
+			givenID: 14753099,
+			expErr:  ErrEventNotFound,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/events.sql")
+				repo := New(dbConn)
+
+				// When:
+				err := repo.MarkEventAttemptFailed(context.Background(), tc.givenID, "sink down", 10)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+
+					o, err := orm.FindOutboxEvent(context.Background(), dbConn, tc.givenID)
+					require.NoError(t, err)
+					require.Equal(t, tc.expAttempts, o.Attempts)
+					require.Equal(t, tc.expStatus.String(), o.Status)
+					require.Equal(t, "sink down", o.LastError)
+				}
+			})
+		})
+	}
+}
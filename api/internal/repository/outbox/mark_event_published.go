@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// MarkEventPublished marks the event as published with its seq, see NextPublishedSeq, so it is not dispatched again
+func (i impl) MarkEventPublished(ctx context.Context, id, seq int64) error {
+	rowsAff, err := orm.OutboxEvents(
+		orm.OutboxEventWhere.ID.EQ(id),
+	).UpdateAll(ctx, i.dbConn, orm.M{
+		orm.OutboxEventColumns.Status:       model.EventStatusPublished.String(),
+		orm.OutboxEventColumns.PublishedSeq: seq,
+		orm.OutboxEventColumns.LastError:    "",
+		orm.OutboxEventColumns.UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if rowsAff == 0 {
+		return pkgerrors.WithStack(ErrEventNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+)
+
+// Repository provides the specification of the functionality provided by this pkg
+type Repository interface {
+	// CreateEvent records an event in the outbox, to be published once the surrounding tx commits
+	CreateEvent(context.Context, model.Event) (model.Event, error)
+	// ListPendingEvents gets the oldest pending events & locks them until the surrounding tx ends
+	ListPendingEvents(context.Context, int) ([]model.Event, error)
+	// NextPublishedSeq assigns the seq of an event being published, in the order the publishing txs commit
+	NextPublishedSeq(context.Context) (int64, error)
+	// MarkEventPublished marks the event as published with its seq
+	MarkEventPublished(context.Context, int64, int64) error
+	// MarkEventAttemptFailed records a failed publishing attempt
+	MarkEventAttemptFailed(context.Context, int64, string, int) error
+	// ListPublishedEvents gets the published events matching the filter from the given seq onwards, to replay them
+	ListPublishedEvents(context.Context, model.PublishedEventFilter, int64, int) ([]model.Event, error)
+	// PurgePublishedEvents deletes the events published before the given time
+	PurgePublishedEvents(context.Context, time.Time) (int64, error)
+}
+
+// New returns an implementation instance satisfying Repository
+func New(dbConn pg.ContextExecutor) Repository {
+	return impl{dbConn: dbConn}
+}
+
+type impl struct {
+	dbConn pg.ContextExecutor
+}
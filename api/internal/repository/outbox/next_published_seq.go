@@ -0,0 +1,23 @@
+package outbox
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// NextPublishedSeq assigns the seq of an event being published. The txs assigning seqs are serialized with a lock held
+// until they end, so a tx cannot commit a lower seq than one already committed: a client resuming after the last seq it
+// saw misses nothing. Seqs of rolled back txs are skipped, leaving gaps. It must be called within a DB tx.
+func (i impl) NextPublishedSeq(ctx context.Context) (int64, error) {
+	if _, err := i.dbConn.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext('outbox_events_publish_seq'))"); err != nil {
+		return 0, pkgerrors.WithStack(err)
+	}
+
+	var seq int64
+	if err := i.dbConn.QueryRowContext(ctx, "SELECT nextval('outbox_events_publish_seq')").Scan(&seq); err != nil {
+		return 0, pkgerrors.WithStack(err)
+	}
+
+	return seq, nil
+}
@@ -0,0 +1,27 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_NextPublishedSeq(t *testing.T) {
+	testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+		// Given:
+		repo := New(dbConn)
+
+		// When:
+		first, err := repo.NextPublishedSeq(context.Background())
+		require.NoError(t, err)
+		second, err := repo.NextPublishedSeq(context.Background())
+		require.NoError(t, err)
+
+		// Then: the lock is reentrant within the tx
+		require.Greater(t, second, first)
+	})
+}
@@ -0,0 +1,25 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// PurgePublishedEvents deletes the events published before the given time & returns how many were deleted.
+// Failed events are kept for inspection.
+func (i impl) PurgePublishedEvents(ctx context.Context, before time.Time) (int64, error) {
+	rowsAff, err := orm.OutboxEvents(
+		orm.OutboxEventWhere.Status.EQ(model.EventStatusPublished.String()),
+		orm.OutboxEventWhere.UpdatedAt.LT(before),
+	).DeleteAll(ctx, i.dbConn)
+	if err != nil {
+		return 0, pkgerrors.WithStack(err)
+	}
+
+	return rowsAff, nil
+}
@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_PurgePublishedEvents(t *testing.T) {
+	testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+		// Given:
+		testutil.LoadTestSQLFile(t, dbConn, "testdata/events.sql")
+		repo := New(dbConn)
+
+		// When:
+		deleted, err := repo.PurgePublishedEvents(context.Background(), time.Now().Add(time.Hour))
+
+		// Then: only the published events are gone
+		require.NoError(t, err)
+		require.Equal(t, int64(4), deleted)
+
+		count, err := orm.OutboxEvents().Count(context.Background(), dbConn)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), count)
+	})
+}
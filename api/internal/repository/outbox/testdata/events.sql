@@ -0,0 +1,10 @@
+INSERT INTO outbox_events(id, type, aggregate_id, user_id, payload, status, attempts, published_seq)
+VALUES
+    (14753001, 'order.created', 14753010, 14753020, '{"order_id":"14753010"}', 'PENDING', 0, 0),
+    (14753002, 'order.status_changed', 14753010, 14753020, '{"order_id":"14753010"}', 'PUBLISHED', 0, 5001),
+    (14753003, 'order.created', 14753011, 14753021, '{"order_id":"14753011"}', 'PENDING', 9, 0),
+    (14753004, 'order.created', 14753012, 14753021, '{"order_id":"14753012"}', 'FAILED', 10, 0),
+    -- Published in another order than inserted
+    (14753005, 'order.status_changed', 14753011, 14753021, '{"order_id":"14753011"}', 'PUBLISHED', 0, 5003),
+    (14753006, 'order.status_changed', 14753010, 14753020, '{"order_id":"14753010"}', 'PUBLISHED', 0, 5002),
+    (14753007, 'product.stock_changed', 14753030, 0, '{"product_id":"14753030"}', 'PUBLISHED', 0, 5004);
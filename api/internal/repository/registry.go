@@ -4,9 +4,12 @@ import (
 	"context"
 	"time"
 
+	"omg/api/internal/repository/idempotency"
 	"omg/api/internal/repository/inventory"
+	"omg/api/internal/repository/outbox"
 	"omg/api/internal/repository/system"
 	"omg/api/internal/repository/user"
+	"omg/api/internal/repository/webhook"
 	"omg/api/pkg/db/pg"
 
 	"github.com/cenkalti/backoff/v4"
@@ -21,26 +24,47 @@ type Registry interface {
 	Inventory() inventory.Repository
 	// User returns the User repo
 	User() user.Repository
+	// Outbox returns the Outbox repo
+	Outbox() outbox.Repository
+	// Webhook returns the Webhook repo
+	Webhook() webhook.Repository
+	// Idempotency returns the Idempotency repo
+	Idempotency() idempotency.Repository
 	// DoInTx wraps operations within a db tx
 	DoInTx(ctx context.Context, txFunc func(ctx context.Context, txRepo Registry) error, overrideBackoffPolicy backoff.BackOff) error
+	// DoInSavepoint wraps operations within a savepoint of the ongoing db tx, so their failure only rolls back their own
+	// changes & the tx can go on
+	DoInSavepoint(ctx context.Context, spFunc func(ctx context.Context) error) error
+	// Notify sends a Postgres notification on the channel. Within a db tx, it is only delivered once the tx commits.
+	Notify(ctx context.Context, channel, payload string) error
+	// AfterCommit calls f once the db tx commits & drops it if the tx rolls back. Outside of a db tx, f is called
+	// right away.
+	AfterCommit(f func())
 }
 
 // New returns a new instance of Registry
 func New(dbConn pg.BeginnerExecutor) Registry {
 	return impl{
-		dbConn:    dbConn,
-		system:    system.New(dbConn),
-		inventory: inventory.New(dbConn),
-		user:      user.New(dbConn),
+		dbConn:      dbConn,
+		system:      system.New(dbConn),
+		inventory:   inventory.New(dbConn),
+		user:        user.New(dbConn),
+		outbox:      outbox.New(dbConn),
+		webhook:     webhook.New(dbConn),
+		idempotency: idempotency.New(dbConn),
 	}
 }
 
 type impl struct {
-	dbConn    pg.BeginnerExecutor // Only used to start DB txns
-	tx        pg.ContextExecutor  // Only used to keep track if txn has already been started to prevent devs from accidentally creating nested txns
-	system    system.Repository
-	inventory inventory.Repository
-	user      user.Repository
+	dbConn      pg.BeginnerExecutor // Only used to start DB txns
+	tx          pg.ContextExecutor  // Only used to keep track if txn has already been started to prevent devs from accidentally creating nested txns
+	afterCommit *[]func()           // The funcs to call once the txn commits
+	system      system.Repository
+	inventory   inventory.Repository
+	user        user.Repository
+	outbox      outbox.Repository
+	webhook     webhook.Repository
+	idempotency idempotency.Repository
 }
 
 // System returns the system repo
@@ -58,6 +82,21 @@ func (i impl) User() user.Repository {
 	return i.user
 }
 
+// Outbox returns the Outbox repo
+func (i impl) Outbox() outbox.Repository {
+	return i.outbox
+}
+
+// Webhook returns the Webhook repo
+func (i impl) Webhook() webhook.Repository {
+	return i.webhook
+}
+
+// Idempotency returns the Idempotency repo
+func (i impl) Idempotency() idempotency.Repository {
+	return i.idempotency
+}
+
 // DoInTx wraps operations within a db tx
 func (i impl) DoInTx(ctx context.Context, txFunc func(ctx context.Context, txRepo Registry) error, overrideBackoffPolicy backoff.BackOff) error {
 	if i.tx != nil {
@@ -68,13 +107,68 @@ func (i impl) DoInTx(ctx context.Context, txFunc func(ctx context.Context, txRep
 		overrideBackoffPolicy = pg.ExponentialBackOff(3, time.Minute)
 	}
 
-	return pg.TxWithBackOff(ctx, overrideBackoffPolicy, i.dbConn, func(tx pg.ContextExecutor) error {
+	var afterCommit []func()
+	if err := pg.TxWithBackOff(ctx, overrideBackoffPolicy, i.dbConn, func(tx pg.ContextExecutor) error {
 		newI := impl{
-			tx:        tx,
-			system:    system.New(tx),
-			inventory: inventory.New(tx),
-			user:      user.New(tx),
+			tx:          tx,
+			afterCommit: &afterCommit,
+			system:      system.New(tx),
+			inventory:   inventory.New(tx),
+			user:        user.New(tx),
+			outbox:      outbox.New(tx),
+			webhook:     webhook.New(tx),
+			idempotency: idempotency.New(tx),
 		}
 		return txFunc(ctx, newI)
-	})
+	}); err != nil {
+		return err
+	}
+
+	for _, f := range afterCommit {
+		f()
+	}
+
+	return nil
+}
+
+// DoInSavepoint wraps operations within a savepoint of the ongoing db tx, so their failure only rolls back their own
+// changes, notifications & after commit funcs, & the tx can go on
+func (i impl) DoInSavepoint(ctx context.Context, spFunc func(ctx context.Context) error) error {
+	if i.tx == nil {
+		return pkgerrors.WithStack(errNoTx)
+	}
+
+	if _, err := i.tx.ExecContext(ctx, "SAVEPOINT registry_savepoint"); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	afterCommit := len(*i.afterCommit)
+
+	if err := spFunc(ctx); err != nil {
+		if _, rbErr := i.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT registry_savepoint"); rbErr != nil {
+			return pkgerrors.WithStack(rbErr)
+		}
+		*i.afterCommit = (*i.afterCommit)[:afterCommit]
+		return err
+	}
+
+	_, err := i.tx.ExecContext(ctx, "RELEASE SAVEPOINT registry_savepoint")
+	return pkgerrors.WithStack(err)
+}
+
+// Notify sends a Postgres notification on the channel. Within a db tx, it is only delivered once the tx commits.
+func (i impl) Notify(ctx context.Context, channel, payload string) error {
+	if i.tx != nil {
+		return pg.Notify(ctx, i.tx, channel, payload)
+	}
+	return pg.Notify(ctx, i.dbConn, channel, payload)
+}
+
+// AfterCommit calls f once the db tx commits & drops it if the tx rolls back. Outside of a db tx, f is called
+// right away.
+func (i impl) AfterCommit(f func()) {
+	if i.afterCommit == nil {
+		f()
+		return
+	}
+	*i.afterCommit = append(*i.afterCommit, f)
 }
@@ -12,6 +12,19 @@ func toUser(o *orm.User) model.User {
 		Email:     o.Email,
 		Password:  o.Password,
 		Status:    model.UserStatus(o.Status),
+		Role:      model.UserRole(o.Role),
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+func toRefreshToken(o *orm.RefreshToken) model.RefreshToken {
+	return model.RefreshToken{
+		ID:        o.ID,
+		UserID:    o.UserID,
+		FamilyID:  o.FamilyID,
+		Revoked:   o.Revoked,
+		ExpiresAt: o.ExpiresAt,
 		CreatedAt: o.CreatedAt,
 		UpdatedAt: o.UpdatedAt,
 	}
@@ -0,0 +1,37 @@
+package user
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// CreateRefreshToken saves refresh token in DB. A token without FamilyID starts a new family.
+func (i impl) CreateRefreshToken(ctx context.Context, m model.RefreshToken) (model.RefreshToken, error) {
+	id, err := generator.RefreshTokenIDSNF.Generate()
+	if err != nil {
+		return model.RefreshToken{}, pkgerrors.WithStack(err)
+	}
+
+	if m.FamilyID == 0 {
+		m.FamilyID = id
+	}
+
+	o := orm.RefreshToken{
+		ID:        id,
+		UserID:    m.UserID,
+		FamilyID:  m.FamilyID,
+		ExpiresAt: m.ExpiresAt,
+	}
+
+	if err = o.Insert(ctx, i.dbConn, boil.Infer()); err != nil {
+		return model.RefreshToken{}, pkgerrors.WithStack(err)
+	}
+
+	return toRefreshToken(&o), nil
+}
@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_CreateRefreshToken(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	type arg struct {
+		testDataPath   string
+		givenCtx       context.Context
+		givenToken     model.RefreshToken
+		expToken       model.RefreshToken
+		expOwnFamilyID bool
+		expErr         error
+	}
+
+	tcs := map[string]arg{
+		"success_new_family": {
+			testDataPath:   "testdata/refresh_tokens.sql",
+			givenCtx:       context.Background(),
+			givenToken:     model.RefreshToken{UserID: 14753001, ExpiresAt: expiresAt},
+			expToken:       model.RefreshToken{UserID: 14753001, ExpiresAt: expiresAt},
+			expOwnFamilyID: true,
+		},
+		"success_rotated": {
+			testDataPath: "testdata/refresh_tokens.sql",
+			givenCtx:     context.Background(),
+			givenToken:   model.RefreshToken{UserID: 14753001, FamilyID: 14753101, ExpiresAt: expiresAt},
+			expToken:     model.RefreshToken{UserID: 14753001, FamilyID: 14753101, ExpiresAt: expiresAt},
+		},
+		"ctx_cancelled": {
+			givenCtx:   cancelledCtx,
+			givenToken: model.RefreshToken{UserID: 14753001, ExpiresAt: expiresAt},
+			expErr:     context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				rt, err := repo.CreateRefreshToken(tc.givenCtx, tc.givenToken)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.NotEmpty(t, rt.ID)
+					if tc.expOwnFamilyID {
+						require.Equal(t, rt.ID, rt.FamilyID)
+					}
+					testutil.Compare(t, tc.expToken, rt, model.RefreshToken{}, "ID", "FamilyID", "CreatedAt", "UpdatedAt")
+					if !tc.expOwnFamilyID {
+						require.Equal(t, tc.expToken.FamilyID, rt.FamilyID)
+					}
+				}
+			})
+		})
+	}
+}
@@ -25,6 +25,7 @@ func (i impl) CreateUser(ctx context.Context, m model.User) (model.User, error)
 		Email:    m.Email,
 		Password: m.Password,
 		Status:   m.Status.String(),
+		Role:     m.Role.String(),
 	}
 
 	if err = o.Insert(ctx, i.dbConn, boil.Infer()); err != nil {
@@ -32,6 +33,7 @@ func (i impl) CreateUser(ctx context.Context, m model.User) (model.User, error)
 	}
 
 	m.ID = id
+	m.Role = model.UserRole(o.Role)
 	m.CreatedAt = o.CreatedAt
 	m.UpdatedAt = o.UpdatedAt
 
@@ -34,6 +34,7 @@ func TestImpl_CreateUser(t *testing.T) {
 				Email:    "test@example.com",
 				Password: "password123",
 				Status:   model.UserStatusActive,
+				Role:     model.UserRoleCustomer,
 			},
 		},
 		"ctx_cancelled": {
@@ -43,6 +44,7 @@ func TestImpl_CreateUser(t *testing.T) {
 				Email:    "test@example.com",
 				Password: "password123",
 				Status:   model.UserStatusActive,
+				Role:     model.UserRoleCustomer,
 			},
 			expErr: context.Canceled,
 		},
@@ -55,6 +57,7 @@ func TestImpl_CreateUser(t *testing.T) {
 				Email:    "test@example.com",
 				Password: "password123",
 				Status:   model.UserStatusActive,
+				Role:     model.UserRoleCustomer,
 			},
 			expErr: errors.New("pq: duplicate key value violates unique constraint"),
 		},
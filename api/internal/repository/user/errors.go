@@ -3,5 +3,6 @@ package user
 import "errors"
 
 var (
-	ErrNotFound = errors.New("user not found")
+	ErrNotFound             = errors.New("user not found")
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
 )
@@ -35,6 +35,7 @@ func Test_impl_GetByEmail(t *testing.T) {
 				Name:   "Test User",
 				Email:  "test@example.com",
 				Status: model.UserStatusActive,
+				Role:   model.UserRoleCustomer,
 			},
 		},
 		"ctx_cancelled": {
@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// GetRefreshTokenByID retrieve the refresh token data by id
+func (i impl) GetRefreshTokenByID(ctx context.Context, id int64) (model.RefreshToken, error) {
+	o, err := orm.FindRefreshToken(ctx, i.dbConn, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.RefreshToken{}, pkgerrors.WithStack(ErrRefreshTokenNotFound)
+		}
+		return model.RefreshToken{}, pkgerrors.WithStack(err)
+	}
+
+	return toRefreshToken(o), nil
+}
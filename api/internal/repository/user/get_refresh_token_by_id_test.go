@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_GetRefreshTokenByID(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath string
+		givenCtx     context.Context
+		givenID      int64
+		expToken     model.RefreshToken
+		expErr       error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/refresh_tokens.sql",
+			givenCtx:     context.Background(),
+			givenID:      14753101,
+			expToken: model.RefreshToken{
+				ID:        14753101,
+				UserID:    14753001,
+				FamilyID:  14753101,
+				Revoked:   true,
+				ExpiresAt: time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"ctx_cancelled": {
+			givenCtx: cancelledCtx,
+			givenID:  14753101,
+			expErr:   context.Canceled,
+		},
+		"not_found": {
+			givenCtx: context.Background(),
+			givenID:  14753109,
+			expErr:   ErrRefreshTokenNotFound,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				rt, err := repo.GetRefreshTokenByID(tc.givenCtx, tc.givenID)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					testutil.Compare(t, tc.expToken, rt, model.RefreshToken{}, "CreatedAt", "UpdatedAt")
+				}
+			})
+		})
+	}
+}
@@ -2,20 +2,76 @@ package user
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"omg/api/internal/model"
 	"omg/api/internal/repository/orm"
+	"omg/api/pkg/pagination"
 
 	pkgerrors "github.com/pkg/errors"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-// GetUsers retrieve all users active data
-func (i impl) GetUsers(ctx context.Context) ([]model.User, error) {
-	o, err := orm.Users(
-		orm.UserWhere.Status.NEQ(model.UserStatusDeleted.String()),
-		qm.OrderBy(orm.UserColumns.CreatedAt+" DESC"),
-	).All(ctx, i.dbConn)
+// UsersFilter holds filters for getting users list
+type UsersFilter struct {
+	Search      string
+	Status      []model.UserStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	After       *pagination.Cursor
+	Limit       int
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetUsers retrieve the users matching the filter, newest first.
+// Without a status filter only the non-deleted users are returned.
+// Pagination is keyset based on (created_at, id).
+func (i impl) GetUsers(ctx context.Context, filter UsersFilter) ([]model.User, error) {
+	qms := []qm.QueryMod{
+		qm.OrderBy(orm.UserColumns.CreatedAt + " DESC, " + orm.UserColumns.ID + " DESC"),
+	}
+
+	if len(filter.Status) > 0 {
+		status := make([]string, len(filter.Status))
+		for idx, s := range filter.Status {
+			status[idx] = s.String()
+		}
+		qms = append(qms, orm.UserWhere.Status.IN(status))
+	} else {
+		qms = append(qms, orm.UserWhere.Status.NEQ(model.UserStatusDeleted.String()))
+	}
+
+	if filter.Search != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Search) + "%"
+		qms = append(qms, qm.Expr(
+			qm.Where(orm.UserColumns.Email+" ILIKE ?", pattern),
+			qm.Or(orm.UserColumns.Name+" ILIKE ?", pattern),
+		))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		qms = append(qms, orm.UserWhere.CreatedAt.GTE(filter.CreatedFrom))
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		qms = append(qms, orm.UserWhere.CreatedAt.LTE(filter.CreatedTo))
+	}
+
+	if filter.After != nil {
+		qms = append(qms, qm.Where(
+			"("+orm.UserColumns.CreatedAt+", "+orm.UserColumns.ID+") < (?, ?)",
+			filter.After.CreatedAt, filter.After.ID,
+		))
+	}
+
+	if filter.Limit > 0 {
+		qms = append(qms, qm.Limit(filter.Limit))
+	}
+
+	o, err := orm.Users(qms...).All(ctx, i.dbConn)
 	if err != nil {
 		return nil, pkgerrors.WithStack(err)
 	}
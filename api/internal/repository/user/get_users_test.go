@@ -3,10 +3,12 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"omg/api/internal/model"
 	"omg/api/internal/repository/generator"
 	"omg/api/pkg/db/pg"
+	"omg/api/pkg/pagination"
 	"omg/api/pkg/testutil"
 
 	pkgerrors "github.com/pkg/errors"
@@ -17,11 +19,18 @@ func Test_impl_GetUsers(t *testing.T) {
 	cancelledCtx, c := context.WithCancel(context.Background())
 	c()
 
+	var (
+		alice = model.User{ID: 14753101, Name: "Alice Smith", Email: "alice@example.com", Status: model.UserStatusActive, Role: model.UserRoleCustomer}
+		bob   = model.User{ID: 14753102, Name: "Bob Jones", Email: "bob@shop.com", Status: model.UserStatusActive, Role: model.UserRoleCustomer}
+		carol = model.User{ID: 14753103, Name: "Carol 100%", Email: "carol@example.com", Status: model.UserStatusDeleted, Role: model.UserRoleCustomer}
+		dave  = model.User{ID: 14753104, Name: "Dave Smith", Email: "dave@shop.com", Status: model.UserStatusActive, Role: model.UserRoleCustomer}
+	)
+
 	type arg struct {
 		testDataPath string
 		givenCtx     context.Context
+		givenFilter  UsersFilter
 		expUser      []model.User
-		mockIDErr    error
 		expErr       error
 	}
 
@@ -31,17 +40,68 @@ func Test_impl_GetUsers(t *testing.T) {
 			givenCtx:     context.Background(),
 			expUser: []model.User{
 				{
-					Name:   "Test User",
-					Email:  "test@example.com",
+					ID:     14753002,
+					Name:   "Test User2",
+					Email:  "test2@example.com",
 					Status: model.UserStatusActive,
+					Role:   model.UserRoleCustomer,
 				},
 				{
-					Name:   "Test User2",
-					Email:  "test2@example.com",
+					ID:     14753001,
+					Name:   "Test User",
+					Email:  "test@example.com",
 					Status: model.UserStatusActive,
+					Role:   model.UserRoleCustomer,
 				},
 			},
 		},
+		"newest_first_without_deleted": {
+			testDataPath: "testdata/list_users.sql",
+			givenCtx:     context.Background(),
+			expUser:      []model.User{dave, bob, alice},
+		},
+		"search_name": {
+			testDataPath: "testdata/list_users.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  UsersFilter{Search: "smith"},
+			expUser:      []model.User{dave, alice},
+		},
+		"search_email": {
+			testDataPath: "testdata/list_users.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  UsersFilter{Search: "SHOP.com"},
+			expUser:      []model.User{dave, bob},
+		},
+		"search_wildcard_is_literal": {
+			testDataPath: "testdata/list_users.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  UsersFilter{Search: "100%", Status: []model.UserStatus{model.UserStatusActive, model.UserStatusDeleted}},
+			expUser:      []model.User{carol},
+		},
+		"status": {
+			testDataPath: "testdata/list_users.sql",
+			givenCtx:     context.Background(),
+			givenFilter:  UsersFilter{Status: []model.UserStatus{model.UserStatusDeleted}},
+			expUser:      []model.User{carol},
+		},
+		"created_date_range": {
+			testDataPath: "testdata/list_users.sql",
+			givenCtx:     context.Background(),
+			givenFilter: UsersFilter{
+				CreatedFrom: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				CreatedTo:   time.Date(2024, 1, 2, 23, 59, 59, 0, time.UTC),
+			},
+			expUser: []model.User{dave, bob},
+		},
+		"after_cursor_and_limit": {
+			testDataPath: "testdata/list_users.sql",
+			givenCtx:     context.Background(),
+			givenFilter: UsersFilter{
+				After: &pagination.Cursor{CreatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), ID: 14753104},
+				Limit: 1,
+			},
+			expUser: []model.User{bob},
+		},
 		"ctx_cancelled": {
 			givenCtx: cancelledCtx,
 			expErr:   context.Canceled,
@@ -63,19 +123,15 @@ func Test_impl_GetUsers(t *testing.T) {
 				require.Nil(t, generator.InitSnowflakeGenerators())
 
 				// When:
-				users, err := repo.GetUsers(tc.givenCtx)
+				users, err := repo.GetUsers(tc.givenCtx, tc.givenFilter)
 
 				// Then:
 				if tc.expErr != nil {
 					require.Error(t, err)
-					if desc == "duplicate_email" {
-						require.Contains(t, err.Error(), tc.expErr.Error())
-					} else {
-						require.Equal(t, tc.expErr, pkgerrors.Cause(err))
-					}
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
 				} else {
 					require.NoError(t, err)
-					testutil.Compare(t, tc.expUser, users, model.User{}, "ID", "Password", "CreatedAt", "UpdatedAt")
+					testutil.Compare(t, tc.expUser, users, model.User{}, "Password", "CreatedAt", "UpdatedAt")
 				}
 			})
 		})
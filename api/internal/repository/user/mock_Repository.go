@@ -14,6 +14,34 @@ type MockRepository struct {
 	mock.Mock
 }
 
+// CreateRefreshToken provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) CreateRefreshToken(_a0 context.Context, _a1 model.RefreshToken) (model.RefreshToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRefreshToken")
+	}
+
+	var r0 model.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefreshToken) (model.RefreshToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.RefreshToken) model.RefreshToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.RefreshToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.RefreshToken) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateUser provides a mock function with given fields: _a0, _a1
 func (_m *MockRepository) CreateUser(_a0 context.Context, _a1 model.User) (model.User, error) {
 	ret := _m.Called(_a0, _a1)
@@ -98,9 +126,37 @@ func (_m *MockRepository) GetByID(_a0 context.Context, _a1 int64) (model.User, e
 	return r0, r1
 }
 
-// GetUsers provides a mock function with given fields: _a0
-func (_m *MockRepository) GetUsers(_a0 context.Context) ([]model.User, error) {
-	ret := _m.Called(_a0)
+// GetRefreshTokenByID provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) GetRefreshTokenByID(_a0 context.Context, _a1 int64) (model.RefreshToken, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshTokenByID")
+	}
+
+	var r0 model.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.RefreshToken, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.RefreshToken); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(model.RefreshToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUsers provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) GetUsers(_a0 context.Context, _a1 UsersFilter) ([]model.User, error) {
+	ret := _m.Called(_a0, _a1)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUsers")
@@ -108,19 +164,19 @@ func (_m *MockRepository) GetUsers(_a0 context.Context) ([]model.User, error) {
 
 	var r0 []model.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]model.User, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, UsersFilter) ([]model.User, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []model.User); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, UsersFilter) []model.User); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, UsersFilter) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -128,6 +184,42 @@ func (_m *MockRepository) GetUsers(_a0 context.Context) ([]model.User, error) {
 	return r0, r1
 }
 
+// RevokeRefreshToken provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) RevokeRefreshToken(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRefreshToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeRefreshTokenFamily provides a mock function with given fields: _a0, _a1
+func (_m *MockRepository) RevokeRefreshTokenFamily(_a0 context.Context, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeRefreshTokenFamily")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: _a0, _a1
 func (_m *MockRepository) Update(_a0 context.Context, _a1 model.User) error {
 	ret := _m.Called(_a0, _a1)
@@ -11,8 +11,13 @@ type Repository interface {
 	CreateUser(context.Context, model.User) (model.User, error)
 	GetByEmail(context.Context, string) (model.User, error)
 	GetByID(context.Context, int64) (model.User, error)
-	GetUsers(context.Context) ([]model.User, error)
+	GetUsers(context.Context, UsersFilter) ([]model.User, error)
 	Update(context.Context, model.User) error
+
+	CreateRefreshToken(context.Context, model.RefreshToken) (model.RefreshToken, error)
+	GetRefreshTokenByID(context.Context, int64) (model.RefreshToken, error)
+	RevokeRefreshToken(context.Context, int64) error
+	RevokeRefreshTokenFamily(context.Context, int64) error
 }
 
 type impl struct {
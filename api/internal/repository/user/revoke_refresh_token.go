@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// RevokeRefreshToken revokes an active refresh token.
+// It returns ErrRefreshTokenNotFound when there is no such active token, so only one caller can ever revoke a token.
+func (i impl) RevokeRefreshToken(ctx context.Context, id int64) error {
+	rowsAff, err := orm.RefreshTokens(
+		orm.RefreshTokenWhere.ID.EQ(id),
+		orm.RefreshTokenWhere.Revoked.EQ(false),
+	).UpdateAll(ctx, i.dbConn, orm.M{
+		orm.RefreshTokenColumns.Revoked:   true,
+		orm.RefreshTokenColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if rowsAff == 0 {
+		return pkgerrors.WithStack(ErrRefreshTokenNotFound)
+	}
+
+	return nil
+}
+
+// RevokeRefreshTokenFamily revokes all the refresh tokens rotated from the same login
+func (i impl) RevokeRefreshTokenFamily(ctx context.Context, familyID int64) error {
+	if _, err := orm.RefreshTokens(
+		orm.RefreshTokenWhere.FamilyID.EQ(familyID),
+		orm.RefreshTokenWhere.Revoked.EQ(false),
+	).UpdateAll(ctx, i.dbConn, orm.M{
+		orm.RefreshTokenColumns.Revoked:   true,
+		orm.RefreshTokenColumns.UpdatedAt: time.Now(),
+	}); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_RevokeRefreshToken(t *testing.T) {
+	cancelledCtx, c := context.WithCancel(context.Background())
+	c()
+
+	type arg struct {
+		testDataPath string
+		givenCtx     context.Context
+		givenID      int64
+		expErr       error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/refresh_tokens.sql",
+			givenCtx:     context.Background(),
+			givenID:      14753102,
+		},
+		"already_revoked": {
+			testDataPath: "testdata/refresh_tokens.sql",
+			givenCtx:     context.Background(),
+			givenID:      14753101,
+			expErr:       ErrRefreshTokenNotFound,
+		},
+		"not_found": {
+			testDataPath: "testdata/refresh_tokens.sql",
+			givenCtx:     context.Background(),
+			givenID:      14753109,
+			expErr:       ErrRefreshTokenNotFound,
+		},
+		"ctx_cancelled": {
+			givenCtx: cancelledCtx,
+			givenID:  14753102,
+			expErr:   context.Canceled,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+
+				repo := New(dbConn)
+				require.Nil(t, generator.InitSnowflakeGenerators())
+
+				// When:
+				err := repo.RevokeRefreshToken(tc.givenCtx, tc.givenID)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Error(t, err)
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+
+					rt, err := repo.GetRefreshTokenByID(context.Background(), tc.givenID)
+					require.NoError(t, err)
+					require.True(t, rt.Revoked)
+				}
+			})
+		})
+	}
+}
+
+func Test_impl_RevokeRefreshTokenFamily(t *testing.T) {
+	testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+		// Given:
+		testutil.LoadTestSQLFile(t, dbConn, "testdata/refresh_tokens.sql")
+		repo := New(dbConn)
+
+		// When:
+		err := repo.RevokeRefreshTokenFamily(context.Background(), 14753101)
+
+		// Then:
+		require.NoError(t, err)
+
+		rt, err := repo.GetRefreshTokenByID(context.Background(), 14753102)
+		require.NoError(t, err)
+		require.True(t, rt.Revoked)
+
+		// Tokens of other families are untouched
+		rt, err = repo.GetRefreshTokenByID(context.Background(), 14753103)
+		require.NoError(t, err)
+		require.False(t, rt.Revoked)
+	})
+}
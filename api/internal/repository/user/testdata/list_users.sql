@@ -0,0 +1,6 @@
+INSERT INTO users(id, name, email, password, status, created_at)
+VALUES
+    (14753101, 'Alice Smith', 'alice@example.com', 'password101', 'ACTIVE', '2024-01-01 00:00:00+00'),
+    (14753102, 'Bob Jones', 'bob@shop.com', 'password102', 'ACTIVE', '2024-01-02 00:00:00+00'),
+    (14753103, 'Carol 100%', 'carol@example.com', 'password103', 'DELETED', '2024-01-03 00:00:00+00'),
+    (14753104, 'Dave Smith', 'dave@shop.com', 'password104', 'ACTIVE', '2024-01-02 00:00:00+00');
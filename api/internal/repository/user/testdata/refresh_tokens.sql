@@ -0,0 +1,9 @@
+INSERT INTO users(id, name, email, password, status)
+VALUES
+   (14753001,'Test User','test@example.com', 'fasfasdasdasd', 'ACTIVE');
+
+INSERT INTO refresh_tokens(id, user_id, family_id, revoked, expires_at)
+VALUES
+   (14753101, 14753001, 14753101, true, '2099-01-01 00:00:00+00'),
+   (14753102, 14753001, 14753101, false, '2099-01-01 00:00:00+00'),
+   (14753103, 14753001, 14753103, false, '2099-01-01 00:00:00+00');
@@ -35,6 +35,7 @@ func Test_impl_Update(t *testing.T) {
 				Email:    "test@example.com",
 				Password: "password123",
 				Status:   model.UserStatusActive,
+				Role:     model.UserRoleCustomer,
 			},
 		},
 		"ctx_cancelled": {
@@ -44,6 +45,7 @@ func Test_impl_Update(t *testing.T) {
 				Email:    "test@example.com",
 				Password: "password123",
 				Status:   model.UserStatusActive,
+				Role:     model.UserRoleCustomer,
 			},
 			expErr: context.Canceled,
 		},
@@ -56,6 +58,7 @@ func Test_impl_Update(t *testing.T) {
 				Email:    "test@example.com",
 				Password: "password123",
 				Status:   model.UserStatusActive,
+				Role:     model.UserRoleCustomer,
 			},
 			expErr: ErrNotFound,
 		},
@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ClaimDueDeliveries gets up to limit pending deliveries due for an attempt, longest overdue first.
+// Their next attempt is postponed by the lease, so the other deliverers skip them while the endpoints are called,
+// and a delivery whose outcome never got saved is retried once the lease expires.
+func (i impl) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDelivery, error) {
+	var slice orm.WebhookDeliverySlice
+	err := queries.Raw(
+		`UPDATE webhook_deliveries
+		SET next_attempt_at = now() + make_interval(secs => $1), updated_at = now()
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = $2 AND next_attempt_at <= now()
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		lease.Seconds(), model.WebhookDeliveryStatusPending.String(), limit,
+	).Bind(ctx, i.dbConn, &slice)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var result []model.WebhookDelivery
+	for _, o := range slice {
+		result = append(result, toDelivery(o))
+	}
+
+	return result, nil
+}
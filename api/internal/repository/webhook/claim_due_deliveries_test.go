@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ClaimDueDeliveries(t *testing.T) {
+	type arg struct {
+		testDataPath string
+		expIDs       []int64
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/webhooks.sql",
+			expIDs:       []int64{14753101},
+		},
+		"empty": {},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+
+				// When:
+				deliveries, err := repo.ClaimDueDeliveries(context.Background(), 10, time.Minute)
+
+				// Then:
+				require.NoError(t, err)
+				var ids []int64
+				for _, d := range deliveries {
+					ids = append(ids, d.ID)
+					require.True(t, d.NextAttemptAt.After(time.Now()))
+				}
+				require.Equal(t, tc.expIDs, ids)
+
+				// The claimed deliveries are not due anymore until the lease expires
+				again, err := repo.ClaimDueDeliveries(context.Background(), 10, time.Minute)
+				require.NoError(t, err)
+				require.Empty(t, again)
+			})
+		})
+	}
+}
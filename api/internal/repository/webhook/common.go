@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+func toEndpoint(o *orm.WebhookEndpoint) (model.WebhookEndpoint, error) {
+	var eventTypes []model.EventType
+	if err := json.Unmarshal(o.EventTypes, &eventTypes); err != nil {
+		return model.WebhookEndpoint{}, pkgerrors.WithStack(err)
+	}
+
+	return model.WebhookEndpoint{
+		ID:         o.ID,
+		URL:        o.URL,
+		Secret:     o.Secret,
+		EventTypes: eventTypes,
+		Status:     model.WebhookEndpointStatus(o.Status),
+		CreatedAt:  o.CreatedAt,
+		UpdatedAt:  o.UpdatedAt,
+	}, nil
+}
+
+func toDelivery(o *orm.WebhookDelivery) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		ID:             o.ID,
+		EndpointID:     o.EndpointID,
+		EventID:        o.EventID,
+		EventType:      model.EventType(o.EventType),
+		Payload:        o.Payload,
+		Status:         model.WebhookDeliveryStatus(o.Status),
+		Attempts:       o.Attempts,
+		LastError:      o.LastError,
+		LastStatusCode: o.LastStatusCode,
+		NextAttemptAt:  o.NextAttemptAt,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+	}
+}
@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// CreateDelivery saves the pending delivery in DB, due right away.
+// The outbox may publish an event more than once, so a delivery already queued for the same endpoint & event is kept as is.
+func (i impl) CreateDelivery(ctx context.Context, m model.WebhookDelivery) error {
+	id, err := generator.WebhookDeliveryIDSNF.Generate()
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	o := orm.WebhookDelivery{
+		ID:         id,
+		EndpointID: m.EndpointID,
+		EventID:    m.EventID,
+		EventType:  m.EventType.String(),
+		Payload:    m.Payload,
+		Status:     model.WebhookDeliveryStatusPending.String(),
+	}
+
+	if err = o.Upsert(ctx, i.dbConn, false,
+		[]string{orm.WebhookDeliveryColumns.EndpointID, orm.WebhookDeliveryColumns.EventID},
+		boil.None(), boil.Infer(),
+	); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_CreateDelivery(t *testing.T) {
+	type arg struct {
+		givenDelivery model.WebhookDelivery
+		expStatus     model.WebhookDeliveryStatus
+		expPayload    string
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenDelivery: model.WebhookDelivery{
+				EndpointID: 14753001,
+				EventID:    14753204,
+				EventType:  model.EventTypeOrderCreated,
+				Payload:    []byte(`{"id":"14753204"}`),
+			},
+			expStatus:  model.WebhookDeliveryStatusPending,
+			expPayload: `{"id": "14753204"}`,
+		},
+		"already_queued": {
+			givenDelivery: model.WebhookDelivery{
+				EndpointID: 14753001,
+				EventID:    14753203,
+				EventType:  model.EventTypeOrderCreated,
+				Payload:    []byte(`{"id":"changed"}`),
+			},
+			expStatus:  model.WebhookDeliveryStatusDead,
+			expPayload: `{"id": "14753203"}`,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/webhooks.sql")
+				require.NoError(t, generator.InitSnowflakeGenerators())
+				repo := New(dbConn)
+
+				// When:
+				err := repo.CreateDelivery(context.Background(), tc.givenDelivery)
+
+				// Then:
+				require.NoError(t, err)
+
+				o, err := orm.WebhookDeliveries(
+					orm.WebhookDeliveryWhere.EndpointID.EQ(tc.givenDelivery.EndpointID),
+					orm.WebhookDeliveryWhere.EventID.EQ(tc.givenDelivery.EventID),
+				).One(context.Background(), dbConn)
+				require.NoError(t, err)
+				require.Equal(t, tc.expStatus.String(), o.Status)
+				require.JSONEq(t, tc.expPayload, string(o.Payload))
+			})
+		})
+	}
+}
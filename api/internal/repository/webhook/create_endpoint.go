@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// CreateEndpoint saves the webhook endpoint in DB
+func (i impl) CreateEndpoint(ctx context.Context, m model.WebhookEndpoint) (model.WebhookEndpoint, error) {
+	id, err := generator.WebhookEndpointIDSNF.Generate()
+	if err != nil {
+		return m, pkgerrors.WithStack(err)
+	}
+
+	eventTypes, err := json.Marshal(m.EventTypes)
+	if err != nil {
+		return m, pkgerrors.WithStack(err)
+	}
+
+	o := orm.WebhookEndpoint{
+		ID:         id,
+		URL:        m.URL,
+		Secret:     m.Secret,
+		EventTypes: eventTypes,
+		Status:     m.Status.String(),
+	}
+
+	if err = o.Insert(ctx, i.dbConn, boil.Infer()); err != nil {
+		return m, pkgerrors.WithStack(err)
+	}
+
+	m.ID = o.ID
+	m.CreatedAt = o.CreatedAt
+	m.UpdatedAt = o.UpdatedAt
+
+	return m, nil
+}
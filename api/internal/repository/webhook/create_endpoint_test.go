@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/generator"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_CreateEndpoint(t *testing.T) {
+	type arg struct {
+		givenEndpoint model.WebhookEndpoint
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenEndpoint: model.WebhookEndpoint{
+				URL:        "https://partner.example.com/hooks",
+				Secret:     "secret",
+				EventTypes: []model.EventType{model.EventTypeOrderCreated, model.EventTypeOrderStatusChanged},
+				Status:     model.WebhookEndpointStatusActive,
+			},
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				require.NoError(t, generator.InitSnowflakeGenerators())
+				repo := New(dbConn)
+
+				// When:
+				created, err := repo.CreateEndpoint(context.Background(), tc.givenEndpoint)
+
+				// Then:
+				require.NoError(t, err)
+				require.NotZero(t, created.ID)
+
+				found, err := repo.GetEndpointByID(context.Background(), created.ID)
+				require.NoError(t, err)
+				testutil.Compare(t, tc.givenEndpoint, found, model.WebhookEndpoint{}, "ID", "CreatedAt", "UpdatedAt")
+			})
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// GetDeliveryByID retrieve the webhook delivery by its ID
+func (i impl) GetDeliveryByID(ctx context.Context, id int64) (model.WebhookDelivery, error) {
+	o, err := orm.WebhookDeliveries(
+		orm.WebhookDeliveryWhere.ID.EQ(id),
+	).One(ctx, i.dbConn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WebhookDelivery{}, pkgerrors.WithStack(ErrDeliveryNotFound)
+		}
+		return model.WebhookDelivery{}, pkgerrors.WithStack(err)
+	}
+
+	return toDelivery(o), nil
+}
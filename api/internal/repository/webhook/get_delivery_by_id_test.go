@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_GetDeliveryByID(t *testing.T) {
+	type arg struct {
+		givenID     int64
+		expDelivery model.WebhookDelivery
+		expErr      error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenID: 14753103,
+			expDelivery: model.WebhookDelivery{
+				ID:         14753103,
+				EndpointID: 14753001,
+				EventID:    14753203,
+				EventType:  model.EventTypeOrderCreated,
+				Payload:    []byte(`{"id": "14753203"}`),
+				Status:     model.WebhookDeliveryStatusDead,
+				Attempts:   13,
+			},
+		},
+		"not_found": {
+			givenID: 14753199,
+			expErr:  ErrDeliveryNotFound,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/webhooks.sql")
+				repo := New(dbConn)
+
+				// When:
+				delivery, err := repo.GetDeliveryByID(context.Background(), tc.givenID)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					testutil.Compare(t, tc.expDelivery, delivery, model.WebhookDelivery{}, "NextAttemptAt", "CreatedAt", "UpdatedAt")
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// GetEndpointByID retrieve the webhook endpoint by its ID
+func (i impl) GetEndpointByID(ctx context.Context, id int64) (model.WebhookEndpoint, error) {
+	o, err := orm.WebhookEndpoints(
+		orm.WebhookEndpointWhere.ID.EQ(id),
+	).One(ctx, i.dbConn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WebhookEndpoint{}, pkgerrors.WithStack(ErrEndpointNotFound)
+		}
+		return model.WebhookEndpoint{}, pkgerrors.WithStack(err)
+	}
+
+	return toEndpoint(o)
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_GetEndpointByID(t *testing.T) {
+	type arg struct {
+		givenID     int64
+		expEndpoint model.WebhookEndpoint
+		expErr      error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenID: 14753001,
+			expEndpoint: model.WebhookEndpoint{
+				ID:         14753001,
+				URL:        "https://partner.example.com/hooks",
+				Secret:     "secret-1",
+				EventTypes: []model.EventType{model.EventTypeOrderCreated, model.EventTypeOrderStatusChanged},
+				Status:     model.WebhookEndpointStatusActive,
+			},
+		},
+		"not_found": {
+			givenID: 14753099,
+			expErr:  ErrEndpointNotFound,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/webhooks.sql")
+				repo := New(dbConn)
+
+				// When:
+				endpoint, err := repo.GetEndpointByID(context.Background(), tc.givenID)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					testutil.Compare(t, tc.expEndpoint, endpoint, model.WebhookEndpoint{}, "CreatedAt", "UpdatedAt")
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/pagination"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// DeliveriesFilter holds filters for getting webhook deliveries list
+type DeliveriesFilter struct {
+	EndpointID int64
+	Status     []model.WebhookDeliveryStatus
+	After      *pagination.Cursor
+	Limit      int
+}
+
+// ListDeliveries retrieve the webhook deliveries matching the filter, newest first.
+// Pagination is keyset based on (created_at, id).
+func (i impl) ListDeliveries(ctx context.Context, filter DeliveriesFilter) ([]model.WebhookDelivery, error) {
+	qms := []qm.QueryMod{
+		qm.OrderBy(orm.WebhookDeliveryColumns.CreatedAt + " DESC, " + orm.WebhookDeliveryColumns.ID + " DESC"),
+	}
+
+	if filter.EndpointID != 0 {
+		qms = append(qms, orm.WebhookDeliveryWhere.EndpointID.EQ(filter.EndpointID))
+	}
+
+	if len(filter.Status) > 0 {
+		status := make([]string, len(filter.Status))
+		for idx, s := range filter.Status {
+			status[idx] = s.String()
+		}
+		qms = append(qms, orm.WebhookDeliveryWhere.Status.IN(status))
+	}
+
+	if filter.After != nil {
+		qms = append(qms, qm.Where(
+			"("+orm.WebhookDeliveryColumns.CreatedAt+", "+orm.WebhookDeliveryColumns.ID+") < (?, ?)",
+			filter.After.CreatedAt, filter.After.ID,
+		))
+	}
+
+	if filter.Limit > 0 {
+		qms = append(qms, qm.Limit(filter.Limit))
+	}
+
+	slice, err := orm.WebhookDeliveries(qms...).All(ctx, i.dbConn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var result []model.WebhookDelivery
+	for _, o := range slice {
+		result = append(result, toDelivery(o))
+	}
+
+	return result, nil
+}
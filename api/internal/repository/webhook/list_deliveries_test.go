@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListDeliveries(t *testing.T) {
+	type arg struct {
+		givenFilter DeliveriesFilter
+		expIDs      []int64
+	}
+
+	tcs := map[string]arg{
+		"all": {
+			expIDs: []int64{14753104, 14753103, 14753102, 14753101},
+		},
+		"by_status": {
+			givenFilter: DeliveriesFilter{Status: []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusDead}},
+			expIDs:      []int64{14753103},
+		},
+		"by_endpoint": {
+			givenFilter: DeliveriesFilter{EndpointID: 14753002},
+			expIDs:      []int64{14753104},
+		},
+		"limited": {
+			givenFilter: DeliveriesFilter{Limit: 2},
+			expIDs:      []int64{14753104, 14753103},
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/webhooks.sql")
+				repo := New(dbConn)
+
+				// When:
+				deliveries, err := repo.ListDeliveries(context.Background(), tc.givenFilter)
+
+				// Then:
+				require.NoError(t, err)
+				var ids []int64
+				for _, d := range deliveries {
+					ids = append(ids, d.ID)
+				}
+				require.Equal(t, tc.expIDs, ids)
+			})
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ListEndpoints retrieve all the webhook endpoints, newest first.
+// There are only a handful of partners, so the list is not paginated.
+func (i impl) ListEndpoints(ctx context.Context) ([]model.WebhookEndpoint, error) {
+	slice, err := orm.WebhookEndpoints(
+		qm.OrderBy(orm.WebhookEndpointColumns.CreatedAt+" DESC, "+orm.WebhookEndpointColumns.ID+" DESC"),
+	).All(ctx, i.dbConn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var result []model.WebhookEndpoint
+	for _, o := range slice {
+		endpoint, err := toEndpoint(o)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, endpoint)
+	}
+
+	return result, nil
+}
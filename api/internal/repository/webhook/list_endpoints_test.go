@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_ListEndpoints(t *testing.T) {
+	type arg struct {
+		testDataPath string
+		expIDs       []int64
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			testDataPath: "testdata/webhooks.sql",
+			expIDs:       []int64{14753002, 14753001},
+		},
+		"empty": {},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				if tc.testDataPath != "" {
+					testutil.LoadTestSQLFile(t, dbConn, tc.testDataPath)
+				}
+				repo := New(dbConn)
+
+				// When:
+				endpoints, err := repo.ListEndpoints(context.Background())
+
+				// Then:
+				require.NoError(t, err)
+				var ids []int64
+				for _, e := range endpoints {
+					ids = append(ids, e.ID)
+				}
+				require.Equal(t, tc.expIDs, ids)
+			})
+		})
+	}
+}
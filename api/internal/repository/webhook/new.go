@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+)
+
+// Repository provides the specification of the functionality provided by this pkg
+type Repository interface {
+	// CreateEndpoint saves the webhook endpoint
+	CreateEndpoint(context.Context, model.WebhookEndpoint) (model.WebhookEndpoint, error)
+	// GetEndpointByID gets the webhook endpoint by its ID
+	GetEndpointByID(context.Context, int64) (model.WebhookEndpoint, error)
+	// ListEndpoints gets all the webhook endpoints, newest first
+	ListEndpoints(context.Context) ([]model.WebhookEndpoint, error)
+	// UpdateEndpointStatus changes the status of the webhook endpoint
+	UpdateEndpointStatus(context.Context, int64, model.WebhookEndpointStatus) error
+	// CreateDelivery saves the delivery, unless the event was already queued for the endpoint
+	CreateDelivery(context.Context, model.WebhookDelivery) error
+	// ClaimDueDeliveries gets the pending deliveries due for an attempt & postpones them by the lease
+	ClaimDueDeliveries(context.Context, int, time.Duration) ([]model.WebhookDelivery, error)
+	// GetDeliveryByID gets the webhook delivery by its ID
+	GetDeliveryByID(context.Context, int64) (model.WebhookDelivery, error)
+	// ListDeliveries gets the webhook deliveries matching the filter, newest first
+	ListDeliveries(context.Context, DeliveriesFilter) ([]model.WebhookDelivery, error)
+	// UpdateDelivery saves the outcome of a delivery attempt
+	UpdateDelivery(context.Context, model.WebhookDelivery) (model.WebhookDelivery, error)
+	// RequeueDeadDelivery makes the delivery pending again, provided it is still dead
+	RequeueDeadDelivery(context.Context, int64) (model.WebhookDelivery, error)
+}
+
+// New returns an implementation instance satisfying Repository
+func New(dbConn pg.ContextExecutor) Repository {
+	return impl{dbConn: dbConn}
+}
+
+type impl struct {
+	dbConn pg.ContextExecutor
+}
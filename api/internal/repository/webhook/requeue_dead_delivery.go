@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// RequeueDeadDelivery makes the dead delivery pending again, due right away & with a fresh set of retries.
+// The status is checked by the update itself, so a delivery changed meanwhile is left as is.
+func (i impl) RequeueDeadDelivery(ctx context.Context, id int64) (model.WebhookDelivery, error) {
+	var slice orm.WebhookDeliverySlice
+	err := queries.Raw(
+		`UPDATE webhook_deliveries
+		SET status = $1, attempts = 0, next_attempt_at = now(), updated_at = now()
+		WHERE id = $2 AND status = $3
+		RETURNING *`,
+		model.WebhookDeliveryStatusPending.String(), id, model.WebhookDeliveryStatusDead.String(),
+	).Bind(ctx, i.dbConn, &slice)
+	if err != nil {
+		return model.WebhookDelivery{}, pkgerrors.WithStack(err)
+	}
+
+	if len(slice) == 0 {
+		return model.WebhookDelivery{}, pkgerrors.WithStack(ErrDeliveryNotDead)
+	}
+
+	return toDelivery(slice[0]), nil
+}
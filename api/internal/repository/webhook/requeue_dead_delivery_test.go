@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_RequeueDeadDelivery(t *testing.T) {
+	type arg struct {
+		givenID int64
+		expErr  error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenID: 14753103,
+		},
+		"not_dead": {
+			givenID: 14753101,
+			expErr:  ErrDeliveryNotDead,
+		},
+		"not_found": {
+			givenID: 14753199,
+			expErr:  ErrDeliveryNotDead,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/webhooks.sql")
+				repo := New(dbConn)
+
+				// When:
+				d, err := repo.RequeueDeadDelivery(context.Background(), tc.givenID)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.givenID, d.ID)
+					require.Equal(t, model.WebhookDeliveryStatusPending, d.Status)
+					require.Zero(t, d.Attempts)
+					require.False(t, d.NextAttemptAt.After(time.Now()))
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,11 @@
+INSERT INTO webhook_endpoints(id, url, secret, event_types, status, created_at)
+VALUES
+    (14753001, 'https://partner.example.com/hooks', 'secret-1', '["order.created","order.status_changed"]', 'ACTIVE', now() - interval '2 hours'),
+    (14753002, 'https://other.example.com/hooks', 'secret-2', '["order.created"]', 'DISABLED', now() - interval '1 hour');
+
+INSERT INTO webhook_deliveries(id, endpoint_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at)
+VALUES
+    (14753101, 14753001, 14753201, 'order.created', '{"id":"14753201"}', 'PENDING', 0, now() - interval '1 minute', now() - interval '4 minutes'),
+    (14753102, 14753001, 14753202, 'order.status_changed', '{"id":"14753202"}', 'PENDING', 3, now() + interval '1 hour', now() - interval '3 minutes'),
+    (14753103, 14753001, 14753203, 'order.created', '{"id":"14753203"}', 'DEAD', 13, now() - interval '1 hour', now() - interval '2 minutes'),
+    (14753104, 14753002, 14753201, 'order.created', '{"id":"14753201"}', 'DELIVERED', 1, now() - interval '1 hour', now() - interval '1 minute');
@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// UpdateDelivery saves the status, attempts & outcome of the last attempt of the delivery in DB
+func (i impl) UpdateDelivery(ctx context.Context, m model.WebhookDelivery) (model.WebhookDelivery, error) {
+	o, err := orm.FindWebhookDelivery(ctx, i.dbConn, m.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WebhookDelivery{}, pkgerrors.WithStack(ErrDeliveryNotFound)
+		}
+		return model.WebhookDelivery{}, pkgerrors.WithStack(err)
+	}
+
+	o.Status = m.Status.String()
+	o.Attempts = m.Attempts
+	o.LastError = m.LastError
+	o.LastStatusCode = m.LastStatusCode
+	o.NextAttemptAt = m.NextAttemptAt
+
+	if _, err = o.Update(ctx, i.dbConn, boil.Whitelist(
+		orm.WebhookDeliveryColumns.Status,
+		orm.WebhookDeliveryColumns.Attempts,
+		orm.WebhookDeliveryColumns.LastError,
+		orm.WebhookDeliveryColumns.LastStatusCode,
+		orm.WebhookDeliveryColumns.NextAttemptAt,
+		orm.WebhookDeliveryColumns.UpdatedAt,
+	)); err != nil {
+		return model.WebhookDelivery{}, pkgerrors.WithStack(err)
+	}
+
+	return toDelivery(o), nil
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_UpdateDelivery(t *testing.T) {
+	nextAttemptAt := time.Now().Add(time.Minute).UTC().Truncate(time.Microsecond)
+
+	type arg struct {
+		givenDelivery model.WebhookDelivery
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenDelivery: model.WebhookDelivery{
+				ID:             14753101,
+				Status:         model.WebhookDeliveryStatusPending,
+				Attempts:       1,
+				LastError:      "unexpected status code 503",
+				LastStatusCode: 503,
+				NextAttemptAt:  nextAttemptAt,
+			},
+		},
+		"not_found": {
+			givenDelivery: model.WebhookDelivery{ID: 14753199},
+			expErr:        ErrDeliveryNotFound,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/webhooks.sql")
+				repo := New(dbConn)
+
+				// When:
+				updated, err := repo.UpdateDelivery(context.Background(), tc.givenDelivery)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tc.givenDelivery.Status, updated.Status)
+					require.Equal(t, tc.givenDelivery.Attempts, updated.Attempts)
+					require.Equal(t, tc.givenDelivery.LastError, updated.LastError)
+					require.Equal(t, tc.givenDelivery.LastStatusCode, updated.LastStatusCode)
+					require.True(t, tc.givenDelivery.NextAttemptAt.Equal(updated.NextAttemptAt))
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// UpdateEndpointStatus changes the status of the webhook endpoint in DB
+func (i impl) UpdateEndpointStatus(ctx context.Context, id int64, status model.WebhookEndpointStatus) error {
+	rowsAff, err := orm.WebhookEndpoints(
+		orm.WebhookEndpointWhere.ID.EQ(id),
+	).UpdateAll(ctx, i.dbConn, orm.M{
+		orm.WebhookEndpointColumns.Status:    status.String(),
+		orm.WebhookEndpointColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+
+	if rowsAff == 0 {
+		return pkgerrors.WithStack(ErrEndpointNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository/orm"
+	"omg/api/pkg/db/pg"
+	"omg/api/pkg/testutil"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_impl_UpdateEndpointStatus(t *testing.T) {
+	type arg struct {
+		givenID int64
+		expErr  error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenID: 14753001,
+		},
+		"not_found": {
+			givenID: 14753099,
+			expErr:  ErrEndpointNotFound,
+		},
+	}
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			testutil.WithTxDB(t, func(dbConn pg.BeginnerExecutor) {
+				// Given:
+				testutil.LoadTestSQLFile(t, dbConn, "testdata/webhooks.sql")
+				repo := New(dbConn)
+
+				// When:
+				err := repo.UpdateEndpointStatus(context.Background(), tc.givenID, model.WebhookEndpointStatusDisabled)
+
+				// Then:
+				if tc.expErr != nil {
+					require.Equal(t, tc.expErr, pkgerrors.Cause(err))
+				} else {
+					require.NoError(t, err)
+
+					o, err := orm.FindWebhookEndpoint(context.Background(), dbConn, tc.givenID)
+					require.NoError(t, err)
+					require.Equal(t, model.WebhookEndpointStatusDisabled.String(), o.Status)
+				}
+			})
+		})
+	}
+}
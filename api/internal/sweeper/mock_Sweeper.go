@@ -0,0 +1,51 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package sweeper
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSweeper is an autogenerated mock type for the Sweeper type
+type MockSweeper struct {
+	mock.Mock
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *MockSweeper) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// Sweep provides a mock function with given fields: ctx
+func (_m *MockSweeper) Sweep(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sweep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockSweeper creates a new instance of MockSweeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSweeper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSweeper {
+	mock := &MockSweeper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,38 @@
+package sweeper
+
+import (
+	"context"
+	"time"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/repository"
+)
+
+const (
+	defaultInterval = time.Minute
+	// expiredOrdersBatchSize caps how many orders with expired stock reservations are cancelled per sweep
+	expiredOrdersBatchSize = 100
+)
+
+// Sweeper cleans up the data which expired
+type Sweeper interface {
+	// Run sweeps periodically until the ctx is done
+	Run(ctx context.Context)
+	// Sweep cleans up what expired since the last sweep
+	Sweep(ctx context.Context) error
+}
+
+// New returns an implementation instance satisfying Sweeper
+func New(repo repository.Registry, orderCtrl orders.Controller) Sweeper {
+	return impl{
+		repo:      repo,
+		orderCtrl: orderCtrl,
+		interval:  defaultInterval,
+	}
+}
+
+type impl struct {
+	repo      repository.Registry
+	orderCtrl orders.Controller
+	interval  time.Duration
+}
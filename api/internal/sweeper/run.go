@@ -0,0 +1,27 @@
+package sweeper
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Run sweeps periodically until the ctx is done
+func (i impl) Run(ctx context.Context) {
+	log.Printf("Starting sweeper")
+
+	ticker := time.NewTicker(i.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopping sweeper")
+			return
+		case <-ticker.C:
+			if err := i.Sweep(ctx); err != nil {
+				log.Printf("Failed to sweep: %v", err)
+			}
+		}
+	}
+}
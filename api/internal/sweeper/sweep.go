@@ -0,0 +1,55 @@
+package sweeper
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"omg/api/internal/model"
+)
+
+// Sweep cancels the pending orders whose stock reservations expired & deletes the idempotency keys older than their retention
+func (i impl) Sweep(ctx context.Context) error {
+	if err := i.expireOrders(ctx); err != nil {
+		return err
+	}
+
+	purged, err := i.repo.Idempotency().PurgeExpiredKeys(ctx, time.Now().Add(-model.IdempotencyKeyRetention))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		log.Printf("Purged %d expired idempotency keys", purged)
+	}
+
+	return nil
+}
+
+// expireOrders gives the stock held by abandoned orders back. An order failing is backed off from, so it does not hold
+// the others up in the next batches either.
+func (i impl) expireOrders(ctx context.Context) error {
+	ids, err := i.repo.Inventory().ListExpiredReservationOrderIDs(ctx, time.Now(), expiredOrdersBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var expired int
+	for _, id := range ids {
+		ok, err := i.orderCtrl.ExpireOrder(ctx, id)
+		if err != nil {
+			log.Printf("Failed to expire order %d: %v", id, err)
+			if err := i.repo.Inventory().PostponeReservationsSweep(ctx, id); err != nil {
+				log.Printf("Failed to postpone the expiry of order %d: %v", id, err)
+			}
+			continue
+		}
+		if ok {
+			expired++
+		}
+	}
+	if expired > 0 {
+		log.Printf("Cancelled %d orders with expired stock reservations", expired)
+	}
+
+	return nil
+}
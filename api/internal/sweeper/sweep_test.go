@@ -0,0 +1,104 @@
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/controller/orders"
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/idempotency"
+	"omg/api/internal/repository/inventory"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_Sweep(t *testing.T) {
+	type arg struct {
+		mockExpiredIDs    []int64
+		mockListErr       error
+		mockExpireResults map[int64]error
+		expPostponed      []int64
+		mockPostponeErr   error
+		mockPurgeErr      error
+		expPurgeCalled    bool
+		expErr            error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			mockExpiredIDs:    []int64{11, 12},
+			mockExpireResults: map[int64]error{11: nil, 12: nil},
+			expPurgeCalled:    true,
+		},
+		"nothing_expired": {
+			expPurgeCalled: true,
+		},
+		"expire_error_does_not_stop_others": {
+			mockExpiredIDs:    []int64{11, 12},
+			mockExpireResults: map[int64]error{11: errors.New("database error"), 12: nil},
+			expPostponed:      []int64{11},
+			expPurgeCalled:    true,
+		},
+		"postpone_error_does_not_stop_others": {
+			mockExpiredIDs:    []int64{11, 12},
+			mockExpireResults: map[int64]error{11: errors.New("database error"), 12: nil},
+			expPostponed:      []int64{11},
+			mockPostponeErr:   errors.New("database error"),
+			expPurgeCalled:    true,
+		},
+		"list_expired_error": {
+			mockListErr: errors.New("database error"),
+			expErr:      errors.New("database error"),
+		},
+		"purge_error": {
+			mockPurgeErr:   errors.New("database error"),
+			expPurgeCalled: true,
+			expErr:         errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given:
+			invRepo := inventory.NewMockRepository(t)
+			invRepo.On("ListExpiredReservationOrderIDs", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+				return time.Since(before) < time.Minute
+			}), expiredOrdersBatchSize).Return(tc.mockExpiredIDs, tc.mockListErr)
+			for _, id := range tc.expPostponed {
+				invRepo.On("PostponeReservationsSweep", mock.Anything, id).Return(tc.mockPostponeErr)
+			}
+
+			orderCtrl := orders.NewMockController(t)
+			for _, id := range tc.mockExpiredIDs {
+				orderCtrl.On("ExpireOrder", mock.Anything, id).Return(tc.mockExpireResults[id] == nil, tc.mockExpireResults[id])
+			}
+
+			idemRepo := idempotency.NewMockRepository(t)
+			if tc.expPurgeCalled {
+				idemRepo.On("PurgeExpiredKeys", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+					// Only the keys older than the retention are purged
+					return time.Since(before) >= model.IdempotencyKeyRetention &&
+						time.Since(before) < model.IdempotencyKeyRetention+time.Minute
+				})).Return(int64(2), tc.mockPurgeErr)
+			}
+
+			mockRepo := repository.NewMockRegistry(t)
+			mockRepo.On("Inventory").Return(invRepo)
+			mockRepo.On("Idempotency").Return(idemRepo).Maybe()
+
+			// When:
+			err := New(mockRepo, orderCtrl).Sweep(context.Background())
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
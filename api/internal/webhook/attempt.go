@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/pkg/db/pg"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// attempt POSTs the delivery to the endpoint & returns the delivery updated with the outcome.
+// A failed delivery is scheduled for a retry, or dead-lettered once the retries are exhausted.
+func (i impl) attempt(ctx context.Context, endpoint model.WebhookEndpoint, d model.WebhookDelivery) model.WebhookDelivery {
+	d.Attempts++
+
+	// There is no point retrying until the endpoint gets enabled again, the delivery can be redelivered then
+	if endpoint.Status != model.WebhookEndpointStatusActive {
+		d.Status = model.WebhookDeliveryStatusDead
+		d.LastStatusCode = 0
+		d.LastError = errEndpointDisabled.Error()
+		return d
+	}
+
+	statusCode, err := i.post(ctx, endpoint, d)
+	d.LastStatusCode = statusCode
+	if err == nil {
+		d.Status = model.WebhookDeliveryStatusDelivered
+		d.LastError = ""
+		return d
+	}
+
+	d.LastError = err.Error()
+	if len(d.LastError) > maxLastErrorLen {
+		d.LastError = d.LastError[:maxLastErrorLen]
+	}
+
+	delay, ok := i.retryDelay(d.Attempts)
+	if !ok {
+		d.Status = model.WebhookDeliveryStatusDead
+		return d
+	}
+
+	d.NextAttemptAt = time.Now().Add(delay)
+	return d
+}
+
+// post sends the signed delivery & returns the status code the endpoint answered with.
+// Any answer other than 2xx is an error.
+func (i impl) post(ctx context.Context, endpoint model.WebhookEndpoint, d model.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, d.EventType.String())
+	req.Header.Set(DeliveryIDHeader, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(SignatureHeader, Sign(endpoint.Secret, time.Now(), d.Payload))
+
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain a bit of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// retryDelay tells how long to wait after the given number of failed attempts, following pg.ExponentialBackOff,
+// & false once the retries are exhausted
+func (i impl) retryDelay(attempts int) (time.Duration, bool) {
+	b := pg.ExponentialBackOff(i.maxRetries, 0)
+	b.Reset() // as backoff.Retry does, for the initial interval to be applied
+
+	var delay time.Duration
+	for n := 0; n < attempts; n++ {
+		if delay = b.NextBackOff(); delay == backoff.Stop {
+			return 0, false
+		}
+	}
+
+	return delay, true
+}
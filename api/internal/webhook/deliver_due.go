@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"omg/api/internal/model"
+)
+
+// DeliverDue claims a batch of due deliveries, POSTs them to their endpoints concurrently & saves the outcomes
+func (i impl) DeliverDue(ctx context.Context) (int, error) {
+	deliveries, err := i.repo.Webhook().ClaimDueDeliveries(ctx, i.batchSize, i.lease)
+	if err != nil {
+		return 0, err
+	}
+
+	endpoints := map[int64]model.WebhookEndpoint{}
+	for _, d := range deliveries {
+		if _, ok := endpoints[d.EndpointID]; ok {
+			continue
+		}
+		endpoint, err := i.repo.Webhook().GetEndpointByID(ctx, d.EndpointID)
+		if err != nil {
+			return 0, err
+		}
+		endpoints[d.EndpointID] = endpoint
+	}
+
+	var wg sync.WaitGroup
+	for _, d := range deliveries {
+		wg.Add(1)
+		go func(endpoint model.WebhookEndpoint, d model.WebhookDelivery) {
+			defer wg.Done()
+
+			// A delivery whose outcome cannot be saved is attempted again once its lease expires
+			if _, err := i.repo.Webhook().UpdateDelivery(ctx, i.attempt(ctx, endpoint, d)); err != nil {
+				log.Printf("Failed to save webhook delivery %d: %v", d.ID, err)
+			}
+		}(endpoints[d.EndpointID], d)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/webhook"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpl_DeliverDue(t *testing.T) {
+	payload := []byte(`{"id":"7","type":"order.created"}`)
+
+	type arg struct {
+		givenStatusCode   int
+		givenAttempts     int
+		givenEndpointStat model.WebhookEndpointStatus
+		mockClaimErr      error
+		expCalled         bool
+		expStatus         model.WebhookDeliveryStatus
+		expLastError      string
+		expRetry          bool
+		expErr            error
+	}
+
+	tcs := map[string]arg{
+		"delivered": {
+			givenStatusCode:   http.StatusNoContent,
+			givenEndpointStat: model.WebhookEndpointStatusActive,
+			expCalled:         true,
+			expStatus:         model.WebhookDeliveryStatusDelivered,
+		},
+		"failed_retried": {
+			givenStatusCode:   http.StatusServiceUnavailable,
+			givenEndpointStat: model.WebhookEndpointStatusActive,
+			expCalled:         true,
+			expStatus:         model.WebhookDeliveryStatusPending,
+			expLastError:      "unexpected status code 503",
+			expRetry:          true,
+		},
+		"failed_dead_lettered": {
+			givenStatusCode:   http.StatusInternalServerError,
+			givenAttempts:     defaultMaxRetries,
+			givenEndpointStat: model.WebhookEndpointStatusActive,
+			expCalled:         true,
+			expStatus:         model.WebhookDeliveryStatusDead,
+			expLastError:      "unexpected status code 500",
+		},
+		"endpoint_disabled": {
+			givenEndpointStat: model.WebhookEndpointStatusDisabled,
+			expStatus:         model.WebhookDeliveryStatusDead,
+			expLastError:      errEndpointDisabled.Error(),
+		},
+		"claim_error": {
+			mockClaimErr: errors.New("database error"),
+			expErr:       errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given:
+			// The handler runs outside the test goroutine, so it only records the request, asserted once delivered
+			type request struct {
+				header http.Header
+				body   []byte
+			}
+			requests := make(chan request, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				requests <- request{header: r.Header.Clone(), body: body}
+				w.WriteHeader(tc.givenStatusCode)
+			}))
+			defer srv.Close()
+
+			delivery := model.WebhookDelivery{
+				ID:         21,
+				EndpointID: 1,
+				EventID:    7,
+				EventType:  model.EventTypeOrderCreated,
+				Payload:    payload,
+				Status:     model.WebhookDeliveryStatusPending,
+				Attempts:   tc.givenAttempts,
+			}
+			endpoint := model.WebhookEndpoint{ID: 1, URL: srv.URL, Secret: "secret", Status: tc.givenEndpointStat}
+
+			webhookRepo := webhook.NewMockRepository(t)
+			if tc.mockClaimErr != nil {
+				webhookRepo.On("ClaimDueDeliveries", mock.Anything, defaultBatchSize, defaultLease).Return(nil, tc.mockClaimErr)
+			} else {
+				webhookRepo.On("ClaimDueDeliveries", mock.Anything, defaultBatchSize, defaultLease).
+					Return([]model.WebhookDelivery{delivery}, nil)
+				webhookRepo.On("GetEndpointByID", mock.Anything, int64(1)).Return(endpoint, nil)
+				webhookRepo.On("UpdateDelivery", mock.Anything, mock.MatchedBy(func(d model.WebhookDelivery) bool {
+					return d.ID == 21 &&
+						d.Attempts == tc.givenAttempts+1 &&
+						d.Status == tc.expStatus &&
+						d.LastError == tc.expLastError &&
+						d.LastStatusCode == map[bool]int{true: tc.givenStatusCode}[tc.expCalled] &&
+						d.NextAttemptAt.After(time.Now()) == tc.expRetry
+				})).Return(model.WebhookDelivery{}, nil)
+			}
+
+			mockRepo := repository.NewMockRegistry(t)
+			mockRepo.On("Webhook").Return(webhookRepo)
+
+			d := New(mockRepo)
+
+			// When:
+			attempted, err := d.DeliverDue(context.Background())
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 1, attempted)
+			}
+
+			if !tc.expCalled {
+				require.Empty(t, requests)
+				return
+			}
+			require.Len(t, requests, 1)
+			req := <-requests
+			require.Equal(t, payload, req.body)
+			require.Equal(t, "application/json", req.header.Get("Content-Type"))
+			require.Equal(t, model.EventTypeOrderCreated.String(), req.header.Get(EventTypeHeader))
+			require.Equal(t, "21", req.header.Get(DeliveryIDHeader))
+
+			ts, err := strconv.ParseInt(req.header.Get(SignatureHeader)[2:12], 10, 64)
+			require.NoError(t, err)
+			require.Equal(t, Sign("secret", time.Unix(ts, 0), req.body), req.header.Get(SignatureHeader))
+		})
+	}
+}
+
+func TestImpl_retryDelay(t *testing.T) {
+	i := New(nil).(impl)
+
+	delay, ok := i.retryDelay(1)
+	require.True(t, ok)
+	require.Equal(t, 5*time.Second, delay)
+
+	delay, ok = i.retryDelay(2)
+	require.True(t, ok)
+	require.Equal(t, 7500*time.Millisecond, delay)
+
+	delay, ok = i.retryDelay(defaultMaxRetries)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, delay)
+
+	_, ok = i.retryDelay(defaultMaxRetries + 1)
+	require.False(t, ok)
+}
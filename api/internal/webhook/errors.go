@@ -0,0 +1,7 @@
+package webhook
+
+import "errors"
+
+var (
+	errEndpointDisabled = errors.New("webhook endpoint disabled")
+)
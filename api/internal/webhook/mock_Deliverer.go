@@ -0,0 +1,61 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package webhook
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeliverer is an autogenerated mock type for the Deliverer type
+type MockDeliverer struct {
+	mock.Mock
+}
+
+// DeliverDue provides a mock function with given fields: ctx
+func (_m *MockDeliverer) DeliverDue(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverDue")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *MockDeliverer) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// NewMockDeliverer creates a new instance of MockDeliverer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeliverer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeliverer {
+	mock := &MockDeliverer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
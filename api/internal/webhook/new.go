@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"omg/api/internal/repository"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 20
+	// The endpoints of a batch are called concurrently, so the lease only needs to outlast one call
+	defaultLease   = time.Minute
+	defaultTimeout = 10 * time.Second
+	// The retries follow pg.ExponentialBackOff, i.e. 5s growing up to 1min in between, ~8min in total
+	defaultMaxRetries = 12
+	// Only the beginning of the error is kept for the back-office
+	maxLastErrorLen = 1024
+)
+
+// Deliverer POSTs the pending webhook deliveries to the partner endpoints
+type Deliverer interface {
+	// Run polls & attempts the due deliveries until the ctx is done
+	Run(ctx context.Context)
+	// DeliverDue attempts one batch of due deliveries & returns how many were attempted
+	DeliverDue(ctx context.Context) (int, error)
+}
+
+// New returns an implementation instance satisfying Deliverer
+func New(repo repository.Registry) Deliverer {
+	return impl{
+		repo:         repo,
+		client:       &http.Client{Timeout: defaultTimeout},
+		pollInterval: defaultPollInterval,
+		batchSize:    defaultBatchSize,
+		lease:        defaultLease,
+		maxRetries:   defaultMaxRetries,
+	}
+}
+
+type impl struct {
+	repo         repository.Registry
+	client       *http.Client
+	pollInterval time.Duration
+	batchSize    int
+	lease        time.Duration
+	maxRetries   uint64
+}
@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Run polls & attempts the due deliveries until the ctx is done
+func (i impl) Run(ctx context.Context) {
+	log.Printf("Starting webhook deliverer")
+
+	ticker := time.NewTicker(i.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopping webhook deliverer")
+			return
+		case <-ticker.C:
+			// Keep draining while full batches come back so a backlog is not paced by the ticker
+			for {
+				attempted, err := i.DeliverDue(ctx)
+				if err != nil {
+					log.Printf("Failed to deliver webhooks: %v", err)
+					break
+				}
+				if attempted < i.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
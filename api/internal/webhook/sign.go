@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the signature of the delivery, see Sign
+	SignatureHeader = "X-Webhook-Signature"
+	// EventTypeHeader carries the type of the delivered event
+	EventTypeHeader = "X-Webhook-Event"
+	// DeliveryIDHeader carries the ID of the delivery, the same for all its attempts
+	DeliveryIDHeader = "X-Webhook-Delivery"
+)
+
+// Sign computes the signature of the body sent at the given time, as "t=<unix seconds>,v1=<hex HMAC-SHA256>".
+// The HMAC is keyed with the endpoint secret & covers "<unix seconds>.<body>",
+// so the partners can check the delivery comes from us & reject the old ones being replayed.
+func Sign(secret string, at time.Time, body []byte) string {
+	ts := strconv.FormatInt(at.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
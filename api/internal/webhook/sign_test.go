@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	// Given:
+	at := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"1","type":"order.created"}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	exp := "t=1700000000,v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	// When:
+	signature := Sign("secret", at, body)
+
+	// Then:
+	require.Equal(t, exp, signature)
+	require.NotEqual(t, signature, Sign("other", at, body))
+	require.NotEqual(t, signature, Sign("secret", at.Add(time.Second), body))
+}
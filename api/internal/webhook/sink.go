@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+)
+
+// Sink queues a delivery of the order events to each webhook endpoint subscribed to them
+type Sink struct{}
+
+// NewSink returns a Sink queueing the deliveries in the tx of the dispatcher
+func NewSink() Sink {
+	return Sink{}
+}
+
+// Name identifies the sink
+func (s Sink) Name() string {
+	return "webhooks"
+}
+
+// Publish queues the deliveries of the event, the events other than the order ones are skipped.
+// The endpoints are only called later by the Deliverer, so a slow partner does not hold the outbox back.
+// The deliveries are queued in the tx marking the event published, so they are committed or rolled back with it.
+func (s Sink) Publish(ctx context.Context, txRepo repository.Registry, event model.Event) error {
+	if !event.Type.IsOrderEvent() {
+		return nil
+	}
+
+	endpoints, err := txRepo.Webhook().ListEndpoints(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, endpoint := range endpoints {
+		if !endpoint.IsSubscribed(event.Type) {
+			continue
+		}
+
+		delivery, err := model.NewWebhookDelivery(endpoint, event)
+		if err != nil {
+			return err
+		}
+
+		if err = txRepo.Webhook().CreateDelivery(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
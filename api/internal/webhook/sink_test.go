@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/internal/repository/webhook"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSink_Publish(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	orderEvent := model.Event{
+		ID:        7,
+		Type:      model.EventTypeOrderCreated,
+		Payload:   json.RawMessage(`{"order_id":"11"}`),
+		CreatedAt: createdAt,
+	}
+	endpoints := []model.WebhookEndpoint{
+		{ID: 1, EventTypes: []model.EventType{model.EventTypeOrderCreated}, Status: model.WebhookEndpointStatusActive},
+		{ID: 2, EventTypes: []model.EventType{model.EventTypeOrderStatusChanged}, Status: model.WebhookEndpointStatusActive},
+		{ID: 3, EventTypes: []model.EventType{model.EventTypeOrderCreated}, Status: model.WebhookEndpointStatusDisabled},
+	}
+
+	type arg struct {
+		givenEvent    model.Event
+		mockEndpoints []model.WebhookEndpoint
+		mockListErr   error
+		mockCreateErr error
+		expEndpoints  []int64
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"success": {
+			givenEvent:    orderEvent,
+			mockEndpoints: endpoints,
+			expEndpoints:  []int64{1},
+		},
+		"not_an_order_event": {
+			givenEvent: model.Event{ID: 8, Type: model.EventTypeProductStockChanged},
+		},
+		"list_error": {
+			givenEvent:  orderEvent,
+			mockListErr: errors.New("database error"),
+			expErr:      errors.New("database error"),
+		},
+		"create_error": {
+			givenEvent:    orderEvent,
+			mockEndpoints: endpoints,
+			mockCreateErr: errors.New("database error"),
+			expEndpoints:  []int64{1},
+			expErr:        errors.New("database error"),
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			// Given:
+			webhookRepo := webhook.NewMockRepository(t)
+			if tc.givenEvent.Type.IsOrderEvent() {
+				webhookRepo.On("ListEndpoints", mock.Anything).Return(tc.mockEndpoints, tc.mockListErr)
+			}
+			for _, id := range tc.expEndpoints {
+				webhookRepo.On("CreateDelivery", mock.Anything, mock.MatchedBy(func(d model.WebhookDelivery) bool {
+					return d.EndpointID == id &&
+						d.EventID == tc.givenEvent.ID &&
+						d.EventType == tc.givenEvent.Type &&
+						d.Status == model.WebhookDeliveryStatusPending &&
+						string(d.Payload) == `{"id":"7","type":"order.created","created_at":"2024-01-02T03:04:05Z","data":{"order_id":"11"}}`
+				})).Return(tc.mockCreateErr)
+			}
+
+			mockRepo := repository.NewMockRegistry(t)
+			mockRepo.On("Webhook").Return(webhookRepo).Maybe()
+
+			// When:
+			err := NewSink().Publish(context.Background(), mockRepo, tc.givenEvent)
+
+			// Then:
+			if tc.expErr != nil {
+				require.EqualError(t, err, tc.expErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
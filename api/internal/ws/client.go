@@ -1,35 +1,74 @@
 package ws
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"sync"
 	"time"
 
+	"omg/api/internal/model"
+
 	"github.com/gorilla/websocket"
 )
 
 const (
-	writeWait      = 10 * time.Second
-	pongWait       = 60 * time.Second
-	pingPeriod     = (pongWait * 9) / 10
-	maxMessageSize = 512
+	writeWait        = 10 * time.Second
+	pongWait         = 60 * time.Second
+	pingPeriod       = (pongWait * 9) / 10
+	maxMessageSize   = 512
+	maxSubscriptions = 100
+	replayPageSize   = 200
+	// replayMaxEvents bounds the events replayed per subscribe, the clients which missed more must refetch the state
+	replayMaxEvents = 1000
+	replayTimeout   = 10 * time.Second
 )
 
 type Client struct {
-	hub    Hub
-	conn   *websocket.Conn
-	send   chan []byte
-	userID int64
+	hub      Hub
+	eventLog EventLog
+	conn     *websocket.Conn
+	send     chan ServerMessage
+	userID   int64
+	role     model.UserRole
+
+	mu            sync.RWMutex
+	subscriptions map[Topic]struct{}
+
+	// While replaying, the live events are held back, then sent after the replayed ones without duplicates
+	replaying bool
+	held      []ServerMessage
+	replayed  map[int64]struct{}
+
+	// done is closed once the client got removed from the hub, send is never closed so writers cannot panic
+	done      chan struct{}
+	closeOnce sync.Once
+	// closeMessage is the payload of the close frame, set before done is closed
+	closeMessage []byte
+	// written is closed once the writePump wrote the close frame & closed the connection
+	written chan struct{}
 }
 
-func NewClientWithUserID(hub Hub, conn *websocket.Conn, userID int64) *Client {
+func NewClient(hub Hub, eventLog EventLog, conn *websocket.Conn, userID int64, role model.UserRole) *Client {
 	return &Client{
-		hub:    hub,
-		conn:   conn,
-		send:   make(chan []byte, 256),
-		userID: userID,
+		hub:           hub,
+		eventLog:      eventLog,
+		conn:          conn,
+		send:          make(chan ServerMessage, 256),
+		userID:        userID,
+		role:          role,
+		subscriptions: make(map[Topic]struct{}),
+		done:          make(chan struct{}),
+		written:       make(chan struct{}),
 	}
 }
 
+// String identifies the client in logs
+func (c *Client) String() string {
+	return fmt.Sprintf("client(user %d, %p)", c.userID, c)
+}
+
 func (c *Client) readPump() {
 	defer func() {
 		c.hub.Unregister(c)
@@ -51,7 +90,10 @@ func (c *Client) readPump() {
 			}
 			break
 		}
-		c.hub.BroadcastMessage(message)
+		// Commands are answered to the sender only, nothing is ever relayed to other clients
+		if reply, ok := c.handleCommand(message); ok {
+			c.enqueue(reply)
+		}
 	}
 }
 
@@ -60,17 +102,19 @@ func (c *Client) writePump() {
 	defer func() {
 		ticker.Stop()
 		c.conn.Close()
+		close(c.written)
 	}()
 
 	for {
 		select {
-		case message, ok := <-c.send:
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			if !ok {
-				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
+		case msg := <-c.send:
+			message, err := json.Marshal(msg)
+			if err != nil {
+				log.Printf("Failed to encode message: %v", err)
+				continue
 			}
 
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			w, err := c.conn.NextWriter(websocket.TextMessage)
 			if err != nil {
 				return
@@ -80,6 +124,10 @@ func (c *Client) writePump() {
 			if err := w.Close(); err != nil {
 				return
 			}
+		case <-c.done:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			c.conn.WriteMessage(websocket.CloseMessage, c.closeMessage)
+			return
 		case <-ticker.C:
 			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
@@ -88,3 +136,293 @@ func (c *Client) writePump() {
 		}
 	}
 }
+
+// handleCommand applies the command sent by the client & returns the ack or the error to answer.
+// False means the answer is sent once the replay requested by the command is over.
+func (c *Client) handleCommand(message []byte) (ServerMessage, bool) {
+	var cmd Command
+	if err := json.Unmarshal(message, &cmd); err != nil {
+		return newError("", &Error{Code: ErrorCodeInvalidMessage, Message: "message must be a JSON command"}), true
+	}
+
+	if cmd.Version != ProtocolVersion {
+		return newError(cmd.ID, &Error{Code: ErrorCodeUnsupportedVersion, Message: "supported protocol version is 1"}), true
+	}
+
+	switch cmd.Type {
+	case MessageTypeSubscribe:
+		if cmd.After > 0 {
+			if !c.startReplay() {
+				return newError(cmd.ID, &Error{Code: ErrorCodeReplayInProgress, Message: "a replay is already in progress"}), true
+			}
+		}
+		if err := c.subscribe(cmd.Topic); err != nil {
+			c.endReplay()
+			return newError(cmd.ID, err), true
+		}
+		if cmd.After > 0 {
+			// Replayed aside, so that the pongs & the other commands are still read meanwhile
+			go c.answerReplay(cmd)
+			return ServerMessage{}, false
+		}
+	case MessageTypeUnsubscribe:
+		c.unsubscribe(cmd.Topic)
+	default:
+		return newError(cmd.ID, &Error{Code: ErrorCodeUnknownType, Message: "unknown command type " + string(cmd.Type)}), true
+	}
+
+	return newAck(cmd), true
+}
+
+// answerReplay replays the events missed by the client, then answers the command & sends the live events held back
+func (c *Client) answerReplay(cmd Command) {
+	defer c.endReplay()
+
+	incomplete, err := c.replay(cmd.Topic, cmd.After)
+	if err != nil {
+		log.Printf("Failed to replay events of %s to client %d: %v", cmd.Topic, c.userID, err)
+		c.enqueue(newError(cmd.ID, &Error{Code: ErrorCodeReplayFailed, Message: "fail to replay the missed events"}))
+		return
+	}
+
+	ack := newAck(cmd)
+	ack.Incomplete = incomplete
+	c.enqueue(ack)
+}
+
+func (c *Client) subscribe(topic Topic) *Error {
+	if err := topic.authorize(c.userID, c.role); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.subscriptions[topic]; !ok && len(c.subscriptions) >= maxSubscriptions {
+		return &Error{Code: ErrorCodeTooManySubscriptions, Message: "too many subscriptions"}
+	}
+	c.subscriptions[topic] = struct{}{}
+
+	return nil
+}
+
+func (c *Client) unsubscribe(topic Topic) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.subscriptions, topic)
+}
+
+func (c *Client) isSubscribed(topic Topic) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, ok := c.subscriptions[topic]
+	return ok
+}
+
+// match returns the subscribed topic through which the publication reaches the client, if any
+func (c *Client) match(p Publication) (Topic, bool) {
+	for _, topic := range []Topic{OrderTopic(p.OrderID), TopicMyOrders, TopicAllOrders, TopicProductStock} {
+		if c.allows(p, topic) && c.isSubscribed(topic) {
+			return topic, true
+		}
+	}
+
+	return "", false
+}
+
+// allows checks the publication belongs to the topic & the client may receive it
+func (c *Client) allows(p Publication, topic Topic) bool {
+	backOffice := c.role.IsBackOffice()
+	owner := c.userID != 0 && c.userID == p.UserID
+
+	switch {
+	case p.OrderID != 0 && topic == OrderTopic(p.OrderID):
+		return owner || backOffice
+	case p.OrderID != 0 && topic == TopicMyOrders:
+		return owner
+	case p.OrderID != 0 && topic == TopicAllOrders:
+		return backOffice
+	case p.ProductID != 0 && topic == TopicProductStock:
+		return backOffice
+	}
+
+	return false
+}
+
+// replay sends the events of the topic published after the given seq, up to replayMaxEvents, then catches up with the
+// live events held back meanwhile. It reports whether the replay is incomplete, which is when the given seq itself is
+// not retained anymore or when more events were missed than replayed.
+func (c *Client) replay(topic Topic, after int64) (bool, error) {
+	if c.eventLog == nil {
+		return true, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replayTimeout)
+	defer cancel()
+	go func() {
+		// Stop querying the events once the client is gone
+		select {
+		case <-c.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	filter := topic.eventFilter(c.userID, c.role)
+	incomplete := false
+	sent := 0
+	// last is the highest seq listed so far & caughtUp the highest held seq listed again up to
+	last, caughtUp := after, int64(0)
+	for fromSeq := after; ; {
+		events, err := c.eventLog.ListPublishedEvents(ctx, filter, fromSeq, replayPageSize)
+		if err != nil {
+			return false, err
+		}
+		if fromSeq == after && (len(events) == 0 || events[0].PublishedSeq != after) {
+			incomplete = true
+		}
+
+		for _, e := range events {
+			if e.PublishedSeq <= after {
+				continue
+			}
+			last = max(last, e.PublishedSeq)
+			if sent >= replayMaxEvents {
+				return true, nil
+			}
+			p, ok, err := NewPublication(e)
+			if err != nil || !ok || !c.allows(p, topic) {
+				continue
+			}
+			if !c.enqueueWait(ctx, newEvent(topic, p)) {
+				return false, ctx.Err()
+			}
+			c.markReplayed(p.Seq)
+			sent++
+		}
+
+		if len(events) == replayPageSize {
+			fromSeq = events[len(events)-1].PublishedSeq + 1
+			continue
+		}
+
+		// The held live events are committed, as is every event published before them. A listing which ran before
+		// their tx committed saw neither, so list again up to them for no event to be skipped between the replayed &
+		// the held ones.
+		held := c.heldUpTo()
+		if held <= last || held <= caughtUp {
+			return incomplete, nil
+		}
+		caughtUp = held
+		fromSeq = last + 1
+	}
+}
+
+// startReplay holds back the live events until endReplay, false when a replay is already in progress
+func (c *Client) startReplay() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.replaying {
+		return false
+	}
+	c.replaying = true
+	c.replayed = make(map[int64]struct{})
+	return true
+}
+
+// heldUpTo returns the highest seq of the live events held back, zero when none is
+func (c *Client) heldUpTo() int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var seq int64
+	for _, msg := range c.held {
+		seq = max(seq, msg.Seq)
+	}
+	return seq
+}
+
+func (c *Client) markReplayed(seq int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.replayed[seq] = struct{}{}
+}
+
+// endReplay sends the live events held back during the replay, skipping the ones already replayed
+func (c *Client) endReplay() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.replaying {
+		return
+	}
+	for _, msg := range c.held {
+		if _, ok := c.replayed[msg.Seq]; !ok {
+			c.enqueue(msg)
+		}
+	}
+	c.replaying = false
+	c.held = nil
+	c.replayed = nil
+}
+
+// deliver sends a live event, held back while replaying. False means the client cannot keep up.
+func (c *Client) deliver(msg ServerMessage) bool {
+	c.mu.Lock()
+	if c.replaying {
+		defer c.mu.Unlock()
+		if len(c.held) >= cap(c.send) {
+			return false
+		}
+		c.held = append(c.held, msg)
+		return true
+	}
+	c.mu.Unlock()
+
+	return c.enqueue(msg)
+}
+
+// enqueue queues the message to be written, it is dropped when the client is gone or too slow to keep up
+func (c *Client) enqueue(msg ServerMessage) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+
+	select {
+	case c.send <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+// enqueueWait queues the message to be written, waiting for room until the ctx is done
+func (c *Client) enqueueWait(ctx context.Context, msg ServerMessage) bool {
+	select {
+	case c.send <- msg:
+		return true
+	case <-c.done:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// close signals the pumps to stop, it is safe to call more than once
+func (c *Client) close() {
+	c.closeWith(nil)
+}
+
+// closeWith stops the pumps, the writePump sending the given payload in the close frame
+func (c *Client) closeWith(closeMessage []byte) {
+	c.closeOnce.Do(func() {
+		c.closeMessage = closeMessage
+		close(c.done)
+	})
+}
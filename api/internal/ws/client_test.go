@@ -0,0 +1,407 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_handleCommand(t *testing.T) {
+	type arg struct {
+		givenUserID  int64
+		givenRole    model.UserRole
+		givenSubs    []Topic
+		givenMessage string
+		expReply     ServerMessage
+		expSubs      []Topic
+	}
+
+	tcs := map[string]arg{
+		"subscribe_my_orders": {
+			givenUserID:  1,
+			givenRole:    model.UserRoleCustomer,
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"orders:mine"}`,
+			expReply:     ServerMessage{Version: 1, Type: MessageTypeAck, ID: "a1", Topic: TopicMyOrders},
+			expSubs:      []Topic{TopicMyOrders},
+		},
+		"subscribe_single_order": {
+			givenUserID:  1,
+			givenRole:    model.UserRoleCustomer,
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"order:123"}`,
+			expReply:     ServerMessage{Version: 1, Type: MessageTypeAck, ID: "a1", Topic: "order:123"},
+			expSubs:      []Topic{"order:123"},
+		},
+		"subscribe_product_stock_as_staff": {
+			givenUserID:  1,
+			givenRole:    model.UserRoleStaff,
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"products:stock"}`,
+			expReply:     ServerMessage{Version: 1, Type: MessageTypeAck, ID: "a1", Topic: TopicProductStock},
+			expSubs:      []Topic{TopicProductStock},
+		},
+		"subscribe_product_stock_as_customer": {
+			givenUserID:  1,
+			givenRole:    model.UserRoleCustomer,
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"products:stock"}`,
+			expReply: ServerMessage{Version: 1, Type: MessageTypeError, ID: "a1",
+				Error: &Error{Code: ErrorCodeForbidden, Message: "topic restricted to back office users"}},
+		},
+		"subscribe_all_orders_as_customer": {
+			givenUserID:  1,
+			givenRole:    model.UserRoleCustomer,
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"orders:all"}`,
+			expReply: ServerMessage{Version: 1, Type: MessageTypeError, ID: "a1",
+				Error: &Error{Code: ErrorCodeForbidden, Message: "topic restricted to back office users"}},
+		},
+		"subscribe_anonymous": {
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"orders:mine"}`,
+			expReply: ServerMessage{Version: 1, Type: MessageTypeError, ID: "a1",
+				Error: &Error{Code: ErrorCodeUnauthorized, Message: "authentication required to subscribe"}},
+		},
+		"subscribe_unknown_topic": {
+			givenUserID:  1,
+			givenRole:    model.UserRoleCustomer,
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"order:abc"}`,
+			expReply: ServerMessage{Version: 1, Type: MessageTypeError, ID: "a1",
+				Error: &Error{Code: ErrorCodeInvalidTopic, Message: `unknown topic "order:abc"`}},
+		},
+		"unsubscribe": {
+			givenUserID:  1,
+			givenRole:    model.UserRoleCustomer,
+			givenSubs:    []Topic{TopicMyOrders, "order:123"},
+			givenMessage: `{"v":1,"id":"a2","type":"unsubscribe","topic":"orders:mine"}`,
+			expReply:     ServerMessage{Version: 1, Type: MessageTypeAck, ID: "a2", Topic: TopicMyOrders},
+			expSubs:      []Topic{"order:123"},
+		},
+		"unsupported_version": {
+			givenUserID:  1,
+			givenMessage: `{"v":2,"id":"a1","type":"subscribe","topic":"orders:mine"}`,
+			expReply: ServerMessage{Version: 1, Type: MessageTypeError, ID: "a1",
+				Error: &Error{Code: ErrorCodeUnsupportedVersion, Message: "supported protocol version is 1"}},
+		},
+		"unknown_type": {
+			givenUserID:  1,
+			givenMessage: `{"v":1,"id":"a1","type":"broadcast","topic":"orders:mine"}`,
+			expReply: ServerMessage{Version: 1, Type: MessageTypeError, ID: "a1",
+				Error: &Error{Code: ErrorCodeUnknownType, Message: "unknown command type broadcast"}},
+		},
+		"not_json": {
+			givenUserID:  1,
+			givenMessage: `hello everyone`,
+			expReply: ServerMessage{Version: 1, Type: MessageTypeError,
+				Error: &Error{Code: ErrorCodeInvalidMessage, Message: "message must be a JSON command"}},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			c := NewClient(NewMockHub(t), nil, nil, tc.givenUserID, tc.givenRole)
+			for _, topic := range tc.givenSubs {
+				c.subscriptions[topic] = struct{}{}
+			}
+
+			// When:
+			reply, ok := c.handleCommand([]byte(tc.givenMessage))
+
+			// Then:
+			require.True(t, ok)
+			require.Equal(t, tc.expReply, reply)
+			var subs []Topic
+			for topic := range c.subscriptions {
+				subs = append(subs, topic)
+			}
+			require.ElementsMatch(t, tc.expSubs, subs)
+		})
+	}
+}
+
+func TestClient_match(t *testing.T) {
+	orderPub := Publication{OrderID: 123, UserID: 1, Data: json.RawMessage(`{}`)}
+	stockPub := Publication{ProductID: 456, Data: json.RawMessage(`{}`)}
+
+	type arg struct {
+		givenUserID int64
+		givenRole   model.UserRole
+		givenSubs   []Topic
+		givenPub    Publication
+		expTopic    Topic
+		expMatch    bool
+	}
+
+	tcs := map[string]arg{
+		"owner_single_order": {
+			givenUserID: 1,
+			givenRole:   model.UserRoleCustomer,
+			givenSubs:   []Topic{"order:123"},
+			givenPub:    orderPub,
+			expTopic:    "order:123",
+			expMatch:    true,
+		},
+		"owner_my_orders": {
+			givenUserID: 1,
+			givenRole:   model.UserRoleCustomer,
+			givenSubs:   []Topic{TopicMyOrders},
+			givenPub:    orderPub,
+			expTopic:    TopicMyOrders,
+			expMatch:    true,
+		},
+		"other_user_single_order": {
+			givenUserID: 2,
+			givenRole:   model.UserRoleCustomer,
+			givenSubs:   []Topic{"order:123"},
+			givenPub:    orderPub,
+		},
+		"other_user_my_orders": {
+			givenUserID: 2,
+			givenRole:   model.UserRoleCustomer,
+			givenSubs:   []Topic{TopicMyOrders},
+			givenPub:    orderPub,
+		},
+		"staff_single_order": {
+			givenUserID: 2,
+			givenRole:   model.UserRoleStaff,
+			givenSubs:   []Topic{"order:123"},
+			givenPub:    orderPub,
+			expTopic:    "order:123",
+			expMatch:    true,
+		},
+		"staff_all_orders": {
+			givenUserID: 2,
+			givenRole:   model.UserRoleAdmin,
+			givenSubs:   []Topic{TopicAllOrders},
+			givenPub:    orderPub,
+			expTopic:    TopicAllOrders,
+			expMatch:    true,
+		},
+		"staff_my_orders_of_other_user": {
+			givenUserID: 2,
+			givenRole:   model.UserRoleStaff,
+			givenSubs:   []Topic{TopicMyOrders},
+			givenPub:    orderPub,
+		},
+		"staff_product_stock": {
+			givenUserID: 2,
+			givenRole:   model.UserRoleStaff,
+			givenSubs:   []Topic{TopicProductStock},
+			givenPub:    stockPub,
+			expTopic:    TopicProductStock,
+			expMatch:    true,
+		},
+		"not_subscribed": {
+			givenUserID: 1,
+			givenRole:   model.UserRoleCustomer,
+			givenPub:    orderPub,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			c := NewClient(NewMockHub(t), nil, nil, tc.givenUserID, tc.givenRole)
+			for _, topic := range tc.givenSubs {
+				c.subscriptions[topic] = struct{}{}
+			}
+
+			// When:
+			topic, ok := c.match(tc.givenPub)
+
+			// Then:
+			require.Equal(t, tc.expMatch, ok)
+			require.Equal(t, tc.expTopic, topic)
+		})
+	}
+}
+
+func TestClient_replay(t *testing.T) {
+	orderEvent := func(id, orderID, userID int64) model.Event {
+		e, err := model.NewOrderEvent(model.EventTypeOrderStatusChanged, model.Order{ID: orderID, UserID: userID, Status: model.OrderStatusPaid})
+		require.NoError(t, err)
+		e.ID = id + 1000
+		e.PublishedSeq = id
+		return e
+	}
+	myOrders := model.PublishedEventFilter{
+		Types:  []model.EventType{model.EventTypeOrderCreated, model.EventTypeOrderStatusChanged},
+		UserID: 1,
+	}
+
+	type arg struct {
+		givenMessage string
+		givenLive    []ServerMessage
+		expFilter    model.PublishedEventFilter
+		mockEvents   []model.Event
+		mockErr      error
+		// mockCatchUp is listed again from mockCatchUpFrom, up to the live events held back meanwhile
+		mockCatchUpFrom int64
+		mockCatchUp     []model.Event
+		// expSent is the seq of the events sent in order, 0 standing for the reply to the command
+		expSent  []int64
+		expReply ServerMessage
+	}
+
+	tcs := map[string]arg{
+		"replay_gap": {
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"orders:mine","after":10}`,
+			expFilter:    myOrders,
+			mockEvents: []model.Event{
+				orderEvent(10, 123, 1),
+				orderEvent(11, 123, 1),
+				orderEvent(12, 124, 2), // order of another user
+				orderEvent(13, 125, 1),
+			},
+			expSent:  []int64{11, 13, 0},
+			expReply: ServerMessage{Version: 1, Type: MessageTypeAck, ID: "a1", Topic: TopicMyOrders},
+		},
+		"live_events_after_replay_without_duplicates": {
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"orders:mine","after":10}`,
+			expFilter:    myOrders,
+			givenLive: []ServerMessage{
+				{Version: 1, Type: MessageTypeEvent, Topic: TopicMyOrders, Seq: 11},
+				{Version: 1, Type: MessageTypeEvent, Topic: TopicMyOrders, Seq: 14},
+			},
+			mockEvents: []model.Event{
+				orderEvent(10, 123, 1),
+				orderEvent(11, 123, 1),
+			},
+			mockCatchUpFrom: 12,
+			expSent:         []int64{11, 0, 14},
+			expReply:        ServerMessage{Version: 1, Type: MessageTypeAck, ID: "a1", Topic: TopicMyOrders},
+		},
+		"held_live_event_after_a_missed_one": {
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"orders:mine","after":10}`,
+			expFilter:    myOrders,
+			givenLive: []ServerMessage{
+				{Version: 1, Type: MessageTypeEvent, Topic: TopicMyOrders, Seq: 12},
+			},
+			// Listed before the tx publishing 11 & 12 committed
+			mockEvents: []model.Event{
+				orderEvent(10, 123, 1),
+			},
+			mockCatchUpFrom: 11,
+			mockCatchUp: []model.Event{
+				orderEvent(11, 123, 1),
+				orderEvent(12, 123, 1),
+			},
+			expSent:  []int64{11, 12, 0},
+			expReply: ServerMessage{Version: 1, Type: MessageTypeAck, ID: "a1", Topic: TopicMyOrders},
+		},
+		"last_seen_not_retained": {
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"order:123","after":10}`,
+			expFilter: model.PublishedEventFilter{
+				Types:       []model.EventType{model.EventTypeOrderCreated, model.EventTypeOrderStatusChanged},
+				AggregateID: 123,
+				UserID:      1,
+			},
+			mockEvents: []model.Event{
+				orderEvent(20, 123, 1),
+			},
+			expSent:  []int64{20, 0},
+			expReply: ServerMessage{Version: 1, Type: MessageTypeAck, ID: "a1", Topic: "order:123", Incomplete: true},
+		},
+		"event_log_error": {
+			givenMessage: `{"v":1,"id":"a1","type":"subscribe","topic":"orders:mine","after":10}`,
+			expFilter:    myOrders,
+			mockErr:      errors.New("database error"),
+			expSent:      []int64{0},
+			expReply: ServerMessage{Version: 1, Type: MessageTypeError, ID: "a1",
+				Error: &Error{Code: ErrorCodeReplayFailed, Message: "fail to replay the missed events"}},
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			var c *Client
+			eventLog := NewMockEventLog(t)
+			eventLog.On("ListPublishedEvents", mock.Anything, tc.expFilter, int64(10), replayPageSize).
+				Run(func(mock.Arguments) {
+					// Live events keep coming while replaying
+					for _, msg := range tc.givenLive {
+						require.True(t, c.deliver(msg))
+					}
+				}).
+				Return(tc.mockEvents, tc.mockErr).
+				Once()
+			if tc.mockCatchUpFrom != 0 {
+				eventLog.On("ListPublishedEvents", mock.Anything, tc.expFilter, tc.mockCatchUpFrom, replayPageSize).
+					Return(tc.mockCatchUp, nil).
+					Once()
+			}
+			c = NewClient(NewMockHub(t), eventLog, nil, 1, model.UserRoleCustomer)
+
+			// When:
+			_, ok := c.handleCommand([]byte(tc.givenMessage))
+
+			// Then: answered once replayed
+			require.False(t, ok)
+			require.Eventually(t, func() bool {
+				c.mu.RLock()
+				defer c.mu.RUnlock()
+				return !c.replaying
+			}, time.Second, time.Millisecond)
+
+			var (
+				sent  []int64
+				reply ServerMessage
+			)
+			for len(c.send) > 0 {
+				msg := <-c.send
+				if msg.Type != MessageTypeEvent {
+					reply = msg
+				}
+				sent = append(sent, msg.Seq)
+			}
+			require.Equal(t, tc.expSent, sent)
+			require.Equal(t, tc.expReply, reply)
+		})
+	}
+}
+
+func TestClient_replay_bounded(t *testing.T) {
+	// Given: more events missed than replayed
+	eventLog := NewMockEventLog(t)
+	eventLog.On("ListPublishedEvents", mock.Anything, mock.Anything, mock.Anything, replayPageSize).
+		Return(func(_ context.Context, _ model.PublishedEventFilter, fromSeq int64, limit int) ([]model.Event, error) {
+			var events []model.Event
+			for seq := fromSeq; seq < fromSeq+int64(limit); seq++ {
+				e, err := model.NewOrderEvent(model.EventTypeOrderStatusChanged, model.Order{ID: 123, UserID: 1})
+				require.NoError(t, err)
+				e.PublishedSeq = seq
+				events = append(events, e)
+			}
+			return events, nil
+		})
+	c := NewClient(NewMockHub(t), eventLog, nil, 1, model.UserRoleCustomer)
+	c.send = make(chan ServerMessage, replayMaxEvents)
+	require.True(t, c.startReplay())
+	require.Nil(t, c.subscribe(TopicMyOrders))
+
+	// When:
+	incomplete, err := c.replay(TopicMyOrders, 10)
+
+	// Then:
+	require.NoError(t, err)
+	require.True(t, incomplete)
+	require.Len(t, c.send, replayMaxEvents)
+}
+
+func TestClient_handleCommand_replayInProgress(t *testing.T) {
+	// Given:
+	c := NewClient(NewMockHub(t), nil, nil, 1, model.UserRoleCustomer)
+	require.True(t, c.startReplay())
+
+	// When:
+	reply, ok := c.handleCommand([]byte(`{"v":1,"id":"a1","type":"subscribe","topic":"orders:mine","after":10}`))
+
+	// Then:
+	require.True(t, ok)
+	require.Equal(t, ServerMessage{Version: 1, Type: MessageTypeError, ID: "a1",
+		Error: &Error{Code: ErrorCodeReplayInProgress, Message: "a replay is already in progress"}}, reply)
+}
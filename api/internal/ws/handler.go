@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"strings"
 
+	"omg/api/internal/model"
+
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 )
@@ -20,7 +22,7 @@ var upgrader = websocket.Upgrader{
 }
 
 func (h *WebSocketHandler) Handle(c *gin.Context) {
-	if err := h.handleWebSocket(c, 0); err != nil {
+	if err := h.handleWebSocket(c, 0, ""); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 	}
 }
@@ -40,7 +42,7 @@ func (h *WebSocketHandler) HandleOrderUpdates(c *gin.Context) {
 		return
 	}
 
-	if err := h.handleWebSocket(c, claims.UserID); err != nil {
+	if err := h.handleWebSocket(c, claims.UserID, claims.Role); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 	}
 }
@@ -59,7 +61,7 @@ func (h *WebSocketHandler) getTokenFromHeader(c *gin.Context) (string, error) {
 	return tokenString, nil
 }
 
-func (h *WebSocketHandler) handleWebSocket(c *gin.Context, userID int64) error {
+func (h *WebSocketHandler) handleWebSocket(c *gin.Context, userID int64, role model.UserRole) error {
 	// Set required headers
 	h.setWebSocketHeaders(c)
 
@@ -71,7 +73,7 @@ func (h *WebSocketHandler) handleWebSocket(c *gin.Context, userID int64) error {
 	}
 
 	// Create and register client
-	client := NewClientWithUserID(h.hub, conn, userID)
+	client := NewClient(h.hub, h.eventLog, conn, userID, role)
 	h.hub.Register(client)
 
 	// Start message pumps
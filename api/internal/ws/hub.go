@@ -1,92 +1,148 @@
 package ws
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"log"
-	"strconv"
+
+	"omg/api/internal/repository"
+
+	"github.com/gorilla/websocket"
 )
 
+// ErrHubNotRunning means the loop of the hub did not answer in time
+var ErrHubNotRunning = errors.New("websocket hub not running")
+
+// shutdownCloseReason hints the clients to reconnect, to another replica, resuming from their last seen seq
+const shutdownCloseReason = "server restarting, reconnect and resume from the last seq"
+
 func (h *implHub) Run() {
 	log.Printf("Starting WebSocket hub")
 
 	for {
 		select {
 		case client := <-h.register:
-			log.Printf("New client registered: %v", client)
 			h.mu.Lock()
 			h.clients[client] = true
+			activeClients.Set(float64(len(h.clients)))
 			h.mu.Unlock()
 			log.Printf("Client registered. Total clients: %d", len(h.clients))
 
 		case client := <-h.unregister:
-			log.Printf("Client unregistered: %v", client)
 			h.mu.Lock()
-			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				close(client.send)
-			}
+			h.remove(client)
+			activeClients.Set(float64(len(h.clients)))
 			h.mu.Unlock()
 			log.Printf("Client unregistered. Total clients: %d", len(h.clients))
 
-		case message := <-h.Broadcast:
-			log.Printf("Broadcasting message: %+v", message)
-			h.mu.RLock()
+		case p := <-h.publish:
+			h.mu.Lock()
 			for client := range h.clients {
-				log.Printf("Starting...")
-				// If message is an order status update, check user ID
-				if msg, err := parseOrderStatusMessage(message); err == nil {
-					log.Printf("Broadcasting message: %+v", msg)
-					// Send to all clients if userID is 0, or only to matching user
-					userID, err := strconv.ParseInt(msg.UserID, 10, 64)
-					if err == nil {
-						log.Printf("Failed to parse data %v", msg.UserID)
-						close(client.send)
-						delete(h.clients, client)
-					}
-
-					if client.userID == 0 || client.userID == userID {
-						log.Printf("Sending order status update to user %d - OrderID: %s, Status: %s",
-							client.userID, msg.OrderID, msg.Status)
-						select {
-						case client.send <- message:
-						default:
-							log.Printf("Failed to send message to client %v", client)
-							close(client.send)
-							delete(h.clients, client)
-						}
-					}
-				} else {
-					// For non-order messages, send to all clients
-					select {
-					case client.send <- message:
-					default:
-						log.Printf("Failed to send message to client %v", client)
-						close(client.send)
-						delete(h.clients, client)
-					}
+				topic, ok := client.match(p)
+				if !ok {
+					continue
+				}
+				if !client.deliver(newEvent(topic, p)) {
+					log.Printf("Client %d too slow, disconnecting", client.userID)
+					droppedSends.Inc()
+					h.remove(client)
 				}
 			}
-			h.mu.RUnlock()
+			activeClients.Set(float64(len(h.clients)))
+			h.mu.Unlock()
+
+		case <-h.ping:
+
+		case <-h.stop:
+			h.mu.Lock()
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseServiceRestart, shutdownCloseReason)
+			for client := range h.clients {
+				delete(h.clients, client)
+				client.closeWith(closeMessage)
+				h.closing = append(h.closing, client)
+			}
+			activeClients.Set(0)
+			h.mu.Unlock()
+			log.Printf("Stopping WebSocket hub, %d clients disconnected", len(h.closing))
+			close(h.stopped)
+			return
 		}
 	}
 }
 
-func parseOrderStatusMessage(data []byte) (*OrderStatusMessage, error) {
-	var msg OrderStatusMessage
-	if err := json.Unmarshal(data, &msg); err != nil {
-		return nil, err
+// remove drops the client & stops its pumps, the lock must be held
+func (h *implHub) remove(client *Client) {
+	if _, ok := h.clients[client]; ok {
+		delete(h.clients, client)
+		client.close()
+	}
+}
+
+// Publish drops the event once the hub is stopped, the clients can replay it after reconnecting to another replica
+func (h *implHub) Publish(p Publication) {
+	select {
+	case h.publish <- p:
+	case <-h.stopped:
 	}
-	return &msg, nil
 }
 
-func (h *implHub) BroadcastMessage(message []byte) {
-	h.Broadcast <- message
+// PublishOnCommit publishes the event once the tx of txRepo commits
+func (h *implHub) PublishOnCommit(_ context.Context, txRepo repository.Registry, p Publication) error {
+	txRepo.AfterCommit(func() {
+		h.Publish(p)
+	})
+	return nil
 }
 
+// Register closes the client right away once the hub is stopped
 func (h *implHub) Register(client *Client) {
-	h.register <- client
+	select {
+	case h.register <- client:
+	case <-h.stopped:
+		client.closeWith(websocket.FormatCloseMessage(websocket.CloseServiceRestart, shutdownCloseReason))
+	}
 }
 
 func (h *implHub) Unregister(client *Client) {
-	h.unregister <- client
+	select {
+	case h.unregister <- client:
+	case <-h.stopped:
+	}
+}
+
+func (h *implHub) Ping(ctx context.Context) error {
+	select {
+	case h.ping <- struct{}{}:
+		return nil
+	case <-h.stopped:
+		return ErrHubNotRunning
+	case <-ctx.Done():
+		return ErrHubNotRunning
+	}
+}
+
+func (h *implHub) Close(ctx context.Context) error {
+	h.stopOnce.Do(func() {
+		close(h.stop)
+	})
+
+	select {
+	case <-h.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// Only the clients with a connection have a writePump, the others are written by their own transport
+	for _, client := range h.closing {
+		if client.conn == nil {
+			continue
+		}
+		select {
+		case <-client.written:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
 }
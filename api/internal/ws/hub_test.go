@@ -0,0 +1,105 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_Ping(t *testing.T) {
+	t.Run("running", func(t *testing.T) {
+		// Given:
+		hub := NewHub()
+		go hub.Run()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		// When:
+		err := hub.Ping(ctx)
+
+		// Then:
+		require.NoError(t, err)
+	})
+
+	t.Run("not_running", func(t *testing.T) {
+		// Given:
+		hub := NewHub()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// When:
+		err := hub.Ping(ctx)
+
+		// Then:
+		require.ErrorIs(t, err, ErrHubNotRunning)
+	})
+}
+
+func TestHub_Close(t *testing.T) {
+	// Given:
+	hub := NewHub()
+	go hub.Run()
+
+	// The handler runs outside the test goroutine, so it reports the upgrade error instead of failing the test itself
+	upgradeErr := make(chan error, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			upgradeErr <- err
+			return
+		}
+
+		client := NewClient(hub, nil, conn, 1, model.UserRoleCustomer)
+		hub.Register(client)
+		upgradeErr <- nil
+		go client.writePump()
+		go client.readPump()
+	}))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, <-upgradeErr)
+	// The client is registered once the hub answers a ping, as the loop handles one request at a time
+	require.NoError(t, hub.Ping(context.Background()))
+
+	events, err := Subscribe(context.Background(), hub, 2, model.UserRoleCustomer, TopicMyOrders)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// When:
+	err = hub.Close(ctx)
+
+	// Then:
+	require.NoError(t, err)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err = conn.ReadMessage()
+	var closeErr *websocket.CloseError
+	require.ErrorAs(t, err, &closeErr)
+	require.Equal(t, websocket.CloseServiceRestart, closeErr.Code)
+	require.Equal(t, shutdownCloseReason, closeErr.Text)
+
+	select {
+	case _, ok := <-events:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription not closed")
+	}
+
+	require.ErrorIs(t, hub.Ping(context.Background()), ErrHubNotRunning)
+	hub.Publish(Publication{Seq: 1, OrderID: 1, UserID: 1})
+}
@@ -3,14 +3,96 @@ package ws
 import (
 	"encoding/json"
 	"strconv"
+
+	"github.com/shopspring/decimal"
 )
 
+// ProtocolVersion is the version of the WebSocket protocol, carried by every message
+const ProtocolVersion = 1
+
+// MessageType is the type of the protocol messages
 type MessageType string
 
 const (
+	// MessageTypeSubscribe is sent by the client to start receiving the events of a topic
+	MessageTypeSubscribe MessageType = "subscribe"
+	// MessageTypeUnsubscribe is sent by the client to stop receiving the events of a topic
+	MessageTypeUnsubscribe MessageType = "unsubscribe"
+	// MessageTypeAck is sent by the server once a command got applied
+	MessageTypeAck MessageType = "ack"
+	// MessageTypeError is sent by the server when a command got rejected
+	MessageTypeError MessageType = "error"
+	// MessageTypeEvent is sent by the server for every event of a subscribed topic
+	MessageTypeEvent MessageType = "event"
+
+	// MessageTypeOrderStatus is the type of the event data about an order status
 	MessageTypeOrderStatus MessageType = "order_status"
+	// MessageTypeProductStock is the type of the event data about a product stock
+	MessageTypeProductStock MessageType = "product_stock"
+)
+
+// ErrorCode identifies why a command got rejected
+type ErrorCode string
+
+const (
+	ErrorCodeInvalidMessage       ErrorCode = "invalid_message"
+	ErrorCodeUnsupportedVersion   ErrorCode = "unsupported_version"
+	ErrorCodeUnknownType          ErrorCode = "unknown_type"
+	ErrorCodeInvalidTopic         ErrorCode = "invalid_topic"
+	ErrorCodeUnauthorized         ErrorCode = "unauthorized"
+	ErrorCodeForbidden            ErrorCode = "forbidden"
+	ErrorCodeTooManySubscriptions ErrorCode = "too_many_subscriptions"
+	ErrorCodeReplayFailed         ErrorCode = "replay_failed"
+	ErrorCodeReplayInProgress     ErrorCode = "replay_in_progress"
 )
 
+// Command is a message sent by the client
+type Command struct {
+	Version int         `json:"v"`
+	ID      string      `json:"id"`
+	Type    MessageType `json:"type"`
+	Topic   Topic       `json:"topic"`
+	// After is the seq of the last event seen by the client, the events published since are replayed on subscribe
+	After int64 `json:"after,omitempty"`
+}
+
+// ServerMessage is a message sent by the server
+type ServerMessage struct {
+	Version int             `json:"v"`
+	Type    MessageType     `json:"type"`
+	ID      string          `json:"id,omitempty"`
+	Topic   Topic           `json:"topic,omitempty"`
+	Seq     int64           `json:"seq,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+	// Incomplete is set on the ack of a replay when older events were not retained anymore
+	Incomplete bool `json:"incomplete,omitempty"`
+	// DataType is the type of the event data, carried by the data itself on the wire
+	DataType MessageType `json:"-"`
+}
+
+// Error describes why a command got rejected
+type Error struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newAck(cmd Command) ServerMessage {
+	return ServerMessage{Version: ProtocolVersion, Type: MessageTypeAck, ID: cmd.ID, Topic: cmd.Topic}
+}
+
+func newError(id string, err *Error) ServerMessage {
+	return ServerMessage{Version: ProtocolVersion, Type: MessageTypeError, ID: id, Error: err}
+}
+
+func newEvent(topic Topic, p Publication) ServerMessage {
+	return ServerMessage{Version: ProtocolVersion, Type: MessageTypeEvent, Topic: topic, Seq: p.Seq, Data: p.Data, DataType: p.Type}
+}
+
 type OrderStatusMessage struct {
 	Type      MessageType `json:"type"`
 	OrderID   string      `json:"order_id"`
@@ -19,12 +101,12 @@ type OrderStatusMessage struct {
 	TotalCost string      `json:"total_cost"`
 }
 
-func NewOrderStatusMessage(orderID, userID int64, status string, totalCost float64) *OrderStatusMessage {
+func NewOrderStatusMessage(orderID, userID int64, status string, totalCost decimal.Decimal) *OrderStatusMessage {
 	return &OrderStatusMessage{
 		Type:      MessageTypeOrderStatus,
 		OrderID:   strconv.FormatInt(orderID, 10),
 		UserID:    strconv.FormatInt(userID, 10),
-		TotalCost: strconv.FormatFloat(totalCost, 'f', -1, 64),
+		TotalCost: totalCost.String(),
 		Status:    status,
 	}
 }
@@ -32,3 +114,35 @@ func NewOrderStatusMessage(orderID, userID int64, status string, totalCost float
 func (m *OrderStatusMessage) ToJSON() ([]byte, error) {
 	return json.Marshal(m)
 }
+
+type ProductStockMessage struct {
+	Type      MessageType `json:"type"`
+	ProductID string      `json:"product_id"`
+	Stock     string      `json:"stock"`
+}
+
+func NewProductStockMessage(productID, stock int64) *ProductStockMessage {
+	return &ProductStockMessage{
+		Type:      MessageTypeProductStock,
+		ProductID: strconv.FormatInt(productID, 10),
+		Stock:     strconv.FormatInt(stock, 10),
+	}
+}
+
+func (m *ProductStockMessage) ToJSON() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// Publication is an event handed to the hub, delivered to the clients subscribed to a matching topic
+type Publication struct {
+	// Seq is the publishing seq of the outbox event, increasing in the order the events got published
+	Seq int64 `json:"seq"`
+	// OrderID & UserID are set for the order events, UserID being the owner of the order
+	OrderID int64 `json:"order_id,omitempty,string"`
+	UserID  int64 `json:"user_id,omitempty,string"`
+	// ProductID is set for the product events
+	ProductID int64 `json:"product_id,omitempty,string"`
+	// Type is the type of the data
+	Type MessageType     `json:"type,omitempty"`
+	Data json.RawMessage `json:"data"`
+}
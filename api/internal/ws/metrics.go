@@ -0,0 +1,19 @@
+package ws
+
+import (
+	"omg/api/pkg/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	activeClients = promauto.With(metrics.Registry).NewGauge(prometheus.GaugeOpts{
+		Name: "ws_active_clients",
+		Help: "Clients registered to the hub of this replica, the SSE streams & GraphQL subscriptions included.",
+	})
+	droppedSends = promauto.With(metrics.Registry).NewCounter(prometheus.CounterOpts{
+		Name: "ws_dropped_sends_total",
+		Help: "Events the hub could not deliver to clients too slow to keep up, which got disconnected.",
+	})
+)
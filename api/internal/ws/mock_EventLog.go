@@ -0,0 +1,59 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package ws
+
+import (
+	context "context"
+	model "omg/api/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEventLog is an autogenerated mock type for the EventLog type
+type MockEventLog struct {
+	mock.Mock
+}
+
+// ListPublishedEvents provides a mock function with given fields: ctx, filter, fromSeq, limit
+func (_m *MockEventLog) ListPublishedEvents(ctx context.Context, filter model.PublishedEventFilter, fromSeq int64, limit int) ([]model.Event, error) {
+	ret := _m.Called(ctx, filter, fromSeq, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPublishedEvents")
+	}
+
+	var r0 []model.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PublishedEventFilter, int64, int) ([]model.Event, error)); ok {
+		return rf(ctx, filter, fromSeq, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PublishedEventFilter, int64, int) []model.Event); ok {
+		r0 = rf(ctx, filter, fromSeq, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PublishedEventFilter, int64, int) error); ok {
+		r1 = rf(ctx, filter, fromSeq, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockEventLog creates a new instance of MockEventLog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventLog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventLog {
+	mock := &MockEventLog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,16 +2,75 @@
 
 package ws
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+	repository "omg/api/internal/repository"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockHub is an autogenerated mock type for the Hub type
 type MockHub struct {
 	mock.Mock
 }
 
-// BroadcastMessage provides a mock function with given fields: message
-func (_m *MockHub) BroadcastMessage(message []byte) {
-	_m.Called(message)
+// Close provides a mock function with given fields: ctx
+func (_m *MockHub) Close(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Ping provides a mock function with given fields: ctx
+func (_m *MockHub) Ping(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Publish provides a mock function with given fields: p
+func (_m *MockHub) Publish(p Publication) {
+	_m.Called(p)
+}
+
+// PublishOnCommit provides a mock function with given fields: ctx, txRepo, p
+func (_m *MockHub) PublishOnCommit(ctx context.Context, txRepo repository.Registry, p Publication) error {
+	ret := _m.Called(ctx, txRepo, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishOnCommit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.Registry, Publication) error); ok {
+		r0 = rf(ctx, txRepo, p)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // Register provides a mock function with given fields: client
@@ -17,6 +17,11 @@ func (_m *MockWebSocket) Handle(c *gin.Context) {
 	_m.Called(c)
 }
 
+// HandleOrderEvents provides a mock function with given fields: c
+func (_m *MockWebSocket) HandleOrderEvents(c *gin.Context) {
+	_m.Called(c)
+}
+
 // HandleOrderUpdates provides a mock function with given fields: c
 func (_m *MockWebSocket) HandleOrderUpdates(c *gin.Context) {
 	_m.Called(c)
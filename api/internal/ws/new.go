@@ -1,9 +1,12 @@
 package ws
 
 import (
+	"context"
 	"sync"
 
 	"omg/api/internal/authenticate"
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
 
 	"github.com/gin-gonic/gin"
 )
@@ -11,40 +14,69 @@ import (
 type WebSocket interface {
 	Handle(c *gin.Context)
 	HandleOrderUpdates(c *gin.Context)
+	HandleOrderEvents(c *gin.Context)
 }
 
-func NewWebSocketHandler(hub Hub, authService authenticate.AuthService) *WebSocketHandler {
+func NewWebSocketHandler(hub Hub, authService authenticate.AuthService, eventLog EventLog) *WebSocketHandler {
 	return &WebSocketHandler{
 		hub:         hub,
 		authService: authService,
+		eventLog:    eventLog,
 	}
 }
 
 type WebSocketHandler struct {
 	hub         Hub
 	authService authenticate.AuthService
+	eventLog    EventLog
+}
+
+// EventLog keeps the recently published events, to replay the ones missed by the clients
+type EventLog interface {
+	// ListPublishedEvents gets the published events matching the filter from the given seq onwards (included),
+	// in publishing order
+	ListPublishedEvents(ctx context.Context, filter model.PublishedEventFilter, fromSeq int64, limit int) ([]model.Event, error)
 }
 
 type Hub interface {
 	Run()
 	Register(client *Client)
 	Unregister(client *Client)
-	BroadcastMessage(message []byte)
+	// Publish delivers the event to the clients subscribed to a matching topic
+	Publish(p Publication)
+	// PublishOnCommit publishes the event once the tx of txRepo commits & drops it if the tx rolls back, so the clients
+	// never see the seq of an event which is not committed as published
+	PublishOnCommit(ctx context.Context, txRepo repository.Registry, p Publication) error
+	// Ping checks the hub is running, by waiting for its loop to answer
+	Ping(ctx context.Context) error
+	// Close disconnects every client with a close frame hinting to reconnect, then stops the hub.
+	// It waits for the close frames to be written until the context is done.
+	Close(ctx context.Context) error
 }
 
 func NewHub() Hub {
 	return &implHub{
-		Broadcast:  make(chan []byte),
+		publish:    make(chan Publication),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
+		ping:       make(chan struct{}),
+		stop:       make(chan struct{}),
+		stopped:    make(chan struct{}),
 		clients:    make(map[*Client]bool),
 	}
 }
 
 type implHub struct {
 	clients    map[*Client]bool
-	Broadcast  chan []byte
+	publish    chan Publication
 	register   chan *Client
 	unregister chan *Client
+	ping       chan struct{}
 	mu         sync.RWMutex
+	// stop is closed to ask the loop to disconnect the clients, stopped once it did & returned
+	stop     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+	// closing holds the clients disconnected on stop, to wait for their close frames
+	closing []*Client
 }
@@ -0,0 +1,179 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"omg/api/internal/repository"
+	"omg/api/pkg/db/pg"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// ErrHubNotListening means the hub does not receive the publications of the other replicas
+var ErrHubNotListening = errors.New("websocket hub not listening to the other replicas")
+
+const (
+	pgHubChannel          = "ws_broadcast"
+	pgHubNotifyTimeout    = 5 * time.Second
+	pgHubListenRetryDelay = time.Second
+	pgHubListenRetryMax   = time.Minute
+)
+
+// NewPGHub returns a Hub fanning the publications out to every replica through Postgres NOTIFY.
+// Each replica LISTENs to the channel & delivers the events to its locally connected clients.
+func NewPGHub(dbConn pg.ContextExecutor, listener pg.Listener) Hub {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = pgHubListenRetryDelay
+	b.MaxInterval = pgHubListenRetryMax
+	// Keep retrying until the hub is closed
+	b.MaxElapsedTime = 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &pgHub{
+		local:         NewHub(),
+		dbConn:        dbConn,
+		listener:      listener,
+		listenBackOff: b,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+type pgHub struct {
+	local         Hub
+	dbConn        pg.ContextExecutor
+	listener      pg.Listener
+	listenBackOff backoff.BackOff
+	// listening is set while the notifications of the channel are received, until then the publications of this
+	// replica are delivered to its clients directly
+	listening atomic.Bool
+	// ctx is cancelled once the hub is closed, to stop retrying to listen
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (h *pgHub) Run() {
+	go h.local.Run()
+
+	if err := backoff.RetryNotify(
+		func() error {
+			return h.listener.Listen(pgHubChannel)
+		},
+		backoff.WithContext(h.listenBackOff, h.ctx),
+		func(err error, next time.Duration) {
+			log.Printf("Failed to listen to %s, only local clients are notified, retrying in %s: %v", pgHubChannel, next, err)
+		},
+	); err != nil {
+		log.Printf("Stopped listening to %s: %v", pgHubChannel, err)
+		return
+	}
+
+	h.listening.Store(true)
+	defer h.listening.Store(false)
+
+	for n := range h.listener.Notifications() {
+		var p Publication
+		if err := json.Unmarshal([]byte(n.Payload), &p); err != nil {
+			log.Printf("Failed to decode %s notification: %v", pgHubChannel, err)
+			continue
+		}
+		h.local.Publish(p)
+	}
+}
+
+func (h *pgHub) Register(client *Client) {
+	h.local.Register(client)
+}
+
+func (h *pgHub) Unregister(client *Client) {
+	h.local.Unregister(client)
+}
+
+// Ping checks the local loop is running & the listener receives the notifications of the other replicas
+func (h *pgHub) Ping(ctx context.Context) error {
+	if err := h.local.Ping(ctx); err != nil {
+		return err
+	}
+
+	if !h.listening.Load() {
+		return ErrHubNotListening
+	}
+
+	// The listener cannot be cancelled, so the ping is left to finish in the background once ctx is done
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- h.listener.Ping()
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrHubNotListening, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ErrHubNotListening
+	}
+}
+
+// Close disconnects the local clients, the notifications received until the listener is closed are dropped
+func (h *pgHub) Close(ctx context.Context) error {
+	h.cancel()
+	return h.local.Close(ctx)
+}
+
+// Publish notifies every replica, including this one, which then delivers the event to its clients.
+// While this replica is not listening, it delivers the event to its clients itself.
+// The notification is sent right away, the events published by a tx go through PublishOnCommit instead.
+func (h *pgHub) Publish(p Publication) {
+	listening := h.listening.Load()
+
+	payload, err := json.Marshal(p)
+	if err != nil {
+		log.Printf("Failed to encode publication: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pgHubNotifyTimeout)
+	defer cancel()
+
+	if err := pg.Notify(ctx, h.dbConn, pgHubChannel, string(payload)); err != nil {
+		// Still reach the clients connected to this replica
+		log.Printf("Failed to notify %s, publishing locally: %v", pgHubChannel, err)
+		h.local.Publish(p)
+		return
+	}
+
+	if !listening {
+		h.local.Publish(p)
+	}
+}
+
+// PublishOnCommit notifies every replica within the tx of txRepo, so Postgres only delivers the notification once the
+// tx commits & drops it if the tx rolls back. While this replica is not listening, it delivers the event to its clients
+// itself, once the tx committed.
+func (h *pgHub) PublishOnCommit(ctx context.Context, txRepo repository.Registry, p Publication) error {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	if err = txRepo.Notify(ctx, pgHubChannel, string(payload)); err != nil {
+		return err
+	}
+
+	txRepo.AfterCommit(func() {
+		if !h.listening.Load() {
+			h.local.Publish(p)
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package ws
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+	"omg/api/pkg/db/pg"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// stubListener fails to listen the given number of times before succeeding
+type stubListener struct {
+	mu            sync.Mutex
+	failures      int
+	attempts      int
+	pingErr       error
+	notifications chan pg.Notification
+}
+
+func (l *stubListener) Listen(string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.attempts++
+	if l.attempts <= l.failures {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func (l *stubListener) isListening() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.attempts > l.failures
+}
+
+func (l *stubListener) Notifications() <-chan pg.Notification {
+	return l.notifications
+}
+
+func (l *stubListener) Ping() error {
+	return l.pingErr
+}
+
+func (l *stubListener) Close() error {
+	close(l.notifications)
+	return nil
+}
+
+// stubNotifier accepts every NOTIFY without delivering it
+type stubNotifier struct {
+	pg.ContextExecutor
+}
+
+func (stubNotifier) ExecContext(context.Context, string, ...interface{}) (sql.Result, error) {
+	return nil, nil
+}
+
+// stubTx stands for the tx of the dispatcher, Postgres delivers its notifications to the listening replicas once
+// committed & drops them on rollback
+type stubTx struct {
+	repository.Registry
+	listener      *stubListener
+	notifications []pg.Notification
+	afterCommit   []func()
+}
+
+func (tx *stubTx) Notify(_ context.Context, channel, payload string) error {
+	tx.notifications = append(tx.notifications, pg.Notification{Channel: channel, Payload: payload})
+	return nil
+}
+
+func (tx *stubTx) AfterCommit(f func()) {
+	tx.afterCommit = append(tx.afterCommit, f)
+}
+
+func (tx *stubTx) commit() {
+	if tx.listener.isListening() {
+		for _, n := range tx.notifications {
+			tx.listener.notifications <- n
+		}
+	}
+	for _, f := range tx.afterCommit {
+		f()
+	}
+}
+
+func newTestPGHub(listener pg.Listener) *pgHub {
+	hub := NewPGHub(stubNotifier{}, listener).(*pgHub)
+	hub.listenBackOff = backoff.NewConstantBackOff(time.Millisecond)
+	return hub
+}
+
+func TestPGHub_Run(t *testing.T) {
+	t.Run("retries_listen", func(t *testing.T) {
+		// Given:
+		listener := &stubListener{failures: 2, notifications: make(chan pg.Notification)}
+		hub := newTestPGHub(listener)
+		defer listener.Close()
+
+		// When:
+		go hub.Run()
+
+		// Then:
+		require.Eventually(t, hub.listening.Load, time.Second, time.Millisecond)
+		listener.mu.Lock()
+		defer listener.mu.Unlock()
+		require.Equal(t, 3, listener.attempts)
+	})
+
+	t.Run("stops_retrying_once_closed", func(t *testing.T) {
+		// Given:
+		listener := &stubListener{failures: 1 << 30, notifications: make(chan pg.Notification)}
+		hub := newTestPGHub(listener)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			hub.Run()
+		}()
+
+		// When:
+		require.NoError(t, hub.Close(context.Background()))
+
+		// Then:
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("hub still running")
+		}
+		require.False(t, hub.listening.Load())
+	})
+}
+
+func TestPGHub_Ping(t *testing.T) {
+	type arg struct {
+		givenFailures int
+		givenPingErr  error
+		expErr        error
+	}
+
+	tcs := map[string]arg{
+		"listening": {},
+		"not_listening": {
+			givenFailures: 1 << 30,
+			expErr:        ErrHubNotListening,
+		},
+		"listener_connection_lost": {
+			givenPingErr: errors.New("no connection"),
+			expErr:       ErrHubNotListening,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			listener := &stubListener{
+				failures:      tc.givenFailures,
+				pingErr:       tc.givenPingErr,
+				notifications: make(chan pg.Notification),
+			}
+			hub := newTestPGHub(listener)
+			go hub.Run()
+			defer listener.Close()
+			defer hub.Close(context.Background())
+			if tc.givenFailures == 0 {
+				require.Eventually(t, hub.listening.Load, time.Second, time.Millisecond)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			// When:
+			err := hub.Ping(ctx)
+
+			// Then:
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPGHub_Publish(t *testing.T) {
+	type arg struct {
+		givenFailures int
+		expLocal      bool
+	}
+
+	tcs := map[string]arg{
+		"listening": {
+			expLocal: false,
+		},
+		"not_listening": {
+			givenFailures: 1 << 30,
+			expLocal:      true,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			listener := &stubListener{failures: tc.givenFailures, notifications: make(chan pg.Notification)}
+			hub := newTestPGHub(listener)
+			go hub.Run()
+			defer listener.Close()
+			defer hub.Close(context.Background())
+			if tc.givenFailures == 0 {
+				require.Eventually(t, hub.listening.Load, time.Second, time.Millisecond)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			events, err := Subscribe(ctx, hub, 1, model.UserRoleCustomer, TopicMyOrders)
+			require.NoError(t, err)
+			require.NoError(t, hub.local.Ping(ctx))
+
+			// When:
+			hub.Publish(Publication{Seq: 1, OrderID: 123, UserID: 1, Data: json.RawMessage(`{}`)})
+
+			// Then: a listening replica waits for its own notification, which the stub never delivers
+			_, received := <-events
+			require.Equal(t, tc.expLocal, received)
+		})
+	}
+}
+
+func TestPGHub_PublishOnCommit(t *testing.T) {
+	type arg struct {
+		givenFailures int
+		givenCommit   bool
+		expReceived   bool
+	}
+
+	tcs := map[string]arg{
+		"listening_committed": {
+			givenCommit: true,
+			expReceived: true,
+		},
+		"listening_rolled_back": {},
+		"not_listening_committed": {
+			givenFailures: 1 << 30,
+			givenCommit:   true,
+			expReceived:   true,
+		},
+		"not_listening_rolled_back": {
+			givenFailures: 1 << 30,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			listener := &stubListener{failures: tc.givenFailures, notifications: make(chan pg.Notification)}
+			hub := newTestPGHub(listener)
+			go hub.Run()
+			defer listener.Close()
+			defer hub.Close(context.Background())
+			if tc.givenFailures == 0 {
+				require.Eventually(t, hub.listening.Load, time.Second, time.Millisecond)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			events, err := Subscribe(ctx, hub, 1, model.UserRoleCustomer, TopicMyOrders)
+			require.NoError(t, err)
+			require.NoError(t, hub.local.Ping(ctx))
+
+			tx := &stubTx{listener: listener}
+
+			// When:
+			err = hub.PublishOnCommit(ctx, tx, Publication{Seq: 1, OrderID: 123, UserID: 1, Data: json.RawMessage(`{}`)})
+			require.NoError(t, err)
+			if tc.givenCommit {
+				tx.commit()
+			}
+
+			// Then: nothing is sent before the commit, nor ever once rolled back
+			msg, received := <-events
+			require.Equal(t, tc.expReceived, received)
+			if received {
+				require.Equal(t, int64(1), msg.Seq)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package ws
+
+import (
+	"encoding/json"
+
+	"omg/api/internal/model"
+)
+
+// NewPublication converts the outbox event to what the hub publishes, false when the event has no WebSocket counterpart
+func NewPublication(event model.Event) (Publication, bool, error) {
+	var (
+		p   Publication
+		err error
+	)
+	switch event.Type {
+	case model.EventTypeOrderCreated, model.EventTypeOrderStatusChanged:
+		p, err = orderPublication(event)
+	case model.EventTypeProductStockChanged:
+		p, err = productStockPublication(event)
+	default:
+		return Publication{}, false, nil
+	}
+	if err != nil {
+		return Publication{}, false, err
+	}
+
+	p.Seq = event.PublishedSeq
+	return p, true, nil
+}
+
+func orderPublication(event model.Event) (Publication, error) {
+	var payload model.OrderEventPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return Publication{}, err
+	}
+
+	data, err := NewOrderStatusMessage(payload.OrderID, payload.UserID, payload.Status.String(), payload.TotalCost).ToJSON()
+	if err != nil {
+		return Publication{}, err
+	}
+
+	return Publication{OrderID: payload.OrderID, UserID: payload.UserID, Type: MessageTypeOrderStatus, Data: data}, nil
+}
+
+func productStockPublication(event model.Event) (Publication, error) {
+	var payload model.ProductStockEventPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return Publication{}, err
+	}
+
+	data, err := NewProductStockMessage(payload.ProductID, payload.Stock).ToJSON()
+	if err != nil {
+		return Publication{}, err
+	}
+
+	return Publication{ProductID: payload.ProductID, Type: MessageTypeProductStock, Data: data}, nil
+}
@@ -0,0 +1,34 @@
+package ws
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+)
+
+// HubSink publishes the outbox events to the clients connected to the hub
+type HubSink struct {
+	hub Hub
+}
+
+// NewHubSink returns a HubSink publishing through the given hub
+func NewHubSink(hub Hub) HubSink {
+	return HubSink{hub: hub}
+}
+
+// Name identifies the sink
+func (s HubSink) Name() string {
+	return "ws_hub"
+}
+
+// Publish hands the event to the hub once the tx of the dispatcher commits, the events without WebSocket counterpart
+// are skipped
+func (s HubSink) Publish(ctx context.Context, txRepo repository.Registry, event model.Event) error {
+	p, ok, err := NewPublication(event)
+	if err != nil || !ok {
+		return err
+	}
+
+	return s.hub.PublishOnCommit(ctx, txRepo, p)
+}
@@ -0,0 +1,80 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"omg/api/internal/model"
+	"omg/api/internal/repository"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubSink_Publish(t *testing.T) {
+	orderEvent, err := model.NewOrderEvent(model.EventTypeOrderStatusChanged, model.Order{
+		ID:        123,
+		UserID:    1,
+		Status:    model.OrderStatusPaid,
+		TotalCost: decimal.RequireFromString("10.5"),
+	})
+	require.NoError(t, err)
+	stockEvent, err := model.NewProductStockEvent(model.Product{ID: 456, Stock: 7})
+	require.NoError(t, err)
+
+	type arg struct {
+		givenEvent model.Event
+		expPub     *Publication
+		expErr     bool
+	}
+
+	tcs := map[string]arg{
+		"order_event": {
+			givenEvent: orderEvent,
+			expPub: &Publication{
+				OrderID: 123,
+				UserID:  1,
+				Type:    MessageTypeOrderStatus,
+				Data:    json.RawMessage(`{"type":"order_status","order_id":"123","user_id":"1","status":"PAID","total_cost":"10.5"}`),
+			},
+		},
+		"product_stock_event": {
+			givenEvent: stockEvent,
+			expPub: &Publication{
+				ProductID: 456,
+				Type:      MessageTypeProductStock,
+				Data:      json.RawMessage(`{"type":"product_stock","product_id":"456","stock":"7"}`),
+			},
+		},
+		"unknown_event": {
+			givenEvent: model.Event{Type: "user.created"},
+		},
+		"invalid_payload": {
+			givenEvent: model.Event{Type: model.EventTypeOrderCreated, Payload: json.RawMessage(`[]`)},
+			expErr:     true,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			txRepo := &repository.MockRegistry{}
+			hub := NewMockHub(t)
+			if tc.expPub != nil {
+				hub.On("PublishOnCommit", mock.Anything, txRepo, *tc.expPub).Return(nil)
+			}
+
+			// When:
+			err := NewHubSink(hub).Publish(context.Background(), txRepo, tc.givenEvent)
+
+			// Then:
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package ws
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	sseHeartbeatPeriod = 15 * time.Second
+	sseRetry           = 5 * time.Second
+)
+
+// HandleOrderEvents streams the order status events with Server-Sent Events, for the clients which cannot use WebSocket.
+// The user gets the events of their own orders, the back office users the ones of all the orders.
+// Query params: order_id to only follow a single order & last_event_id to resume, also read from the Last-Event-ID header.
+func (h *WebSocketHandler) HandleOrderEvents(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+		return
+	}
+	role := model.UserRole(c.GetString("role"))
+
+	topic := TopicMyOrders
+	if role.IsBackOffice() {
+		topic = TopicAllOrders
+	}
+	if v := c.Query("order_id"); v != "" {
+		orderID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || orderID <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order_id"})
+			return
+		}
+		topic = OrderTopic(orderID)
+	}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	var after int64
+	if lastEventID != "" {
+		var err error
+		if after, err = strconv.ParseInt(lastEventID, 10, 64); err != nil || after < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid last event id"})
+			return
+		}
+	}
+
+	client := NewClient(h.hub, h.eventLog, nil, userID, role)
+	if after > 0 {
+		client.startReplay()
+	}
+	if err := client.subscribe(topic); err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Message})
+		return
+	}
+	h.hub.Register(client)
+	defer h.hub.Unregister(client)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Ask the reverse proxies not to buffer the stream
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	// The stream outlives the write timeout of the server, so every write gets its own deadline
+	rc := http.NewResponseController(c.Writer)
+	write := func(f func() error) error {
+		// Writers without deadlines, like the ones of the tests, are written to as is
+		if err := rc.SetWriteDeadline(time.Now().Add(writeWait)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		if err := f(); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	if err := write(func() error {
+		_, err := fmt.Fprintf(c.Writer, "retry: %d\n\n", sseRetry.Milliseconds())
+		return err
+	}); err != nil {
+		log.Printf("Failed to start the event stream of client %d: %v", userID, err)
+		return
+	}
+
+	if after > 0 {
+		// Replayed concurrently as the events are only drained below
+		go func() {
+			if _, err := client.replay(topic, after); err != nil {
+				log.Printf("Failed to replay events of %s to client %d: %v", topic, userID, err)
+			}
+			client.endReplay()
+		}()
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeatPeriod)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-client.done:
+			return
+		case <-heartbeat.C:
+			// Comments keep the connection open through the proxies
+			if err := write(func() error {
+				_, err := fmt.Fprint(c.Writer, ": heartbeat\n\n")
+				return err
+			}); err != nil {
+				log.Printf("Failed to write heartbeat to client %d: %v", userID, err)
+				return
+			}
+		case msg := <-client.send:
+			if msg.Type != MessageTypeEvent {
+				continue
+			}
+			if err := write(func() error {
+				return writeSSEvent(c.Writer, msg)
+			}); err != nil {
+				log.Printf("Failed to write event to client %d: %v", userID, err)
+				return
+			}
+		}
+	}
+}
+
+// writeSSEvent writes the event sent by the hub as an SSE event, with its seq as the event id
+func writeSSEvent(w io.Writer, msg ServerMessage) error {
+	if msg.DataType == "" {
+		_, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", msg.Seq, msg.Data)
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", msg.Seq, msg.DataType, msg.Data)
+	return err
+}
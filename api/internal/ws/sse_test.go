@@ -0,0 +1,193 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"omg/api/internal/authenticate"
+	"omg/api/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketHandler_HandleOrderEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	replayed, err := model.NewOrderEvent(model.EventTypeOrderStatusChanged, model.Order{ID: 123, UserID: 1, Status: model.OrderStatusShipped})
+	require.NoError(t, err)
+	replayed.ID = 3
+	replayed.PublishedSeq = 11
+
+	type arg struct {
+		givenUserID  int64
+		givenRole    model.UserRole
+		givenQuery   string
+		givenHeaders map[string]string
+		givenLive    *Publication
+		mockEvents   []model.Event
+		expTopic     Topic
+		expStatus    int
+		expBody      []string
+	}
+
+	tcs := map[string]arg{
+		"stream_my_orders": {
+			givenUserID: 1,
+			givenRole:   model.UserRoleCustomer,
+			givenLive: &Publication{Seq: 42, OrderID: 123, UserID: 1, Type: MessageTypeOrderStatus,
+				Data: []byte(`{"type":"order_status","order_id":"123","user_id":"1","status":"PAID","total_cost":"21"}`)},
+			expTopic:  TopicMyOrders,
+			expStatus: http.StatusOK,
+			expBody: []string{
+				"retry: 5000\n\n",
+				"id: 42\nevent: order_status\ndata: {\"type\":\"order_status\",\"order_id\":\"123\",\"user_id\":\"1\",\"status\":\"PAID\",\"total_cost\":\"21\"}\n\n",
+			},
+		},
+		"stream_all_orders_for_staff": {
+			givenUserID: 2,
+			givenRole:   model.UserRoleStaff,
+			expTopic:    TopicAllOrders,
+			expStatus:   http.StatusOK,
+			expBody:     []string{"retry: 5000\n\n"},
+		},
+		"stream_single_order": {
+			givenUserID: 1,
+			givenRole:   model.UserRoleCustomer,
+			givenQuery:  "?order_id=123",
+			expTopic:    "order:123",
+			expStatus:   http.StatusOK,
+			expBody:     []string{"retry: 5000\n\n"},
+		},
+		"resume_from_last_event_id": {
+			givenUserID:  1,
+			givenRole:    model.UserRoleCustomer,
+			givenHeaders: map[string]string{"Last-Event-ID": "10"},
+			mockEvents:   []model.Event{replayed},
+			expTopic:     TopicMyOrders,
+			expStatus:    http.StatusOK,
+			expBody: []string{
+				"id: 11\nevent: order_status\ndata: {\"type\":\"order_status\",\"order_id\":\"123\",\"user_id\":\"1\",\"status\":\"SHIPPED\",\"total_cost\":\"0\"}\n\n",
+			},
+		},
+		"unauthorized": {
+			expStatus: http.StatusUnauthorized,
+		},
+		"invalid_order_id": {
+			givenUserID: 1,
+			givenQuery:  "?order_id=abc",
+			expStatus:   http.StatusBadRequest,
+		},
+		"invalid_last_event_id": {
+			givenUserID:  1,
+			givenHeaders: map[string]string{"Last-Event-ID": "abc"},
+			expStatus:    http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			// Given:
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			hub := NewMockHub(t)
+			eventLog := NewMockEventLog(t)
+			if tc.expStatus == http.StatusOK {
+				hub.On("Register", mock.Anything).Run(func(args mock.Arguments) {
+					client := args.Get(0).(*Client)
+					require.True(t, client.isSubscribed(tc.expTopic))
+					if tc.givenLive != nil {
+						topic, ok := client.match(*tc.givenLive)
+						require.True(t, ok)
+						require.True(t, client.deliver(newEvent(topic, *tc.givenLive)))
+					}
+					// Let the stream flow, then the client goes away
+					time.AfterFunc(200*time.Millisecond, cancel)
+				}).Return()
+				hub.On("Unregister", mock.Anything).Return()
+			}
+			if tc.mockEvents != nil {
+				eventLog.On("ListPublishedEvents", mock.Anything, TopicMyOrders.eventFilter(1, model.UserRoleCustomer), int64(10), replayPageSize).Return(tc.mockEvents, nil)
+			}
+
+			h := NewWebSocketHandler(hub, authenticate.AuthService{}, eventLog)
+			router := gin.New()
+			router.GET("/events", func(c *gin.Context) {
+				if tc.givenUserID != 0 {
+					c.Set("user_id", tc.givenUserID)
+				}
+				c.Set("role", string(tc.givenRole))
+			}, h.HandleOrderEvents)
+
+			req := httptest.NewRequest(http.MethodGet, "/events"+tc.givenQuery, nil).WithContext(ctx)
+			for k, v := range tc.givenHeaders {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			// When:
+			router.ServeHTTP(w, req)
+
+			// Then:
+			require.Equal(t, tc.expStatus, w.Code)
+			if tc.expStatus == http.StatusOK {
+				require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			}
+			for _, part := range tc.expBody {
+				require.Contains(t, w.Body.String(), part)
+			}
+		})
+	}
+}
+
+// failingWriter fails the writes once the stream got started
+type failingWriter struct {
+	*httptest.ResponseRecorder
+	writes int
+}
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	w.writes++
+	if w.writes > 1 {
+		return 0, errors.New("broken pipe")
+	}
+	return w.ResponseRecorder.Write(b)
+}
+
+func TestWebSocketHandler_HandleOrderEvents_writeError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Given:
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	hub := NewMockHub(t)
+	hub.On("Register", mock.Anything).Run(func(args mock.Arguments) {
+		client := args.Get(0).(*Client)
+		require.True(t, client.deliver(newEvent(TopicMyOrders, Publication{Seq: 42, OrderID: 123, UserID: 1,
+			Type: MessageTypeOrderStatus, Data: []byte(`{}`)})))
+	}).Return()
+	hub.On("Unregister", mock.Anything).Return()
+
+	h := NewWebSocketHandler(hub, authenticate.AuthService{}, NewMockEventLog(t))
+	router := gin.New()
+	router.GET("/events", func(c *gin.Context) {
+		c.Set("user_id", int64(1))
+		c.Set("role", string(model.UserRoleCustomer))
+	}, h.HandleOrderEvents)
+
+	w := &failingWriter{ResponseRecorder: httptest.NewRecorder()}
+
+	// When:
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(ctx))
+
+	// Then: the stream ends without waiting for the client to go away
+	require.NoError(t, ctx.Err())
+	require.Equal(t, "retry: 5000\n\n", w.Body.String())
+}
@@ -0,0 +1,41 @@
+package ws
+
+import (
+	"context"
+
+	"omg/api/internal/model"
+)
+
+// Subscribe follows the topic on behalf of the user through a client without connection, for the transports which
+// write the events themselves, e.g. the GraphQL subscriptions. The live events are sent on the returned channel, which
+// is closed once the context is done or once the hub dropped the client for being too slow.
+func Subscribe(ctx context.Context, hub Hub, userID int64, role model.UserRole, topic Topic) (<-chan ServerMessage, error) {
+	client := NewClient(hub, nil, nil, userID, role)
+	if err := client.subscribe(topic); err != nil {
+		return nil, err
+	}
+	hub.Register(client)
+
+	events := make(chan ServerMessage)
+	go func() {
+		defer close(events)
+		defer hub.Unregister(client)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-client.done:
+				return
+			case msg := <-client.send:
+				select {
+				case events <- msg:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
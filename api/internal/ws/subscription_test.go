@@ -0,0 +1,53 @@
+package ws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"omg/api/internal/model"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribe(t *testing.T) {
+	hub := NewHub()
+	go hub.Run()
+
+	t.Run("delivers_the_events_of_the_topic", func(t *testing.T) {
+		// Given:
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events, err := Subscribe(ctx, hub, 1, model.UserRoleCustomer, TopicMyOrders)
+		require.NoError(t, err)
+
+		// When:
+		hub.Publish(Publication{Seq: 41, OrderID: 122, UserID: 2, Data: []byte(`{"type":"order_status","order_id":"122"}`)})
+		hub.Publish(Publication{Seq: 42, OrderID: 123, UserID: 1, Data: []byte(`{"type":"order_status","order_id":"123"}`)})
+
+		// Then:
+		select {
+		case msg := <-events:
+			require.Equal(t, MessageTypeEvent, msg.Type)
+			require.Equal(t, TopicMyOrders, msg.Topic)
+			require.Equal(t, int64(42), msg.Seq)
+			require.JSONEq(t, `{"type":"order_status","order_id":"123"}`, string(msg.Data))
+		case <-time.After(time.Second):
+			t.Fatal("event not delivered")
+		}
+
+		cancel()
+		select {
+		case _, ok := <-events:
+			require.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("events not closed")
+		}
+	})
+
+	t.Run("rejects_the_forbidden_topic", func(t *testing.T) {
+		_, err := Subscribe(context.Background(), hub, 1, model.UserRoleCustomer, TopicAllOrders)
+		require.EqualError(t, err, "topic restricted to back office users")
+	})
+}
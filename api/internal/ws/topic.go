@@ -0,0 +1,74 @@
+package ws
+
+import (
+	"strconv"
+	"strings"
+
+	"omg/api/internal/model"
+)
+
+// Topic is what a client subscribes to
+type Topic string
+
+const (
+	// TopicMyOrders carries the events of all the orders of the user
+	TopicMyOrders Topic = "orders:mine"
+	// TopicAllOrders carries the events of all the orders, for back office users only
+	TopicAllOrders Topic = "orders:all"
+	// TopicProductStock carries the stock changes of all the products, for back office users only
+	TopicProductStock Topic = "products:stock"
+
+	orderTopicPrefix = "order:"
+)
+
+// OrderTopic returns the topic carrying the events of a single order
+func OrderTopic(orderID int64) Topic {
+	return Topic(orderTopicPrefix + strconv.FormatInt(orderID, 10))
+}
+
+// authorize checks the topic exists & the user may subscribe to it
+func (t Topic) authorize(userID int64, role model.UserRole) *Error {
+	if userID == 0 {
+		return &Error{Code: ErrorCodeUnauthorized, Message: "authentication required to subscribe"}
+	}
+
+	switch t {
+	case TopicMyOrders:
+		return nil
+	case TopicAllOrders, TopicProductStock:
+		if !role.IsBackOffice() {
+			return &Error{Code: ErrorCodeForbidden, Message: "topic restricted to back office users"}
+		}
+		return nil
+	}
+
+	if id, ok := strings.CutPrefix(string(t), orderTopicPrefix); ok {
+		// The ownership is checked on delivery, the events of the orders of other users are never sent
+		if orderID, err := strconv.ParseInt(id, 10, 64); err == nil && orderID > 0 {
+			return nil
+		}
+	}
+
+	return &Error{Code: ErrorCodeInvalidTopic, Message: "unknown topic " + strconv.Quote(string(t))}
+}
+
+// eventFilter selects the published events of the topic the user may receive, to replay them
+func (t Topic) eventFilter(userID int64, role model.UserRole) model.PublishedEventFilter {
+	orderEvents := []model.EventType{model.EventTypeOrderCreated, model.EventTypeOrderStatusChanged}
+
+	switch t {
+	case TopicMyOrders:
+		return model.PublishedEventFilter{Types: orderEvents, UserID: userID}
+	case TopicAllOrders:
+		return model.PublishedEventFilter{Types: orderEvents}
+	case TopicProductStock:
+		return model.PublishedEventFilter{Types: []model.EventType{model.EventTypeProductStockChanged}}
+	}
+
+	filter := model.PublishedEventFilter{Types: orderEvents}
+	filter.AggregateID, _ = strconv.ParseInt(strings.TrimPrefix(string(t), orderTopicPrefix), 10, 64)
+	if !role.IsBackOffice() {
+		filter.UserID = userID
+	}
+	return filter
+}
@@ -0,0 +1,103 @@
+package pg
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	listenerMinReconnectInterval = 10 * time.Second
+	listenerMaxReconnectInterval = time.Minute
+	listenerPingInterval         = 90 * time.Second
+)
+
+// Notification is a payload received on a LISTENed channel
+type Notification struct {
+	Channel string
+	Payload string
+}
+
+// Listener receives the payloads sent with NOTIFY on the channels it listens to
+type Listener interface {
+	// Listen starts listening to the channel
+	Listen(channel string) error
+	// Notifications returns the received notifications, it is closed once the Listener is closed
+	Notifications() <-chan Notification
+	// Ping checks the connection of the Listener is alive
+	Ping() error
+	// Close disconnects the Listener
+	Close() error
+}
+
+// NewListener opens a connection dedicated to LISTEN, separated from the pool as it must stay open.
+// The connection is re-established when lost; notifications sent while disconnected are missed.
+func NewListener(url string) Listener {
+	l := &listener{
+		pqListener: pq.NewListener(url, listenerMinReconnectInterval, listenerMaxReconnectInterval, func(ev pq.ListenerEventType, err error) {
+			if err != nil {
+				log.Printf("Postgres listener event %d: %v", ev, err)
+			}
+		}),
+		notifications: make(chan Notification, 256),
+	}
+
+	go l.forward()
+
+	return l
+}
+
+type listener struct {
+	pqListener    *pq.Listener
+	notifications chan Notification
+}
+
+func (l *listener) Listen(channel string) error {
+	return pkgerrors.WithStack(l.pqListener.Listen(channel))
+}
+
+func (l *listener) Notifications() <-chan Notification {
+	return l.notifications
+}
+
+func (l *listener) Ping() error {
+	return pkgerrors.WithStack(l.pqListener.Ping())
+}
+
+func (l *listener) Close() error {
+	return pkgerrors.WithStack(l.pqListener.Close())
+}
+
+func (l *listener) forward() {
+	defer close(l.notifications)
+
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case n, ok := <-l.pqListener.Notify:
+			if !ok {
+				return
+			}
+			// A nil notification is sent after the connection got re-established
+			if n == nil {
+				log.Println("Postgres listener reconnected, notifications may have been missed")
+				continue
+			}
+			l.notifications <- Notification{Channel: n.Channel, Payload: n.Extra}
+		case <-ticker.C:
+			// Detect a dead connection even when nothing gets notified
+			go l.pqListener.Ping()
+		}
+	}
+}
+
+// Notify sends the payload to the listeners of the channel. Within a tx, it is only delivered once the tx commits.
+func Notify(ctx context.Context, dbConn ContextExecutor, channel, payload string) error {
+	_, err := dbConn.ExecContext(ctx, "SELECT pg_notify($1, $2)", channel, payload)
+	return pkgerrors.WithStack(err)
+}
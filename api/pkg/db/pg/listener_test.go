@@ -0,0 +1,56 @@
+package pg
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListener_Notify(t *testing.T) {
+	// Given:
+	dbConn, err := NewPool(os.Getenv("DB_URL"), 3, 1)
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	l := NewListener(os.Getenv("DB_URL"))
+	defer l.Close()
+	require.NoError(t, l.Listen("test_listener"))
+
+	// When:
+	require.NoError(t, Notify(context.Background(), dbConn, "test_listener", "hello"))
+
+	// Then:
+	select {
+	case n := <-l.Notifications():
+		require.Equal(t, Notification{Channel: "test_listener", Payload: "hello"}, n)
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not received")
+	}
+}
+
+func TestListener_NotifyInTx(t *testing.T) {
+	// Given:
+	dbConn, err := NewPool(os.Getenv("DB_URL"), 3, 1)
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	l := NewListener(os.Getenv("DB_URL"))
+	defer l.Close()
+	require.NoError(t, l.Listen("test_listener_tx"))
+
+	// When: the tx is rolled back
+	require.Error(t, Tx(context.Background(), dbConn, func(tx ContextExecutor) error {
+		require.NoError(t, Notify(context.Background(), tx, "test_listener_tx", "rolled back"))
+		return context.Canceled
+	}))
+
+	// Then: nothing is delivered
+	select {
+	case n := <-l.Notifications():
+		t.Fatalf("unexpected notification: %+v", n)
+	case <-time.After(time.Second):
+	}
+}
@@ -0,0 +1,46 @@
+package pg
+
+import (
+	"errors"
+
+	"omg/api/pkg/metrics"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// The outcomes of a transaction
+const (
+	txResultBeginFailed  = "begin_failed"
+	txResultRolledBack   = "rolled_back"
+	txResultCommitFailed = "commit_failed"
+	txResultCommitted    = "committed"
+)
+
+var (
+	txBeginAttempts = promauto.With(metrics.Registry).NewCounter(prometheus.CounterOpts{
+		Name: "db_tx_begin_attempts_total",
+		Help: "Attempts to begin a transaction, retries included.",
+	})
+	txBeginRetries = promauto.With(metrics.Registry).NewCounter(prometheus.CounterOpts{
+		Name: "db_tx_begin_retries_total",
+		Help: "Attempts to begin a transaction made after a failed one.",
+	})
+	txDuration = promauto.With(metrics.Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_tx_duration_seconds",
+		Help:    "Duration of the transactions, from the first attempt to begin until the commit or rollback, by result.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"result"})
+)
+
+// RegisterPoolMetrics exposes the stats of the connection pool, e.g. the open, in use & idle connections & the waits
+func RegisterPoolMetrics(dbconn BeginnerExecutor) error {
+	db, ok := dbconn.(*gobaseDB)
+	if !ok {
+		return pkgerrors.WithStack(errors.New("pool metrics are only available for the pools of NewPool"))
+	}
+
+	return pkgerrors.WithStack(metrics.Registry.Register(collectors.NewDBStatsCollector(db.DB, db.info.dbName)))
+}
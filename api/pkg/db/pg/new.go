@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 	"time"
 
 	_ "github.com/lib/pq"
@@ -39,6 +41,17 @@ func NewPool(
 	log.Println("Postgres initialized")
 
 	return &gobaseDB{
-		DB: conn,
+		DB:   conn,
+		info: InstanceInfo{dbName: dbNameFromURL(url)},
 	}, nil
 }
+
+// dbNameFromURL gets the database name of a postgres:// URL, it is empty for the key=value connection strings
+func dbNameFromURL(dbURL string) string {
+	u, err := url.Parse(dbURL)
+	if err != nil || u.Scheme == "" {
+		return ""
+	}
+
+	return strings.TrimPrefix(u.Path, "/")
+}
@@ -61,3 +61,20 @@ func TestInitDBConn(t *testing.T) {
 		})
 	}
 }
+
+func TestDBNameFromURL(t *testing.T) {
+	tcs := map[string]struct {
+		givenURL string
+		expName  string
+	}{
+		"url":              {givenURL: "postgres://omg:@pg:5432/omg?sslmode=disable", expName: "omg"},
+		"key_value":        {givenURL: "host=pg dbname=omg sslmode=disable", expName: ""},
+		"url_without_name": {givenURL: "postgres://omg:@pg:5432", expName: ""},
+	}
+
+	for desc, tc := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			require.Equal(t, tc.expName, dbNameFromURL(tc.givenURL))
+		})
+	}
+}
@@ -20,6 +20,12 @@ func TxWithBackOff(ctx context.Context, b backoff.BackOff, dbconn BeginnerExecut
 		b = &backoff.StopBackOff{}
 	}
 
+	start := time.Now()
+	result := txResultBeginFailed
+	defer func() {
+		txDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+	}()
+
 	tx, err := beginTx(ctx, dbconn, b)
 	if err != nil {
 		return err
@@ -37,11 +43,14 @@ func TxWithBackOff(ctx context.Context, b backoff.BackOff, dbconn BeginnerExecut
 
 	// errors from here on are code errors and should not retry
 	if err = callback(tx); err != nil {
+		result = txResultRolledBack
 		return err
 	}
 	if err = tx.Commit(); err != nil {
+		result = txResultCommitFailed
 		return pkgerrors.WithStack(err)
 	}
+	result = txResultCommitted
 	return nil
 }
 
@@ -51,6 +60,10 @@ func beginTx(ctx context.Context, dbconn BeginnerExecutor, b backoff.BackOff) (T
 
 	if err := backoff.Retry(func() error {
 		tryCount++
+		txBeginAttempts.Inc()
+		if tryCount > 1 {
+			txBeginRetries.Inc()
+		}
 		var err error
 
 		log.Printf("DB: BeginTx Attempt: %d\n", tryCount)
@@ -0,0 +1,11 @@
+package decimalutil
+
+import "github.com/shopspring/decimal"
+
+// FormatDecimal format output of decimal, whole numbers without fraction & the rest with 2 decimal places
+func FormatDecimal(inp decimal.Decimal) string {
+	if inp.IsInteger() {
+		return inp.StringFixed(0)
+	}
+	return inp.StringFixed(2)
+}
@@ -2,17 +2,35 @@ package gql
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
 )
 
-// Handler creates a http.Handler from the given ExecutableSchema and returns it
-func Handler(es graphql.ExecutableSchema, isIntrospectionEnabled bool) http.Handler {
+// wsKeepAlivePingInterval keeps the idle subscriptions open through the proxies
+const wsKeepAlivePingInterval = 15 * time.Second
+
+// Handler creates a http.Handler from the given ExecutableSchema and returns it.
+// The subscriptions are served over WebSocket (graphql-ws & graphql-transport-ws) when wsInit is given, which
+// authenticates the connection with its init payload.
+func Handler(es graphql.ExecutableSchema, isIntrospectionEnabled bool, wsInit transport.WebsocketInitFunc) http.Handler {
 	srv := handler.New(es)
 	srv.AddTransport(transport.POST{})
+	if wsInit != nil {
+		srv.AddTransport(transport.Websocket{
+			Upgrader: websocket.Upgrader{
+				CheckOrigin: func(r *http.Request) bool {
+					return true
+				},
+			},
+			InitFunc:              wsInit,
+			KeepAlivePingInterval: wsKeepAlivePingInterval,
+		})
+	}
 	srv.SetErrorPresenter(errorPresenter(isIntrospectionEnabled))
 	if isIntrospectionEnabled {
 		srv.Use(extension.Introspection{})
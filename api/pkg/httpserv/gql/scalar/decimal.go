@@ -0,0 +1,44 @@
+package scalar
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/shopspring/decimal"
+	"omg/api/pkg/httpserv"
+)
+
+// MarshalDecimal marshals decimal.Decimal to string, keeping its exact value
+func MarshalDecimal(d decimal.Decimal) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(d.String()))
+	})
+}
+
+// UnmarshalDecimal unmarshals string to decimal.Decimal
+func UnmarshalDecimal(v interface{}) (decimal.Decimal, error) {
+	var err error
+	switch v := v.(type) {
+	case string:
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			return decimal.Decimal{}, &httpserv.Error{
+				Status: http.StatusBadRequest,
+				Code:   "invalid_gql_field_value",
+				Desc:   "Unable to convert value to decimal",
+			}
+		}
+		return d, nil
+	default:
+		err = &httpserv.Error{
+			Status: http.StatusBadRequest,
+			Code:   "invalid_gql_field_type",
+			Desc:   fmt.Sprintf("decimal must be of string type, but got %s instead", reflect.TypeOf(v)),
+		}
+	}
+	return decimal.Decimal{}, err
+}
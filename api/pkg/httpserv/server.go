@@ -15,16 +15,17 @@ type server struct {
 	shutdownGrace time.Duration
 }
 
-// NewServer initializes and returns an instance of HTTP Server
-func NewServer(handler http.Handler) *server {
+// NewServer initializes and returns an instance of HTTP Server listening on addr, giving the in-flight requests the
+// shutdown grace period to complete once stopped
+func NewServer(addr string, handler http.Handler, shutdownGrace time.Duration) *server {
 	s := server{
 		srv: &http.Server{
-			Addr:         ":3000",
+			Addr:         addr,
 			Handler:      handler,
 			ReadTimeout:  time.Minute,
 			WriteTimeout: time.Minute,
 		},
-		shutdownGrace: 10 * time.Second,
+		shutdownGrace: shutdownGrace,
 	}
 
 	return &s
@@ -53,7 +54,14 @@ func (s *server) Start(ctx context.Context) error {
 	}
 }
 
-// Stop stops the HTTP server
+// RegisterOnShutdown registers a function to call once the server starts shutting down, e.g. to close the
+// long-lived connections which the shutdown does not wait for or would wait for until its grace period is over
+func (s *server) RegisterOnShutdown(f func()) {
+	s.srv.RegisterOnShutdown(f)
+}
+
+// Stop stops the HTTP server, no new connection is accepted & the in-flight requests are given the grace period
+// to complete before their connections get closed
 func (s *server) Stop() error {
 	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownGrace)
 	defer cancel()
@@ -66,4 +74,3 @@ func (s *server) Stop() error {
 
 	return nil
 }
-
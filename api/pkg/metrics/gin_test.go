@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Given:
+	router := gin.New()
+	router.Use(GinMiddleware())
+	router.GET("/items/:id", func(c *gin.Context) {
+		c.Status(http.StatusTeapot)
+	})
+
+	// When:
+	for _, path := range []string{"/items/1", "/items/2", "/unknown/path"} {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	// The metrics are served on their own listener, apart from the observed router
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+	Handler().ServeHTTP(w, req)
+
+	// Then:
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `http_request_duration_seconds_count{method="GET",route="/items/:id",status="418"} 2`)
+	require.Contains(t, w.Body.String(), `http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`)
+	require.Contains(t, w.Body.String(), "go_goroutines")
+}
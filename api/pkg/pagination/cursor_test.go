@@ -0,0 +1,37 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	given := Cursor{
+		CreatedAt: time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC),
+		ID:        14753010,
+	}
+
+	rs, err := DecodeCursor(given.Encode())
+	require.NoError(t, err)
+	require.Equal(t, given, rs)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	tcs := map[string]string{
+		"empty":        "",
+		"not_base64":   "!!!",
+		"no_separator": "MTIz",       // 123
+		"invalid_time": "YWJjOjE",    // abc:1
+		"invalid_id":   "MTIzOmFiYw", // 123:abc
+		"zero_id":      "MTIzOjA",    // 123:0
+	}
+
+	for desc, token := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			_, err := DecodeCursor(token)
+			require.Equal(t, ErrInvalidCursor, err)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+// SeqCursor is a keyset position in a list ordered by a monotonic seq, to continue listing after
+type SeqCursor struct {
+	Seq int64
+}
+
+// Encode turns the cursor into an opaque token for clients
+func (c SeqCursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.Seq, 10)))
+}
+
+// DecodeSeqCursor parses a token made by SeqCursor.Encode
+func DecodeSeqCursor(token string) (SeqCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return SeqCursor{}, ErrInvalidCursor
+	}
+
+	seq, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || seq <= 0 {
+		return SeqCursor{}, ErrInvalidCursor
+	}
+
+	return SeqCursor{Seq: seq}, nil
+}
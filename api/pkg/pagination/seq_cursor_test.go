@@ -0,0 +1,32 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeqCursor(t *testing.T) {
+	given := SeqCursor{Seq: 14753010}
+
+	rs, err := DecodeSeqCursor(given.Encode())
+	require.NoError(t, err)
+	require.Equal(t, given, rs)
+}
+
+func TestDecodeSeqCursor_Invalid(t *testing.T) {
+	tcs := map[string]string{
+		"empty":        "",
+		"not_base64":   "!!!",
+		"invalid_seq":  "YWJj", // abc
+		"zero_seq":     "MA",   // 0
+		"negative_seq": "LTE",  // -1
+	}
+
+	for desc, token := range tcs {
+		t.Run(desc, func(t *testing.T) {
+			_, err := DecodeSeqCursor(token)
+			require.Equal(t, ErrInvalidCursor, err)
+		})
+	}
+}
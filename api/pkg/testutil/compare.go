@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/shopspring/decimal"
 )
 
 // Compare allows comparing expected vs actual excluding ignoreFields and shows difference between both
@@ -16,6 +17,9 @@ func Compare(t *testing.T, expected interface{}, actual interface{}, model inter
 		cmp.Comparer(func(x, y time.Time) bool {
 			return x.Sub(y) < 1*time.Second // Allow 1 second difference
 		}),
+		cmp.Comparer(func(x, y decimal.Decimal) bool {
+			return x.Equal(y) // NUMERIC columns come back with their scale, e.g. 10.5 as 10.50
+		}),
 	}
 
 	if !cmp.Equal(expected, actual, opts...) {
@@ -37,6 +37,17 @@ func WithTxDB(t *testing.T, callback func(pg.BeginnerExecutor)) {
 	callback(&txDB{Tx: tx})
 }
 
+// WithDB provides callback with a real `pg.BeginnerExecutor` for tests which need data to be
+// visible across connections (e.g. concurrency tests). Data written here is committed, so the
+// callback is responsible for cleaning it up
+func WithDB(t *testing.T, callback func(pg.BeginnerExecutor)) {
+	dbConn, err := pg.NewPool(env.GetAndValidateF("DB_URL"), 50, 10)
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	callback(dbConn)
+}
+
 type txStatus int
 
 // Constants of rollback status